@@ -0,0 +1,80 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Addon is an EAN-2 or EAN-5 supplemental code printed on the right of an EAN-13,
+// UPC-A or UPC-E symbol. The zero value means no add-on.
+type Addon struct {
+	code uint64
+	size int
+}
+
+var errInvalidAddon = errors.New("Invalid add-on code")
+
+// AddonFromString parses a 2 or 5 digit add-on code.
+func AddonFromString(code string) (Addon, error) {
+	if (len(code) != 2 && len(code) != 5) || !allDigits(code) {
+		return Addon{}, errInvalidAddon
+	}
+	c, err := strconv.ParseUint(code, 10, 64)
+	if err != nil {
+		return Addon{}, err
+	}
+	return Addon{
+		code: c,
+		size: len(code),
+	}, nil
+}
+
+func (a Addon) Code() uint64 {
+	return a.code
+}
+
+// Size returns the number of digits of the add-on, 0 if there is no add-on.
+func (a Addon) Size() int {
+	return a.size
+}
+
+func (a Addon) String() string {
+	if a.size == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%0*d", a.size, a.code)
+}
+
+// parity returns the parity of each digit of the add-on.
+func (a Addon) parity() []int {
+	switch a.size {
+	case 2:
+		return ean2DispatchTable[a.code%4][:]
+	case 5:
+		return ean5DispatchTable[computeEAN5Checksum(a.code)][:]
+	}
+	return nil
+}
+
+// logicalWidth returns the width the add-on needs in addition to the main symbol,
+// whose right margin is shared with the gap.
+func (a Addon) logicalWidth() int {
+	if a.size == 0 {
+		return 0
+	}
+	width := addonMarkerSize + a.size*digitBarSize + (a.size-1)*addonSeparatorSize
+	return addonGap + width + addonMargin - digitBarSize
+}
+
+var ean5ChecksumWeights = [2]uint64{3, 9}
+
+func computeEAN5Checksum(code uint64) uint64 {
+	var checksum uint64 = 0
+	var i = 0
+	for c := code; i < 5; c /= 10 {
+		checksum += (c % 10) * ean5ChecksumWeights[i%2]
+		i++
+	}
+	return checksum % 10
+}
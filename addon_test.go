@@ -0,0 +1,68 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestAddon(t *testing.T) {
+	type data struct {
+		code   string
+		parity []int
+	}
+	var testdata = []data{
+		{"12", []int{0, 0}},
+		{"14", []int{1, 0}},
+		{"31", []int{1, 1}},
+		{"52495", []int{1, 0, 1, 0, 0}},
+		{"90000", []int{0, 1, 0, 1, 0}},
+	}
+	for _, d := range testdata {
+		a, err := AddonFromString(d.code)
+		if err != nil {
+			t.Errorf("Invalid add-on %s", d.code)
+			continue
+		}
+		if a.String() != d.code {
+			t.Errorf("Unexpected add-on %s v.s. %s", d.code, a)
+		}
+		parity := a.parity()
+		for i := range d.parity {
+			if parity[i] != d.parity[i] {
+				t.Errorf("Unexpected parity for %s: %v v.s. %v", d.code, d.parity, parity)
+				break
+			}
+		}
+	}
+}
+
+func TestInvalidAddon(t *testing.T) {
+	var invalidString = []string{
+		"1",      // too short
+		"123",    // neither 2 nor 5 digits
+		"123456", // too long
+		"1a",     // not all digits
+	}
+	for _, c := range invalidString {
+		if _, err := AddonFromString(c); err == nil {
+			t.Errorf("Unexpected valid add-on %s", c)
+		}
+	}
+}
+
+func TestAddonConversion(t *testing.T) {
+	a, _ := AddonFromString("12")
+	upc, _ := UPCAFromString("036000291452")
+	upc = upc.WithAddon(a)
+	if upc.EAN13().Addon() != a {
+		t.Errorf("Add-on lost in conversion to EAN-13")
+	}
+	upce, _ := UPCEFromString("04252614")
+	upce = upce.WithAddon(a)
+	if upce.UPCA().Addon() != a {
+		t.Errorf("Add-on lost in expansion to UPC-A")
+	}
+	back, err := UPCEFromEAN13(upce.EAN13())
+	if err != nil || back != upce {
+		t.Errorf("Unexpected compression %s v.s. %s", upce, back)
+	}
+}
@@ -0,0 +1,213 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+	"strconv"
+	"strings"
+)
+
+// AusPostFCC is the format control code of an Australia Post customer barcode.
+type AusPostFCC int
+
+const (
+	// Standard Customer Barcode, 37 bars
+	AusPostStandard AusPostFCC = 11
+	// Customer Barcode 2, with up to 8 digits or 5 characters of customer information
+	AusPostCustomer2 AusPostFCC = 59
+	// Customer Barcode 3, with up to 15 digits or 10 characters of customer information
+	AusPostCustomer3 AusPostFCC = 62
+	// Reply Paid Barcode
+	AusPostReplyPaid AusPostFCC = 45
+	// Routing Barcode
+	AusPostRouting AusPostFCC = 87
+	// Redirection Barcode
+	AusPostRedirection AusPostFCC = 92
+)
+
+// The bars are written as their values 0 to 3: full, ascender, descender and
+// tracker.
+var auspostBars = [4]fourStateBar{barFull, barAscender, barDescender, barTracker}
+
+// auspostNTable gives the 2 bars of each digit.
+var auspostNTable = [10]string{"00", "01", "02", "10", "11", "12", "20", "21", "22", "30"}
+
+// auspostCChars lists the characters of the C table by value.
+const auspostCChars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz #"
+
+// auspostCTable gives the 3 bars of each character of auspostCChars.
+var auspostCTable = [64]string{
+	"222", "300", "301", "302", "310", "311", "312", "320", "321", "322",
+	"000", "001", "002", "010", "011", "012", "020", "021", "022", "100",
+	"101", "102", "110", "111", "112", "120", "121", "122", "200", "201",
+	"202", "210", "211", "212", "220", "221", "023", "030", "031", "032",
+	"033", "103", "113", "123", "130", "131", "132", "133", "203", "213",
+	"223", "230", "231", "232", "233", "303", "313", "323", "330", "331",
+	"332", "333", "003", "013",
+}
+
+const (
+	// Quiet zone of Australia Post barcodes in modules
+	auspostQuietZone = 6
+	// Digits of the delivery point identifier
+	auspostDPIDDigits = 8
+	// Bars of the data, before the customer information
+	auspostDataBars = 2 * (2 + auspostDPIDDigits)
+	// Reed-Solomon parity symbols, of 3 bars each
+	auspostParitySymbols = 4
+)
+
+// Start and stop bars
+const auspostStartStop = "13"
+
+var auspostField = newGaloisField(64, 0x43)
+
+var (
+	errInvalidAusPost     = errors.New("Invalid Australia Post delivery point identifier")
+	errInvalidAusPostInfo = errors.New("Invalid Australia Post customer information")
+	errInvalidAusPostFCC  = errors.New("Invalid Australia Post format control code")
+)
+
+// AusPost is an Australia Post 4-state customer barcode.
+type AusPost struct {
+	fcc  AusPostFCC
+	dpid string
+	// Customer information, encoded with the N table when made of digits and with
+	// the C table otherwise
+	info string
+}
+
+// AusPostFromString encodes an 8 digit delivery point identifier, optionally
+// followed by customer information. The format is the Standard Customer Barcode
+// without customer information, otherwise the smallest Customer Barcode that
+// holds it.
+func AusPostFromString(text string) (AusPost, error) {
+	if len(text) < auspostDPIDDigits || !allDigits(text[:auspostDPIDDigits]) {
+		return AusPost{}, errInvalidAusPost
+	}
+	code := AusPost{dpid: text[:auspostDPIDDigits], info: text[auspostDPIDDigits:]}
+	for _, c := range code.info {
+		if !strings.ContainsRune(auspostCChars, c) {
+			return AusPost{}, errInvalidAusPostInfo
+		}
+	}
+	n := len(code.infoBars())
+	switch {
+	case n == 0:
+		code.fcc = AusPostStandard
+	case n <= auspostInfoCapacity(AusPostCustomer2):
+		code.fcc = AusPostCustomer2
+	case n <= auspostInfoCapacity(AusPostCustomer3):
+		code.fcc = AusPostCustomer3
+	default:
+		return AusPost{}, errInvalidAusPostInfo
+	}
+	return code, nil
+}
+
+// String returns the delivery point identifier followed by the customer
+// information.
+func (code AusPost) String() string {
+	return code.dpid + code.info
+}
+
+// FCC returns the format control code.
+func (code AusPost) FCC() AusPostFCC {
+	return code.fcc
+}
+
+// WithFCC returns a copy with the format control code, which must hold the
+// customer information.
+func (code AusPost) WithFCC(fcc AusPostFCC) (AusPost, error) {
+	switch fcc {
+	case AusPostStandard, AusPostCustomer2, AusPostCustomer3, AusPostReplyPaid, AusPostRouting, AusPostRedirection:
+	default:
+		return AusPost{}, errInvalidAusPostFCC
+	}
+	if len(code.infoBars()) > auspostInfoCapacity(fcc) {
+		return AusPost{}, errInvalidAusPostInfo
+	}
+	code.fcc = fcc
+	return code, nil
+}
+
+// auspostInfoCapacity returns the number of bars of customer information of the
+// format.
+func auspostInfoCapacity(fcc AusPostFCC) int {
+	switch fcc {
+	case AusPostCustomer2:
+		return 16
+	case AusPostCustomer3:
+		return 31
+	}
+	return 0
+}
+
+func (code AusPost) infoBars() string {
+	var bars []string
+	if allDigits(code.info) {
+		for _, c := range code.info {
+			bars = append(bars, auspostNTable[c-'0'])
+		}
+	} else {
+		for _, c := range code.info {
+			bars = append(bars, auspostCTable[strings.IndexRune(auspostCChars, c)])
+		}
+	}
+	return strings.Join(bars, "")
+}
+
+// bars returns the values of the bars: the start bars, the format control code
+// and delivery point identifier, the customer information padded with trackers,
+// the parity and the stop bars.
+func (code AusPost) bars() string {
+	bars := []byte(auspostStartStop)
+	for _, c := range strconv.Itoa(int(code.fcc)) + code.dpid {
+		bars = append(bars, auspostNTable[c-'0']...)
+	}
+	bars = append(bars, code.infoBars()...)
+	// The data is padded to whole symbols of 3 bars
+	n := len(auspostStartStop) + auspostDataBars + auspostInfoCapacity(code.fcc)
+	for len(bars) < n || (len(bars)-len(auspostStartStop))%3 != 0 {
+		bars = append(bars, '3')
+	}
+	var symbols []int
+	for i := len(auspostStartStop); i < len(bars); i += 3 {
+		symbols = append(symbols, int(bars[i]-'0')<<4|int(bars[i+1]-'0')<<2|int(bars[i+2]-'0'))
+	}
+	for _, p := range auspostField.rsEncode(symbols, auspostParitySymbols, 1) {
+		bars = append(bars, byte('0'+p>>4), byte('0'+p>>2&3), byte('0'+p&3))
+	}
+	return string(append(bars, auspostStartStop...))
+}
+
+func (code AusPost) symbol() fourStateSymbol {
+	values := code.bars()
+	bars := make([]fourStateBar, len(values))
+	for i := range values {
+		bars[i] = auspostBars[values[i]-'0']
+	}
+	return fourStateSymbol{bars: bars, quietZone: auspostQuietZone}
+}
+
+func (code AusPost) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := code.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderFourState(s, r)
+	return nil
+}
+
+func (code AusPost) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := code.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderFourState(s, r)
+	return nil
+}
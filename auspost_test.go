@@ -0,0 +1,81 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestAusPostCTable(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, bars := range auspostCTable {
+		if seen[bars] {
+			t.Errorf("Duplicate bars %s", bars)
+		}
+		seen[bars] = true
+	}
+}
+
+func TestAusPostFormats(t *testing.T) {
+	for _, test := range []struct {
+		text string
+		fcc  AusPostFCC
+		bars int
+	}{
+		{"39987520", AusPostStandard, 37},
+		{"3998752012345678", AusPostCustomer2, 52},
+		{"39987520AbC 1", AusPostCustomer2, 52},
+		{"39987520123456789", AusPostCustomer3, 67},
+		{"39987520Ab#123", AusPostCustomer3, 67},
+	} {
+		code, err := AusPostFromString(test.text)
+		if err != nil {
+			t.Errorf("Unexpected error %v for %s", err, test.text)
+			continue
+		}
+		bars := code.bars()
+		if code.FCC() != test.fcc || len(bars) != test.bars {
+			t.Errorf("Unexpected format %d with %d bars for %s", code.FCC(), len(bars), test.text)
+		}
+	}
+}
+
+func TestAusPostBars(t *testing.T) {
+	code, _ := AusPostFromString("39987520")
+	bars := code.bars()
+	// Start, FCC 11, DPID and filler
+	expected := "13" + "0101" + "1030302221120200" + "3"
+	if bars[:len(expected)] != expected || bars[len(bars)-2:] != "13" {
+		t.Errorf("Unexpected bars %s", bars)
+	}
+	// The parity brings the syndromes to zero
+	var symbols []int
+	for i := 2; i < len(bars)-2; i += 3 {
+		symbols = append(symbols, int(bars[i]-'0')<<4|int(bars[i+1]-'0')<<2|int(bars[i+2]-'0'))
+	}
+	for i := 1; i <= auspostParitySymbols; i++ {
+		root, s := auspostField.exp[i], 0
+		for _, c := range symbols {
+			s = auspostField.mul(s, root) ^ c
+		}
+		if s != 0 {
+			t.Errorf("Unexpected syndrome %d", i)
+		}
+	}
+}
+
+func TestAusPostInvalid(t *testing.T) {
+	for _, text := range []string{"3998752", "3998752x", "39987520AbCdEfGhIjK", "399875201234567890123456", "39987520a-b"} {
+		if _, err := AusPostFromString(text); err == nil {
+			t.Errorf("Unexpected valid text %q", text)
+		}
+	}
+	code, _ := AusPostFromString("39987520123")
+	if _, err := code.WithFCC(AusPostRouting); err == nil {
+		t.Errorf("Unexpected valid format with customer information")
+	}
+	if _, err := code.WithFCC(12); err == nil {
+		t.Errorf("Unexpected valid format 12")
+	}
+	if code, err := code.WithFCC(AusPostCustomer3); err != nil || len(code.bars()) != 67 {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
@@ -0,0 +1,370 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+)
+
+// Limits of the Aztec Code symbols
+const (
+	azMaxCompactLayers  = 4
+	azMaxLayers         = 32
+	azMaxCompactWords   = 64
+	azMaxECCPercent     = 90
+	azDefaultQuietZone  = 0
+	azECIUTF8           = 26
+	azModeMessageBits   = 4
+	azFLGDigitCountBits = 3
+)
+
+var (
+	errAztecTooLong    = errors.New("Data too long for an Aztec Code")
+	errInvalidAztecECC = errors.New("Invalid Aztec Code error correction percentage")
+)
+
+// azFields are the Galois fields of the codewords, by codeword size.
+var azFields = map[int]*galoisField{
+	4:  newGaloisField(16, 0x13),
+	6:  newGaloisField(64, 0x43),
+	8:  newGaloisField(256, 0x12d),
+	10: newGaloisField(1024, 0x409),
+	12: newGaloisField(4096, 0x1069),
+}
+
+// azWordSize returns the size of the codewords of a symbol with the layers.
+func azWordSize(layers int) int {
+	switch {
+	case layers <= 2:
+		return 6
+	case layers <= 8:
+		return 8
+	case layers <= 22:
+		return 10
+	}
+	return 12
+}
+
+// azTotalBits returns the number of bits in the data layers.
+func azTotalBits(layers int, compact bool) int {
+	if compact {
+		return (88 + 16*layers) * layers
+	}
+	return (112 + 16*layers) * layers
+}
+
+// stuffAztecBits splits the bits in codewords, padded with ones. A codeword whose
+// bits would all be equal has its last bit inverted, and that bit goes to the
+// next codeword.
+func stuffAztecBits(bits []bool, wordSize int) []int {
+	var words []int
+	mask := 1<<uint(wordSize) - 2
+	for i := 0; i < len(bits); {
+		word := 0
+		for j := 0; j < wordSize; j++ {
+			word <<= 1
+			if i+j >= len(bits) || bits[i+j] {
+				word |= 1
+			}
+		}
+		switch word & mask {
+		case mask:
+			words = append(words, word&mask)
+			i += wordSize - 1
+		case 0:
+			words = append(words, word|1)
+			i += wordSize - 1
+		default:
+			words = append(words, word)
+			i += wordSize
+		}
+	}
+	return words
+}
+
+// azMessageBits returns the bits of the codewords and their error correction
+// codewords, filling totalBits from the end.
+func azMessageBits(words []int, totalBits, wordSize int) []bool {
+	ecc := azFields[wordSize].rsEncode(words, totalBits/wordSize-len(words), 1)
+	bits := make([]bool, totalBits%wordSize)
+	bits = append(bits, codewordBits(words, wordSize)...)
+	return append(bits, codewordBits(ecc, wordSize)...)
+}
+
+// Aztec is an Aztec Code symbol.
+type Aztec struct {
+	modules   [][]bool
+	compact   bool
+	layers    int
+	quietZone int
+}
+
+// AztecFromString encodes text in the smallest compact or full-range symbol with
+// at least eccPercent of the data as error correction, 23 being the recommended
+// minimum. Latin-1 text is encoded as is, and other text in UTF-8 behind an ECI.
+func AztecFromString(text string, eccPercent int) (Aztec, error) {
+	var data []byte
+	var prefix bitWriter
+	for _, c := range text {
+		if c > 0xff {
+			// FLG(2) with the ECI designator
+			data = []byte(text)
+			prefix = bitWriter{}
+			prefix.write(azPunctShift, 5)
+			prefix.write(0, 5)
+			prefix.write(2, azFLGDigitCountBits)
+			prefix.write(azECIUTF8/10+2, 4)
+			prefix.write(azECIUTF8%10+2, 4)
+			break
+		}
+		data = append(data, byte(c))
+	}
+	return aztecFromData(encodeAztec(data, prefix).bits, eccPercent)
+}
+
+// AztecFromBytes encodes binary data in the smallest symbol with at least
+// eccPercent of the data as error correction.
+func AztecFromBytes(data []byte, eccPercent int) (Aztec, error) {
+	return aztecFromData(encodeAztec(data, bitWriter{}).bits, eccPercent)
+}
+
+func aztecFromData(bits []bool, eccPercent int) (Aztec, error) {
+	if eccPercent < 0 || eccPercent > azMaxECCPercent {
+		return Aztec{}, errInvalidAztecECC
+	}
+	eccBits := len(bits)*eccPercent/100 + 11
+	// The compact symbols come first, the full-range symbols of less than 4 layers
+	// being as large as the compact ones with one more layer
+	for i := 0; i < azMaxLayers+azMaxCompactLayers-3; i++ {
+		compact, layers := i < azMaxCompactLayers, i+1
+		if !compact {
+			layers = i - azMaxCompactLayers + 4
+		}
+		totalBits := azTotalBits(layers, compact)
+		if len(bits)+eccBits > totalBits {
+			continue
+		}
+		wordSize := azWordSize(layers)
+		words := stuffAztecBits(bits, wordSize)
+		if compact && len(words) > azMaxCompactWords {
+			continue
+		}
+		if len(words)*wordSize+eccBits > totalBits-totalBits%wordSize {
+			continue
+		}
+		return Aztec{
+			modules:   aztecModules(azMessageBits(words, totalBits, wordSize), compact, layers, len(words)),
+			compact:   compact,
+			layers:    layers,
+			quietZone: azDefaultQuietZone,
+		}, nil
+	}
+	return Aztec{}, errAztecTooLong
+}
+
+// azModeMessage returns the bits of the layer count and the codeword count, with
+// their error correction.
+func azModeMessage(compact bool, layers, words int) []bool {
+	var w bitWriter
+	total := 40
+	if compact {
+		w.write(uint64(layers-1), 2)
+		w.write(uint64(words-1), 6)
+		total = 28
+	} else {
+		w.write(uint64(layers-1), 5)
+		w.write(uint64(words-1), 11)
+	}
+	var data []int
+	for i := 0; i < len(w.bits); i += azModeMessageBits {
+		v := 0
+		for _, b := range w.bits[i : i+azModeMessageBits] {
+			v <<= 1
+			if b {
+				v |= 1
+			}
+		}
+		data = append(data, v)
+	}
+	return azMessageBits(data, total, azModeMessageBits)
+}
+
+// aztecModules lays out the bits around the bullseye, layer by layer from the
+// inside, followed by the mode message and the reference grid.
+func aztecModules(bits []bool, compact bool, layers, words int) [][]bool {
+	baseSize := 14 + layers*4
+	if compact {
+		baseSize = 11 + layers*4
+	}
+	// The reference grid inserts a line every 15 modules from the center
+	size := baseSize
+	alignment := make([]int, baseSize)
+	if compact {
+		for i := range alignment {
+			alignment[i] = i
+		}
+	} else {
+		size = baseSize + 1 + 2*((baseSize/2-1)/15)
+		origCenter, center := baseSize/2, size/2
+		for i := 0; i < origCenter; i++ {
+			offset := i + i/15
+			alignment[origCenter-i-1] = center - offset - 1
+			alignment[origCenter+i] = center + offset + 1
+		}
+	}
+	m := newQRMatrix(size, size)
+	set := func(x, y int) {
+		m.modules[y][x] = true
+	}
+
+	offset := 0
+	for i := 0; i < layers; i++ {
+		rowSize := (layers-i)*4 + 12
+		if compact {
+			rowSize = (layers-i)*4 + 9
+		}
+		// Each side of the layer is 2 modules deep, one side after the other
+		for j := 0; j < rowSize; j++ {
+			for k := 0; k < 2; k++ {
+				low, high := alignment[i*2+k], alignment[baseSize-1-i*2-k]
+				if bits[offset+j*2+k] {
+					set(low, alignment[i*2+j])
+				}
+				if bits[offset+rowSize*2+j*2+k] {
+					set(alignment[i*2+j], high)
+				}
+				if bits[offset+rowSize*4+j*2+k] {
+					set(high, alignment[baseSize-1-i*2-j])
+				}
+				if bits[offset+rowSize*6+j*2+k] {
+					set(alignment[baseSize-1-i*2-j], low)
+				}
+			}
+		}
+		offset += rowSize * 8
+	}
+
+	center := size / 2
+	mode := azModeMessage(compact, layers, words)
+	if compact {
+		for i := 0; i < 7; i++ {
+			o := center - 3 + i
+			if mode[i] {
+				set(o, center-5)
+			}
+			if mode[i+7] {
+				set(center+5, o)
+			}
+			if mode[20-i] {
+				set(o, center+5)
+			}
+			if mode[27-i] {
+				set(center-5, o)
+			}
+		}
+	} else {
+		for i := 0; i < 10; i++ {
+			o := center - 5 + i + i/5
+			if mode[i] {
+				set(o, center-7)
+			}
+			if mode[i+10] {
+				set(center+7, o)
+			}
+			if mode[29-i] {
+				set(o, center+7)
+			}
+			if mode[39-i] {
+				set(center-7, o)
+			}
+		}
+	}
+
+	// Bullseye and orientation marks
+	radius := 7
+	if compact {
+		radius = 5
+	}
+	for i := 0; i < radius; i += 2 {
+		for j := center - i; j <= center+i; j++ {
+			set(j, center-i)
+			set(j, center+i)
+			set(center-i, j)
+			set(center+i, j)
+		}
+	}
+	set(center-radius, center-radius)
+	set(center-radius+1, center-radius)
+	set(center-radius, center-radius+1)
+	set(center+radius, center-radius)
+	set(center+radius, center-radius+1)
+	set(center+radius, center+radius-1)
+
+	if !compact {
+		for i, j := 0, 0; i < baseSize/2-1; i, j = i+15, j+16 {
+			for k := center & 1; k < size; k += 2 {
+				set(center-j, k)
+				set(center+j, k)
+				set(k, center-j)
+				set(k, center+j)
+			}
+		}
+	}
+	return m.modules
+}
+
+// Compact returns whether the symbol is a compact one, with a smaller bullseye and
+// no reference grid.
+func (az Aztec) Compact() bool {
+	return az.compact
+}
+
+// Layers returns the number of data layers around the bullseye.
+func (az Aztec) Layers() int {
+	return az.layers
+}
+
+// Size returns the number of modules on each side, without the quiet zone.
+func (az Aztec) Size() int {
+	return len(az.modules)
+}
+
+// Module returns whether the module at column x and row y is dark.
+func (az Aztec) Module(x, y int) bool {
+	return az.modules[y][x]
+}
+
+// WithQuietZone returns a copy with a quiet zone of the given number of modules
+// on each side. Aztec Code needs none, which is the default.
+func (az Aztec) WithQuietZone(modules int) Aztec {
+	az.quietZone = modules
+	return az
+}
+
+func (az Aztec) symbol() matrixSymbol {
+	return matrixSymbol{
+		modules:   az.modules,
+		quietZone: az.quietZone,
+	}
+}
+
+func (az Aztec) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := az.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
+
+func (az Aztec) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := az.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
@@ -0,0 +1,247 @@
+package barcode
+
+// Modes of the Aztec Code high level encoding
+const (
+	azUpper = iota
+	azLower
+	azMixed
+	azPunct
+	azDigit
+	azModes
+)
+
+// azCode is a sequence of bits in the encoded data.
+type azCode struct {
+	value, bits int
+}
+
+// azLatches gives the shortest sequence of latches from one mode to another.
+var azLatches = [azModes][azModes]azCode{
+	azUpper: {azLower: {28, 5}, azMixed: {29, 5}, azPunct: {29<<5 | 30, 10}, azDigit: {30, 5}},
+	azLower: {azUpper: {30<<4 | 14, 9}, azMixed: {29, 5}, azPunct: {29<<5 | 30, 10}, azDigit: {30, 5}},
+	azMixed: {azUpper: {29, 5}, azLower: {28, 5}, azPunct: {30, 5}, azDigit: {29<<5 | 30, 10}},
+	azPunct: {azUpper: {31, 5}, azLower: {31<<5 | 28, 10}, azMixed: {31<<5 | 29, 10}, azDigit: {31<<5 | 30, 10}},
+	azDigit: {azUpper: {14, 4}, azLower: {14<<5 | 28, 9}, azMixed: {14<<5 | 29, 9}, azPunct: {14<<10 | 29<<5 | 30, 14}},
+}
+
+// Shifts and the binary shift, which are available in some modes only
+var (
+	azUpperShifts = [azModes]azCode{azLower: {28, 5}, azDigit: {15, 4}}
+	azBinaryShift = azCode{31, 5}
+)
+
+const (
+	azPunctShift = 0
+	// Longest run of bytes after a binary shift
+	azMaxBinary = 2047 + 31
+)
+
+// azPunctPairs are the pairs of characters with a single code in the punctuation
+// mode, from code 2.
+var azPunctPairs = []string{"\r\n", ". ", ", ", ": "}
+
+// azCharCodes gives the code of each byte in each mode, or 0.
+var azCharCodes [azModes][256]int
+
+func init() {
+	for c := 'A'; c <= 'Z'; c++ {
+		azCharCodes[azUpper][c] = int(c-'A') + 2
+		azCharCodes[azLower][c+'a'-'A'] = int(c-'A') + 2
+	}
+	for c := '0'; c <= '9'; c++ {
+		azCharCodes[azDigit][c] = int(c-'0') + 2
+	}
+	azCharCodes[azUpper][' '] = 1
+	azCharCodes[azLower][' '] = 1
+	azCharCodes[azDigit][' '] = 1
+	azCharCodes[azDigit][','] = 12
+	azCharCodes[azDigit]['.'] = 13
+	mixed := []byte(" \x01\x02\x03\x04\x05\x06\x07\b\t\n\v\f\r\x1b\x1c\x1d\x1e\x1f@\\^_`|~\x7f")
+	for i, c := range mixed {
+		azCharCodes[azMixed][c] = i + 1
+	}
+	punct := []byte("\r____!\"#$%&'()*+,-./:;<=>?[]{}")
+	for i, c := range punct {
+		if c != '_' {
+			azCharCodes[azPunct][c] = i + 1
+		}
+	}
+}
+
+// azModeBits returns the size of the codes in the mode.
+func azModeBits(mode int) int {
+	if mode == azDigit {
+		return 4
+	}
+	return 5
+}
+
+func azPunctPair(data []byte) int {
+	if len(data) < 2 {
+		return 0
+	}
+	for i, pair := range azPunctPairs {
+		if string(data[:2]) == pair {
+			return i + 2
+		}
+	}
+	return 0
+}
+
+// Kinds of steps of the high level encoding
+const (
+	azStepChar = iota
+	azStepPair
+	azStepPunctShift
+	azStepPunctShiftPair
+	azStepUpperShift
+	azStepBinary
+)
+
+// azStep encodes data from a previous position in a mode, without changing it.
+type azStep struct {
+	from, kind int
+}
+
+func azBinaryCost(n int) int {
+	if n <= 31 {
+		return 10 + 8*n
+	}
+	return 21 + 8*n
+}
+
+// encodeAztec returns the shortest bit stream encoding the data, starting in the
+// upper mode with the given prefix.
+func encodeAztec(data []byte, prefix bitWriter) bitWriter {
+	n := len(data)
+	const unreachable = 1 << 30
+	// cost[i][m] is the length of the shortest encoding of data[:i] ending in
+	// mode m, and arrive[i][m] the same before the latches at position i.
+	cost := make([][azModes]int, n+1)
+	arrive := make([][azModes]int, n+1)
+	steps := make([][azModes]azStep, n+1)
+	latchFrom := make([][azModes]int, n+1)
+	for i := range cost {
+		for m := range cost[i] {
+			cost[i][m], arrive[i][m] = unreachable, unreachable
+		}
+	}
+	arrive[0][azUpper] = 0
+	relax := func(i, m, c, kind, from int) {
+		if c < arrive[i][m] {
+			arrive[i][m] = c
+			steps[i][m] = azStep{from, kind}
+		}
+	}
+	for i := 0; i <= n; i++ {
+		for to := 0; to < azModes; to++ {
+			for from := 0; from < azModes; from++ {
+				c := arrive[i][from]
+				if from != to {
+					c += azLatches[from][to].bits
+				}
+				if c < cost[i][to] {
+					cost[i][to], latchFrom[i][to] = c, from
+				}
+			}
+		}
+		if i == n {
+			break
+		}
+		for m := 0; m < azModes; m++ {
+			c := cost[i][m]
+			if c >= unreachable {
+				continue
+			}
+			w := azModeBits(m)
+			ch := data[i]
+			if azCharCodes[m][ch] != 0 {
+				relax(i+1, m, c+w, azStepChar, i)
+			}
+			if pair := azPunctPair(data[i:]); pair != 0 {
+				if m == azPunct {
+					relax(i+2, m, c+w, azStepPair, i)
+				} else {
+					relax(i+2, m, c+w+5, azStepPunctShiftPair, i)
+				}
+			}
+			if m != azPunct && azCharCodes[azPunct][ch] != 0 {
+				relax(i+1, m, c+w+5, azStepPunctShift, i)
+			}
+			if azUpperShifts[m].bits > 0 && azCharCodes[azUpper][ch] != 0 {
+				relax(i+1, m, c+azUpperShifts[m].bits+5, azStepUpperShift, i)
+			}
+			if m == azUpper || m == azLower || m == azMixed {
+				for k := 1; k <= azMaxBinary && i+k <= n; k++ {
+					relax(i+k, m, c+azBinaryCost(k), azStepBinary, i)
+				}
+			}
+		}
+	}
+
+	// Walk back the shortest path
+	type azAction struct {
+		mode, latchFrom int
+		step            azStep
+		to              int
+	}
+	var path []azAction
+	best := 0
+	for m := range cost[n] {
+		if cost[n][m] < cost[n][best] {
+			best = m
+		}
+	}
+	for i, m := n, best; i > 0 || m != azUpper; {
+		if from := latchFrom[i][m]; from != m {
+			path = append(path, azAction{mode: m, latchFrom: from, to: -1})
+			m = from
+		}
+		if i == 0 {
+			break
+		}
+		s := steps[i][m]
+		path = append(path, azAction{mode: m, step: s, to: i})
+		i = s.from
+	}
+
+	w := prefix
+	for j := len(path) - 1; j >= 0; j-- {
+		a := path[j]
+		if a.to < 0 {
+			latch := azLatches[a.latchFrom][a.mode]
+			w.write(uint64(latch.value), latch.bits)
+			continue
+		}
+		bits := azModeBits(a.mode)
+		chunk := data[a.step.from:a.to]
+		switch a.step.kind {
+		case azStepChar:
+			w.write(uint64(azCharCodes[a.mode][chunk[0]]), bits)
+		case azStepPair:
+			w.write(uint64(azPunctPair(chunk)), bits)
+		case azStepPunctShift:
+			w.write(uint64(azPunctShift), bits)
+			w.write(uint64(azCharCodes[azPunct][chunk[0]]), 5)
+		case azStepPunctShiftPair:
+			w.write(uint64(azPunctShift), bits)
+			w.write(uint64(azPunctPair(chunk)), 5)
+		case azStepUpperShift:
+			shift := azUpperShifts[a.mode]
+			w.write(uint64(shift.value), shift.bits)
+			w.write(uint64(azCharCodes[azUpper][chunk[0]]), 5)
+		case azStepBinary:
+			w.write(uint64(azBinaryShift.value), azBinaryShift.bits)
+			if len(chunk) <= 31 {
+				w.write(uint64(len(chunk)), 5)
+			} else {
+				w.write(0, 5)
+				w.write(uint64(len(chunk)-31), 11)
+			}
+			for _, b := range chunk {
+				w.write(uint64(b), 8)
+			}
+		}
+	}
+	return w
+}
@@ -0,0 +1,58 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestEncodeAztec(t *testing.T) {
+	data := []struct {
+		data string
+		bits string
+	}{
+		{"ABC", "00010 00011 00100"},
+		{"abc", "11100 00010 00011 00100"},
+		{"123", "11110 0011 0100 0101"},
+		// Punctuation pair through a shift
+		{"A. ", "00010 00000 00011"},
+		// Upper case shift in lower case
+		{"aB", "11100 00010 11100 00011"},
+		{"\xff", "11111 00001 11111111"},
+		{"a\x01b", "11100 00010 11101 00010 11100 00011"},
+	}
+	for _, d := range data {
+		bits := encodeAztec([]byte(d.data), bitWriter{}).bits
+		s := ""
+		for _, b := range bits {
+			if b {
+				s += "1"
+			} else {
+				s += "0"
+			}
+		}
+		expected := ""
+		for _, c := range d.bits {
+			if c != ' ' {
+				expected += string(c)
+			}
+		}
+		if s != expected {
+			t.Errorf("Unexpected bits of %q: %s", d.data, s)
+		}
+	}
+}
+
+func TestEncodeAztecBinary(t *testing.T) {
+	// Two short binary shifts are shorter than a long one up to 62 bytes
+	data := make([]byte, 40)
+	if n := len(encodeAztec(data, bitWriter{}).bits); n != 2*(5+5)+40*8 {
+		t.Errorf("Unexpected length %d", n)
+	}
+	data = make([]byte, 70)
+	if n := len(encodeAztec(data, bitWriter{}).bits); n != 5+5+11+70*8 {
+		t.Errorf("Unexpected length %d", n)
+	}
+	data = make([]byte, 2100)
+	if n := len(encodeAztec(data, bitWriter{}).bits); n != 5+5+11+5+5+2100*8 {
+		t.Errorf("Unexpected length %d", n)
+	}
+}
@@ -0,0 +1,97 @@
+package barcode
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStuffAztecBits(t *testing.T) {
+	bits := make([]bool, 6)
+	words := stuffAztecBits(bits, 6)
+	if !equalCodewords(words, []int{1, 31}) {
+		t.Errorf("Unexpected words %v", words)
+	}
+	for i := range bits {
+		bits[i] = true
+	}
+	words = stuffAztecBits(bits, 6)
+	if !equalCodewords(words, []int{62, 62}) {
+		t.Errorf("Unexpected words %v", words)
+	}
+}
+
+func TestAztecModeMessage(t *testing.T) {
+	// Compact with 1 layer and 1 codeword: 8 data bits and 5 check words
+	mode := azModeMessage(true, 1, 1)
+	if len(mode) != 28 {
+		t.Fatalf("Unexpected length %d", len(mode))
+	}
+	for _, b := range mode[:8] {
+		if b {
+			t.Errorf("Unexpected mode message %v", mode)
+			break
+		}
+	}
+	if len(azModeMessage(false, 4, 100)) != 40 {
+		t.Errorf("Unexpected length of full-range mode message")
+	}
+}
+
+func TestAztecSize(t *testing.T) {
+	data := []struct {
+		text    string
+		compact bool
+		layers  int
+		size    int
+	}{
+		{"A", true, 1, 15},
+		{"Hello World 123", true, 2, 19},
+		{strings.Repeat("0123456789", 10), true, 4, 27},
+		{strings.Repeat("ABCDEFGHIJ", 20), false, 6, 41},
+	}
+	for _, d := range data {
+		code, err := AztecFromString(d.text, 23)
+		if err != nil || code.Compact() != d.compact || code.Layers() != d.layers || code.Size() != d.size {
+			t.Errorf("Unexpected symbol for %q: %v %d %d %v", d.text, code.Compact(), code.Layers(), code.Size(), err)
+		}
+	}
+}
+
+func TestAztecBullseye(t *testing.T) {
+	code, _ := AztecFromString("A", 23)
+	c := code.Size() / 2
+	for d := -5; d <= 5; d++ {
+		// Alternating rings, the center being dark
+		ring := absInt(d)
+		if code.Module(c+d, c) != (ring%2 == 0) || code.Module(c, c+d) != (ring%2 == 0) {
+			t.Errorf("Unexpected bullseye at %d", d)
+		}
+	}
+	// Orientation marks
+	if !code.Module(c-5, c-5) || !code.Module(c-4, c-5) || !code.Module(c+5, c+4) || code.Module(c-5, c+5) {
+		t.Errorf("Unexpected orientation marks")
+	}
+}
+
+func TestAztecErrors(t *testing.T) {
+	if _, err := AztecFromString("A", 91); err == nil {
+		t.Errorf("Unexpected error correction of 91%%")
+	}
+	if _, err := AztecFromBytes(make([]byte, 2000), 23); err == nil {
+		t.Errorf("Unexpected fit of 2000 bytes")
+	}
+	// More error correction needs more layers
+	low, _ := AztecFromString(strings.Repeat("A", 50), 5)
+	high, _ := AztecFromString(strings.Repeat("A", 50), 80)
+	if low.Layers() >= high.Layers() {
+		t.Errorf("Unexpected layers %d %d", low.Layers(), high.Layers())
+	}
+}
+
+func TestAztecUTF8(t *testing.T) {
+	latin, _ := AztecFromString("é", 23)
+	utf, _ := AztecFromString("Ω", 23)
+	if latin.Size() != 15 || utf.Size() != 15 {
+		t.Errorf("Unexpected sizes %d %d", latin.Size(), utf.Size())
+	}
+}
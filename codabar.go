@@ -0,0 +1,179 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+	"strings"
+)
+
+// codabarChars lists the characters of Codabar by value, the last four being the
+// start/stop characters.
+const codabarChars = "0123456789-$:/.+ABCD"
+
+// codabarTable gives the wide (1) and narrow (0) elements of each character, from
+// the most significant bit, alternating bars and spaces.
+var codabarTable = [20]int{
+	0x003, 0x006, 0x009, 0x060, 0x012, 0x042, 0x021, 0x024, 0x030, 0x048,
+	0x00c, 0x018, 0x045, 0x051, 0x054, 0x015, 0x01a, 0x029, 0x00b, 0x00e,
+}
+
+const (
+	// Quiet zone of Codabar, 10 times the narrow bar
+	codabarQuietZone = 10 * narrowBarSize
+	// Number of data characters, before the start/stop characters
+	codabarDataChars = 16
+)
+
+var (
+	errInvalidCodabar          = errors.New("Character cannot be encoded in Codabar")
+	errInvalidCodabarStartStop = errors.New("Invalid Codabar start/stop character")
+)
+
+// Codabar is a Codabar (NW-7) symbol.
+type Codabar struct {
+	// Encoded characters, without the start/stop characters and check character
+	data          string
+	start, stop   byte
+	check         bool
+	ratio         float64
+	gap           float64
+	showStartStop bool
+}
+
+// CodabarFromString encodes digits and -$:/.+ between A start/stop characters,
+// with a wide to narrow ratio of 3 and a gap of one narrow element.
+func CodabarFromString(text string) (Codabar, error) {
+	for _, c := range text {
+		if i := strings.IndexRune(codabarChars, c); i < 0 || i >= codabarDataChars {
+			return Codabar{}, errInvalidCodabar
+		}
+	}
+	return Codabar{
+		data:  text,
+		start: 'A',
+		stop:  'A',
+		ratio: 3,
+		gap:   1,
+	}, nil
+}
+
+// String returns the encoded text, without the start/stop characters.
+func (code Codabar) String() string {
+	return code.data
+}
+
+// WithStartStop returns a copy with the start and stop characters, each one of A,
+// B, C and D.
+func (code Codabar) WithStartStop(start, stop byte) (Codabar, error) {
+	for _, c := range []byte{start, stop} {
+		if strings.IndexByte(codabarChars, c) < codabarDataChars {
+			return Codabar{}, errInvalidCodabarStartStop
+		}
+	}
+	code.start, code.stop = start, stop
+	return code, nil
+}
+
+// WithChecksum returns a copy with a modulo 16 check character before the stop
+// character.
+func (code Codabar) WithChecksum() Codabar {
+	code.check = true
+	return code
+}
+
+// WithRatio returns a copy of the symbol with the wide to narrow ratio, between 2 and 3.
+// The ratio is rounded up to the next quarter when rendering.
+func (code Codabar) WithRatio(ratio float64) (Codabar, error) {
+	if ratio < 2 || ratio > 3 {
+		return Codabar{}, errInvalidRatio
+	}
+	code.ratio = ratio
+	return code, nil
+}
+
+// WithGap returns a copy with an intercharacter gap of gap narrow elements,
+// between 1 and 5. The gap is rounded up to the next quarter when rendering.
+func (code Codabar) WithGap(gap float64) (Codabar, error) {
+	if gap < 1 || gap > 5 {
+		return Codabar{}, errInvalidGap
+	}
+	code.gap = gap
+	return code, nil
+}
+
+// WithStartStopText returns a copy that shows or hides the start/stop characters
+// around the human readable text. They are hidden by default.
+func (code Codabar) WithStartStopText(show bool) Codabar {
+	code.showStartStop = show
+	return code
+}
+
+// checksum returns the modulo 16 check character, which brings the sum of all the
+// values, start/stop characters included, to a multiple of 16.
+func (code Codabar) checksum() byte {
+	sum := 0
+	for _, c := range string(code.start) + code.data + string(code.stop) {
+		sum += strings.IndexRune(codabarChars, c)
+	}
+	return codabarChars[(16-sum%16)%16]
+}
+
+func (code Codabar) widths() []int {
+	data := code.data
+	if code.check {
+		data += string(code.checksum())
+	}
+	n := ratioUnit(code.ratio, code.gap)
+	w := wideBarSize(code.ratio, n)
+	gap := wideBarSize(code.gap, n)
+	var widths []int
+	for i, c := range string(code.start) + data + string(code.stop) {
+		if i > 0 {
+			widths = append(widths, gap)
+		}
+		pattern := codabarTable[strings.IndexRune(codabarChars, c)]
+		for j := 6; j >= 0; j-- {
+			if pattern>>uint(j)&1 != 0 {
+				widths = append(widths, w)
+			} else {
+				widths = append(widths, n)
+			}
+		}
+	}
+	return widths
+}
+
+func (code Codabar) symbol() linearSymbol {
+	text := humanReadable(code.data)
+	if code.showStartStop {
+		text = string(code.start) + text + string(code.stop)
+	}
+	return linearSymbol{
+		widths:      code.widths(),
+		quietZone:   codabarQuietZone * ratioUnit(code.ratio, code.gap) / narrowBarSize,
+		text:        text,
+		bearerStyle: BearerNone,
+	}
+}
+
+func (code Codabar) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := code.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+func (code Codabar) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := code.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
@@ -0,0 +1,77 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestCodabarTable(t *testing.T) {
+	// The data characters have 2 or 3 wide elements, the start/stop characters 3
+	for i, pattern := range codabarTable {
+		wide := 0
+		for j := 0; j < 7; j++ {
+			wide += pattern >> uint(j) & 1
+		}
+		if wide < 2 || wide > 3 || (i >= 12 && wide != 3) {
+			t.Errorf("Unexpected pattern of %c", codabarChars[i])
+		}
+	}
+}
+
+func TestCodabarChecksum(t *testing.T) {
+	code, _ := CodabarFromString("37859")
+	code, _ = code.WithStartStop('A', 'B')
+	if c := code.checksum(); c != '+' {
+		t.Errorf("Unexpected check character %c", c)
+	}
+}
+
+func TestCodabarWidths(t *testing.T) {
+	code, _ := CodabarFromString("1")
+	code, _ = code.WithStartStop('C', 'D')
+	code, _ = code.WithRatio(2.5)
+	code, _ = code.WithGap(2)
+	n, w := narrowBarSize, wideBarSize(2.5, narrowBarSize)
+	expected := []int{
+		n, n, n, w, n, w, w, // C
+		2 * n,
+		n, n, n, n, w, w, n, // 1
+		2 * n,
+		n, n, n, w, w, w, n, // D
+	}
+	widths := code.widths()
+	if !equalCodewords(widths, expected) {
+		t.Errorf("Unexpected widths %v", widths)
+	}
+	if len(code.WithChecksum().widths()) != len(widths)+8 {
+		t.Errorf("Missing check character")
+	}
+}
+
+func TestCodabarInvalid(t *testing.T) {
+	for _, text := range []string{"12A34", "1 2", "x"} {
+		if _, err := CodabarFromString(text); err == nil {
+			t.Errorf("Unexpected valid text %q", text)
+		}
+	}
+	code, _ := CodabarFromString("1")
+	if _, err := code.WithStartStop('A', 'E'); err == nil {
+		t.Errorf("Unexpected valid start/stop characters")
+	}
+	if _, err := code.WithStartStop('1', 'A'); err == nil {
+		t.Errorf("Unexpected valid start/stop characters")
+	}
+	if _, err := code.WithRatio(1.5); err == nil {
+		t.Errorf("Unexpected valid ratio")
+	}
+}
+
+func TestCodabarText(t *testing.T) {
+	code, _ := CodabarFromString("123")
+	code, _ = code.WithStartStop('B', 'C')
+	if s := code.symbol(); s.text != "123" {
+		t.Errorf("Unexpected text %q", s.text)
+	}
+	if s := code.WithStartStopText(true).symbol(); s.text != "B123C" {
+		t.Errorf("Unexpected text %q", s.text)
+	}
+}
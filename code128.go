@@ -0,0 +1,262 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+	"strings"
+)
+
+// code128Table gives the widths of the bars and spaces of each symbol value, in modules.
+var code128Table = [107]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+// Code sets of Code 128
+const (
+	code128SetA = iota
+	code128SetB
+	code128SetC
+)
+
+// Symbol values with a special meaning
+const (
+	code128FNC3Value   = 96
+	code128FNC2Value   = 97
+	code128ShiftValue  = 98
+	code128CodeCValue  = 99
+	code128FNC1Value   = 102
+	code128StartAValue = 103
+	code128StopValue   = 106
+)
+
+// Function characters that can be passed to the encoder along with the Latin-1 characters
+const (
+	code128FNC1 = 256 + iota
+	code128FNC2
+	code128FNC3
+)
+
+// code128LatchValues gives the value switching to a code set, indexed by the current set.
+var code128LatchValues = [3][3]int{
+	{-1, 100, 99},
+	{101, -1, 99},
+	{101, 100, -1},
+}
+
+// code128FNC4Values gives the value of FNC4 in code sets A and B.
+var code128FNC4Values = [2]int{101, 100}
+
+const code128QuietZone = 10 * narrowBarSize
+
+// code128Value returns the values encoding c in code set A or B, preceded by FNC4 for
+// the upper half of Latin-1. It returns nil if c cannot be encoded in the set.
+func code128Value(set int, c int) []int {
+	switch c {
+	case code128FNC1:
+		return []int{code128FNC1Value}
+	case code128FNC2:
+		return []int{code128FNC2Value}
+	case code128FNC3:
+		return []int{code128FNC3Value}
+	}
+	var prefix []int
+	if c >= 128 && c < 256 {
+		prefix = []int{code128FNC4Values[set]}
+		c -= 128
+	}
+	switch {
+	case c >= 32 && c < 96:
+		return append(prefix, c-32)
+	case set == code128SetA && c >= 0 && c < 32:
+		return append(prefix, c+64)
+	case set == code128SetB && c >= 96 && c < 128:
+		return append(prefix, c-32)
+	}
+	return nil
+}
+
+func isDigitChar(c int) bool {
+	return c >= '0' && c <= '9'
+}
+
+// code128Step encodes the data at the start of data in the given set, returning the
+// values and the number of characters consumed, or nil if it cannot be encoded.
+// In code sets A and B, a character of the other set is encoded with a shift.
+func code128Step(set int, data []int) ([]int, int) {
+	if set == code128SetC {
+		if data[0] == code128FNC1 {
+			return []int{code128FNC1Value}, 1
+		}
+		if len(data) >= 2 && isDigitChar(data[0]) && isDigitChar(data[1]) {
+			return []int{(data[0]-'0')*10 + data[1] - '0'}, 2
+		}
+		return nil, 0
+	}
+	if v := code128Value(set, data[0]); v != nil {
+		return v, 1
+	}
+	if data[0] < 128 {
+		if v := code128Value(1-set, data[0]); v != nil {
+			return append([]int{code128ShiftValue}, v...), 1
+		}
+	}
+	return nil, 0
+}
+
+var (
+	errInvalidCode128 = errors.New("Character cannot be encoded in Code 128")
+	errEmptyCode128   = errors.New("Code 128 needs at least one character")
+)
+
+// encodeCode128 returns the symbol values encoding data, from the start character to the
+// checksum. The code sets are chosen to produce the shortest symbol.
+func encodeCode128(data []int) ([]int, error) {
+	n := len(data)
+	// cost[i][s] is the minimum number of values to encode data[i:] in set s, and
+	// next[i][s] is the set used to encode data[i], latching to it if different from s.
+	cost := make([][3]int, n+1)
+	next := make([][3]int, n+1)
+	const infinity = 1 << 30
+	for i := n - 1; i >= 0; i-- {
+		var stay [3]int
+		for s := 0; s < 3; s++ {
+			stay[s] = infinity
+			if v, k := code128Step(s, data[i:]); v != nil {
+				stay[s] = len(v) + cost[i+k][s]
+			}
+		}
+		for s := 0; s < 3; s++ {
+			cost[i][s], next[i][s] = stay[s], s
+			for t := 0; t < 3; t++ {
+				if t != s && stay[t] != infinity && 1+stay[t] < cost[i][s] {
+					cost[i][s], next[i][s] = 1+stay[t], t
+				}
+			}
+			if cost[i][s] >= infinity {
+				return nil, errInvalidCode128
+			}
+		}
+	}
+	// The start character selects the first set, preferring C then B on ties
+	set := code128SetC
+	for _, s := range []int{code128SetB, code128SetA} {
+		if cost[0][s] < cost[0][set] {
+			set = s
+		}
+	}
+	values := []int{code128StartAValue + set}
+	for i := 0; i < n; {
+		if t := next[i][set]; t != set {
+			values = append(values, code128LatchValues[set][t])
+			set = t
+		}
+		v, k := code128Step(set, data[i:])
+		values = append(values, v...)
+		i += k
+	}
+	checksum := values[0]
+	for i, v := range values[1:] {
+		checksum += (i + 1) * v
+	}
+	return append(values, checksum%103), nil
+}
+
+func code128Widths(values []int) []int {
+	var widths []int
+	// Append to a copy, values may share its array with the caller
+	for _, v := range append(append([]int(nil), values...), code128StopValue) {
+		for _, w := range code128Table[v] {
+			widths = append(widths, int(w-'0')*narrowBarSize)
+		}
+	}
+	return widths
+}
+
+// Code128 is a Code 128 symbol encoding Latin-1 text.
+type Code128 struct {
+	values []int
+	text   string
+}
+
+// Code128FromString encodes text, which may contain any Latin-1 character and must
+// not be empty.
+func Code128FromString(text string) (Code128, error) {
+	if text == "" {
+		return Code128{}, errEmptyCode128
+	}
+	var data []int
+	for _, c := range text {
+		if c > 255 {
+			return Code128{}, errInvalidCode128
+		}
+		data = append(data, int(c))
+	}
+	return code128FromData(data, text)
+}
+
+func code128FromData(data []int, text string) (Code128, error) {
+	values, err := encodeCode128(data)
+	if err != nil {
+		return Code128{}, err
+	}
+	return Code128{
+		values: values,
+		text:   text,
+	}, nil
+}
+
+// String returns the human readable text.
+func (code Code128) String() string {
+	return code.text
+}
+
+// humanReadable drops the control characters, which cannot be printed.
+func humanReadable(text string) string {
+	return strings.Map(func(c rune) rune {
+		if c < 32 || c == 127 {
+			return -1
+		}
+		return c
+	}, text)
+}
+
+func (code Code128) symbol() linearSymbol {
+	return linearSymbol{
+		widths:      code128Widths(code.values),
+		quietZone:   code128QuietZone,
+		text:        humanReadable(code.text),
+		bearerStyle: BearerNone,
+	}
+}
+
+func (code Code128) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := code.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+func (code Code128) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := code.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
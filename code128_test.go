@@ -0,0 +1,102 @@
+package barcode
+
+import (
+	"image"
+	"testing"
+)
+
+func TestCode128Table(t *testing.T) {
+	for v, pattern := range code128Table {
+		sum := 0
+		for _, w := range pattern {
+			sum += int(w - '0')
+		}
+		if (v == code128StopValue && sum != 13) || (v != code128StopValue && sum != 11) {
+			t.Errorf("Unexpected width %d of value %d", sum, v)
+		}
+	}
+}
+
+func TestEncodeCode128(t *testing.T) {
+	data := []struct {
+		text   string
+		values []int
+	}{
+		{"Wikipedia", []int{104, 55, 73, 75, 73, 80, 69, 68, 73, 65, 88}},
+		{"123456", []int{105, 12, 34, 56, -1}},
+		{"a\nb", []int{104, 65, 98, 74, 66, -1}},
+		{"\x01\x02AB", []int{103, 65, 66, 33, 34, -1}},
+		{"AB123456", []int{104, 33, 34, 99, 12, 34, 56, -1}},
+		{"é", []int{104, 100, 73, -1}},
+	}
+	for _, d := range data {
+		code, err := Code128FromString(d.text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(code.values) != len(d.values) {
+			t.Fatalf("Unexpected values of %q: %v", d.text, code.values)
+		}
+		for i, v := range d.values {
+			if v >= 0 && code.values[i] != v {
+				t.Fatalf("Unexpected values of %q: %v", d.text, code.values)
+			}
+		}
+	}
+}
+
+func TestCode128Widths(t *testing.T) {
+	// The stop character must not overwrite the array shared with values
+	values := []int{104, 33, 47, 0}[:3]
+	code128Widths(values)
+	if values[:4][3] != 0 {
+		t.Errorf("Unexpected write to the values array %v", values[:4])
+	}
+}
+
+func TestEncodeCode128FNC1(t *testing.T) {
+	values, err := encodeCode128([]int{code128FNC1, '0', '1', '2', '3'})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values[0] != 105 || values[1] != code128FNC1Value || values[2] != 1 || values[3] != 23 {
+		t.Errorf("Unexpected values %v", values)
+	}
+}
+
+func TestCode128Invalid(t *testing.T) {
+	if _, err := Code128FromString("Ā"); err == nil {
+		t.Errorf("Unexpected valid text")
+	}
+	if _, err := Code128FromString(""); err != errEmptyCode128 {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestRenderCode128Latin1Text(t *testing.T) {
+	code, err := Code128FromString("Gr\u00f6\u00dfe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bound := image.Rect(0, 0, 400, 100)
+	img := image.NewGray(bound)
+	if err := code.RenderImage(img, bound, 0); err != nil {
+		t.Fatal(err)
+	}
+	// The 5 characters are drawn with the glyphs of their base letters
+	s := code.symbol()
+	expected := image.NewGray(bound)
+	r, err := newBitmapRenderer(expected, bound, 0, s.layout())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rect, fontSize := r.Start().translateText(0, s.layout().width, 5)
+	r.DrawText("Grose", rect, fontSize)
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			if img.GrayAt(x, y) != expected.GrayAt(x, y) {
+				t.Fatalf("Unexpected text pixel at %d, %d", x, y)
+			}
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+	"strings"
+)
+
+// code39Chars lists the characters of Code 39 by value, followed by the start/stop
+// character.
+const code39Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ-. $/+%*"
+
+// code39Table gives the wide (1) and narrow (0) elements of each character, from
+// the most significant bit, alternating bars and spaces.
+var code39Table = [44]int{
+	0x034, 0x121, 0x061, 0x160, 0x031, 0x130, 0x070, 0x025, 0x124, 0x064,
+	0x109, 0x049, 0x148, 0x019, 0x118, 0x058, 0x00d, 0x10c, 0x04c, 0x01c,
+	0x103, 0x043, 0x142, 0x013, 0x112, 0x052, 0x007, 0x106, 0x046, 0x016,
+	0x181, 0x0c1, 0x1c0, 0x091, 0x190, 0x0d0, 0x085, 0x184, 0x0c4, 0x0a8,
+	0x0a2, 0x08a, 0x02a, 0x094,
+}
+
+// code39FullASCII gives the pair of characters encoding each ASCII character in Full
+// ASCII Code 39, or the character itself.
+var code39FullASCII [128]string
+
+func init() {
+	shifted := func(first, last int, shift byte, from byte) {
+		for c := first; c <= last; c++ {
+			code39FullASCII[c] = string([]byte{shift, from + byte(c-first)})
+		}
+	}
+	for c := range code39FullASCII {
+		code39FullASCII[c] = string(rune(c))
+	}
+	code39FullASCII[0] = "%U"
+	shifted(1, 26, '$', 'A')
+	shifted(27, 31, '%', 'A')
+	shifted('!', ',', '/', 'A')
+	code39FullASCII['/'] = "/O"
+	code39FullASCII[':'] = "/Z"
+	shifted(';', '?', '%', 'F')
+	code39FullASCII['@'] = "%V"
+	shifted('[', '_', '%', 'K')
+	code39FullASCII['`'] = "%W"
+	shifted('a', 'z', '+', 'A')
+	shifted('{', 127, '%', 'P')
+}
+
+const (
+	// Quiet zone of Code 39, 10 times the narrow bar
+	code39QuietZone = 10 * narrowBarSize
+	code39StartStop = '*'
+)
+
+var (
+	errInvalidCode39 = errors.New("Character cannot be encoded in Code 39")
+	errEmptyCode39   = errors.New("Code 39 needs at least one character")
+	errInvalidGap    = errors.New("Invalid intercharacter gap")
+)
+
+// Code39 is a Code 39 symbol.
+type Code39 struct {
+	// Encoded characters, without the start/stop characters and check character
+	data string
+	// Human readable text
+	text          string
+	check         bool
+	ratio         float64
+	gap           float64
+	showStartStop bool
+}
+
+// Code39FromString encodes text made of digits, upper case letters, space and
+// -.$/+%, with a wide to narrow ratio of 3 and a gap of one narrow element. The
+// text must not be empty.
+func Code39FromString(text string) (Code39, error) {
+	if text == "" {
+		return Code39{}, errEmptyCode39
+	}
+	for _, c := range text {
+		if c == code39StartStop || !strings.ContainsRune(code39Chars, c) {
+			return Code39{}, errInvalidCode39
+		}
+	}
+	return code39FromData(text, text), nil
+}
+
+// Code39FullASCIIFromString encodes ASCII text in Full ASCII Code 39, where the
+// characters missing from Code 39 are encoded as pairs of characters.
+func Code39FullASCIIFromString(text string) (Code39, error) {
+	if text == "" {
+		return Code39{}, errEmptyCode39
+	}
+	var data []string
+	for _, c := range text {
+		if c >= 128 {
+			return Code39{}, errInvalidCode39
+		}
+		data = append(data, code39FullASCII[c])
+	}
+	return code39FromData(strings.Join(data, ""), text), nil
+}
+
+func code39FromData(data, text string) Code39 {
+	return Code39{
+		data:  data,
+		text:  text,
+		ratio: 3,
+		gap:   1,
+	}
+}
+
+// String returns the encoded text.
+func (code Code39) String() string {
+	return code.text
+}
+
+// WithChecksum returns a copy with a modulo 43 check character after the data.
+func (code Code39) WithChecksum() Code39 {
+	code.check = true
+	return code
+}
+
+// WithRatio returns a copy of the symbol with the wide to narrow ratio, between 2 and 3.
+// The ratio is rounded up to the next quarter when rendering.
+func (code Code39) WithRatio(ratio float64) (Code39, error) {
+	if ratio < 2 || ratio > 3 {
+		return Code39{}, errInvalidRatio
+	}
+	code.ratio = ratio
+	return code, nil
+}
+
+// WithGap returns a copy with an intercharacter gap of gap narrow elements,
+// between 1 and 5. The gap is rounded up to the next quarter when rendering.
+func (code Code39) WithGap(gap float64) (Code39, error) {
+	if gap < 1 || gap > 5 {
+		return Code39{}, errInvalidGap
+	}
+	code.gap = gap
+	return code, nil
+}
+
+// WithStartStopText returns a copy that shows or hides the * start/stop characters
+// around the human readable text. They are hidden by default.
+func (code Code39) WithStartStopText(show bool) Code39 {
+	code.showStartStop = show
+	return code
+}
+
+// checksum returns the modulo 43 check character of the data.
+func (code Code39) checksum() byte {
+	sum := 0
+	for _, c := range code.data {
+		sum += strings.IndexRune(code39Chars, c)
+	}
+	return code39Chars[sum%43]
+}
+
+func (code Code39) widths() []int {
+	data := code.data
+	if code.check {
+		data += string(code.checksum())
+	}
+	n := ratioUnit(code.ratio, code.gap)
+	w := wideBarSize(code.ratio, n)
+	// The gap is rounded like a wide element
+	gap := wideBarSize(code.gap, n)
+	var widths []int
+	for i, c := range string(code39StartStop) + data + string(code39StartStop) {
+		if i > 0 {
+			widths = append(widths, gap)
+		}
+		pattern := code39Table[strings.IndexRune(code39Chars, c)]
+		for j := 8; j >= 0; j-- {
+			if pattern>>uint(j)&1 != 0 {
+				widths = append(widths, w)
+			} else {
+				widths = append(widths, n)
+			}
+		}
+	}
+	return widths
+}
+
+func (code Code39) symbol() linearSymbol {
+	text := humanReadable(code.text)
+	if code.showStartStop {
+		text = string(code39StartStop) + text + string(code39StartStop)
+	}
+	return linearSymbol{
+		widths:      code.widths(),
+		quietZone:   code39QuietZone * ratioUnit(code.ratio, code.gap) / narrowBarSize,
+		text:        text,
+		bearerStyle: BearerNone,
+	}
+}
+
+func (code Code39) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := code.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+func (code Code39) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := code.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
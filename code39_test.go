@@ -0,0 +1,120 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestCode39Table(t *testing.T) {
+	// Each character has 3 wide elements out of 9
+	for i, pattern := range code39Table {
+		wide := 0
+		for j := 0; j < 9; j++ {
+			wide += pattern >> uint(j) & 1
+		}
+		if wide != 3 {
+			t.Errorf("Unexpected pattern of %c", code39Chars[i])
+		}
+	}
+}
+
+func TestCode39Checksum(t *testing.T) {
+	code, _ := Code39FromString("CODE 39")
+	if c := code.checksum(); c != 'R' {
+		t.Errorf("Unexpected check character %c", c)
+	}
+}
+
+func TestCode39Widths(t *testing.T) {
+	code, _ := Code39FromString("A")
+	code, _ = code.WithRatio(2.5)
+	code, _ = code.WithGap(2)
+	n, w := narrowBarSize, wideBarSize(2.5, narrowBarSize)
+	expected := []int{
+		n, w, n, n, w, n, w, n, n, // *
+		2 * n,
+		w, n, n, n, n, w, n, n, w, // A
+		2 * n,
+		n, w, n, n, w, n, w, n, n, // *
+	}
+	widths := code.widths()
+	if !equalCodewords(widths, expected) {
+		t.Errorf("Unexpected widths %v", widths)
+	}
+	if len(code.WithChecksum().widths()) != len(widths)+10 {
+		t.Errorf("Missing check character")
+	}
+}
+
+func TestCode39FullASCII(t *testing.T) {
+	data := []struct {
+		text, data string
+	}{
+		{"Code39", "C+O+D+E39"},
+		{"a-b.c", "+A-+B.+C"},
+		{"\x00\x7f", "%U%T"},
+		{"!/:@`", "/A/O/Z%V%W"},
+	}
+	for _, d := range data {
+		code, err := Code39FullASCIIFromString(d.text)
+		if err != nil || code.data != d.data || code.String() != d.text {
+			t.Errorf("Unexpected data of %q: %q %v", d.text, code.data, err)
+		}
+	}
+	if _, err := Code39FullASCIIFromString("é"); err == nil {
+		t.Errorf("Unexpected valid text")
+	}
+}
+
+func TestCode39Invalid(t *testing.T) {
+	for _, text := range []string{"abc", "A*B", "A_B"} {
+		if _, err := Code39FromString(text); err == nil {
+			t.Errorf("Unexpected valid text %q", text)
+		}
+	}
+	if _, err := Code39FromString(""); err != errEmptyCode39 {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if _, err := Code39FullASCIIFromString(""); err != errEmptyCode39 {
+		t.Errorf("Unexpected error %v", err)
+	}
+	code, _ := Code39FromString("A")
+	if _, err := code.WithRatio(3.5); err == nil {
+		t.Errorf("Unexpected valid ratio")
+	}
+	if _, err := code.WithGap(0.5); err == nil {
+		t.Errorf("Unexpected valid gap")
+	}
+}
+
+func TestCode39Text(t *testing.T) {
+	code, _ := Code39FromString("ABC")
+	if s := code.symbol(); s.text != "ABC" {
+		t.Errorf("Unexpected text %q", s.text)
+	}
+	if s := code.WithStartStopText(true).symbol(); s.text != "*ABC*" {
+		t.Errorf("Unexpected text %q", s.text)
+	}
+}
+
+func TestCode39Ratio(t *testing.T) {
+	// The ratio is rounded up to the next quarter, the gap stays one narrow element
+	data := []struct {
+		ratio, rendered float64
+	}{
+		{2, 2},
+		{2.2, 2.25},
+		{2.25, 2.25},
+		{2.6, 2.75},
+		{3, 3},
+	}
+	for _, d := range data {
+		code, _ := Code39FromString("CODE 39")
+		code, err := code.WithRatio(d.ratio)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rendered := renderedRatio(t, code.RenderImage); rendered != d.rendered {
+			t.Errorf("Unexpected rendered ratio %v for %v", rendered, d.ratio)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+	"strings"
+)
+
+// code93Chars lists the characters of Code 93 by value. The four shift characters
+// of Extended Code 93, ($) (%) (/) and (+), are marked with the bytes 1 to 4.
+const code93Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ-. $/+%\x01\x02\x03\x04"
+
+// code93Table gives the modules of each character, from the most significant bit,
+// followed by the start/stop character.
+var code93Table = [48]int{
+	0x114, 0x148, 0x144, 0x142, 0x128, 0x124, 0x122, 0x150, 0x112, 0x10a,
+	0x1a8, 0x1a4, 0x1a2, 0x194, 0x192, 0x18a, 0x168, 0x164, 0x162, 0x134,
+	0x11a, 0x158, 0x14c, 0x146, 0x12c, 0x116, 0x1b4, 0x1b2, 0x1ac, 0x1a6,
+	0x196, 0x19a, 0x16c, 0x166, 0x136, 0x13a, 0x12e, 0x1d4, 0x1d2, 0x1ca,
+	0x16e, 0x176, 0x1ae, 0x126, 0x1da, 0x1d6, 0x132, 0x15e,
+}
+
+const (
+	// Quiet zone of Code 93, 10 times the module
+	code93QuietZone = 10 * narrowBarSize
+	code93StartStop = 47
+	// Modules of each character
+	code93CharModules = 9
+)
+
+// code93Shifts maps the shift characters of Full ASCII Code 39 to the ones of
+// Extended Code 93.
+var code93Shifts = strings.NewReplacer("$", "\x01", "%", "\x02", "/", "\x03", "+", "\x04")
+
+var errInvalidCode93 = errors.New("Character cannot be encoded in Code 93")
+
+// Code93 is a Code 93 symbol.
+type Code93 struct {
+	// Encoded characters, without the start/stop characters and check characters
+	data string
+	// Human readable text
+	text string
+}
+
+// Code93FromString encodes text made of digits, upper case letters, space and
+// -.$/+%.
+func Code93FromString(text string) (Code93, error) {
+	for _, c := range text {
+		if c < ' ' || !strings.ContainsRune(code93Chars, c) {
+			return Code93{}, errInvalidCode93
+		}
+	}
+	return Code93{data: text, text: text}, nil
+}
+
+// Code93ExtendedFromString encodes ASCII text in Extended Code 93, where the
+// characters missing from Code 93 are encoded as a shift character followed by a
+// letter.
+func Code93ExtendedFromString(text string) (Code93, error) {
+	var data []string
+	for _, c := range text {
+		if c >= 128 {
+			return Code93{}, errInvalidCode93
+		}
+		if c >= ' ' && strings.ContainsRune(code93Chars, c) {
+			data = append(data, string(c))
+			continue
+		}
+		// The pairs are the ones of Full ASCII Code 39, which lacks $ % + and /
+		pair := code39FullASCII[c]
+		if len(pair) == 2 {
+			pair = code93Shifts.Replace(pair[:1]) + pair[1:]
+		}
+		data = append(data, pair)
+	}
+	return Code93{data: strings.Join(data, ""), text: text}, nil
+}
+
+// String returns the encoded text.
+func (code Code93) String() string {
+	return code.text
+}
+
+// values returns the values of the data characters.
+func (code Code93) values() []int {
+	values := make([]int, len(code.data))
+	for i := range code.data {
+		values[i] = strings.IndexByte(code93Chars, code.data[i])
+	}
+	return values
+}
+
+// code93Checksum returns the modulo 47 check value of values, weighted from 1 to
+// maxWeight from the right.
+func code93Checksum(values []int, maxWeight int) int {
+	sum := 0
+	for i := range values {
+		sum += values[len(values)-1-i] * (i%maxWeight + 1)
+	}
+	return sum % 47
+}
+
+// checksums returns the C and K check values.
+func (code Code93) checksums() (int, int) {
+	values := code.values()
+	c := code93Checksum(values, 20)
+	k := code93Checksum(append(values, c), 15)
+	return c, k
+}
+
+func (code Code93) widths() []int {
+	values := code.values()
+	c, k := code.checksums()
+	values = append([]int{code93StartStop}, values...)
+	values = append(values, c, k, code93StartStop)
+	var widths []int
+	for _, v := range values {
+		// Run lengths of the modules, starting with a bar
+		pattern, run := code93Table[v], 0
+		for j := code93CharModules - 1; j >= 0; j-- {
+			run++
+			if j == 0 || pattern>>uint(j)&1 != pattern>>uint(j-1)&1 {
+				widths = append(widths, run*narrowBarSize)
+				run = 0
+			}
+		}
+	}
+	// Termination bar
+	return append(widths, narrowBarSize)
+}
+
+func (code Code93) symbol() linearSymbol {
+	return linearSymbol{
+		widths:      code.widths(),
+		quietZone:   code93QuietZone,
+		text:        humanReadable(code.text),
+		bearerStyle: BearerNone,
+	}
+}
+
+func (code Code93) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := code.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+func (code Code93) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := code.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
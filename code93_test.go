@@ -0,0 +1,73 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestCode93Table(t *testing.T) {
+	// Each character has 3 bars and 3 spaces over 9 modules, starting with a bar
+	for i, pattern := range code93Table {
+		runs := 1
+		for j := 8; j > 0; j-- {
+			if pattern>>uint(j)&1 != pattern>>uint(j-1)&1 {
+				runs++
+			}
+		}
+		if pattern>>8 != 1 || pattern&1 != 0 || runs != 6 {
+			t.Errorf("Unexpected pattern of value %d", i)
+		}
+	}
+}
+
+func TestCode93Checksums(t *testing.T) {
+	code, _ := Code93FromString("TEST93")
+	if c, k := code.checksums(); code93Chars[c] != '+' || code93Chars[k] != '6' {
+		t.Errorf("Unexpected check characters %d %d", c, k)
+	}
+}
+
+func TestCode93Widths(t *testing.T) {
+	code, _ := Code93FromString("A")
+	n := narrowBarSize
+	expected := []int{
+		n, n, n, n, 4 * n, n, // start
+		2 * n, n, n, n, n, 3 * n, // A
+		2 * n, n, n, n, n, 3 * n, // C check character A
+		2 * n, 2 * n, n, n, 2 * n, n, // K check character U
+		n, n, n, n, 4 * n, n, // stop
+		n,
+	}
+	widths := code.widths()
+	if !equalCodewords(widths, expected) {
+		t.Errorf("Unexpected widths %v", widths)
+	}
+}
+
+func TestCode93Extended(t *testing.T) {
+	data := []struct {
+		text, data string
+	}{
+		{"Code93", "C\x04O\x04D\x04E93"},
+		{"a$b", "\x04A$\x04B"},
+		{"\x00\x7f", "\x02U\x02T"},
+		{"!/:@`", "\x03A/\x03Z\x02V\x02W"},
+		{"$%+/ -.", "$%+/ -."},
+	}
+	for _, d := range data {
+		code, err := Code93ExtendedFromString(d.text)
+		if err != nil || code.data != d.data || code.String() != d.text {
+			t.Errorf("Unexpected data of %q: %q %v", d.text, code.data, err)
+		}
+	}
+	if _, err := Code93ExtendedFromString("é"); err == nil {
+		t.Errorf("Unexpected valid text")
+	}
+}
+
+func TestCode93Invalid(t *testing.T) {
+	for _, text := range []string{"abc", "A*B", "A\x01B"} {
+		if _, err := Code93FromString(text); err == nil {
+			t.Errorf("Unexpected valid text %q", text)
+		}
+	}
+}
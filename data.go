@@ -1,3 +1,8 @@
+// Code generated for package barcode by go-bindata DO NOT EDIT. (@generated)
+// sources:
+// data/RangeMessage.xml
+// data/digits.gif
+// data/shiftjis.bin
 package barcode
 
 import (
@@ -7,13 +12,12 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
-	"path"
 	"path/filepath"
 	"strings"
 	"time"
 )
 
-func bindata_read(data []byte, name string) ([]byte, error) {
+func bindataRead(data []byte, name string) ([]byte, error) {
 	gz, err := gzip.NewReader(bytes.NewBuffer(data))
 	if err != nil {
 		return nil, fmt.Errorf("Read %q: %v", name, err)
@@ -21,11 +25,14 @@ func bindata_read(data []byte, name string) ([]byte, error) {
 
 	var buf bytes.Buffer
 	_, err = io.Copy(&buf, gz)
-	gz.Close()
+	clErr := gz.Close()
 
 	if err != nil {
 		return nil, fmt.Errorf("Read %q: %v", name, err)
 	}
+	if clErr != nil {
+		return nil, err
+	}
 
 	return buf.Bytes(), nil
 }
@@ -35,48 +42,99 @@ type asset struct {
 	info  os.FileInfo
 }
 
-type bindata_file_info struct {
+type bindataFileInfo struct {
 	name    string
 	size    int64
 	mode    os.FileMode
 	modTime time.Time
 }
 
-func (fi bindata_file_info) Name() string {
+// Name return file name
+func (fi bindataFileInfo) Name() string {
 	return fi.name
 }
-func (fi bindata_file_info) Size() int64 {
+
+// Size return file size
+func (fi bindataFileInfo) Size() int64 {
 	return fi.size
 }
-func (fi bindata_file_info) Mode() os.FileMode {
+
+// Mode return file mode
+func (fi bindataFileInfo) Mode() os.FileMode {
 	return fi.mode
 }
-func (fi bindata_file_info) ModTime() time.Time {
+
+// Mode return file modify time
+func (fi bindataFileInfo) ModTime() time.Time {
 	return fi.modTime
 }
-func (fi bindata_file_info) IsDir() bool {
-	return false
+
+// IsDir return file whether a directory
+func (fi bindataFileInfo) IsDir() bool {
+	return fi.mode&os.ModeDir != 0
 }
-func (fi bindata_file_info) Sys() interface{} {
+
+// Sys return file is sys mode
+func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _digits_gif = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x97\x77\x5c\x53\xd9\xb6\xc7\xf7\x39\x27\xbd\x50\x92\x10\x01\x29\xa1\x37\xa9\x0a\x04\x10\x29\xa1\x45\x40\x8a\x48\x13\x95\x98\x04\x08\x25\x60\x48\x88\x62\x57\x44\x05\x46\x14\x15\x29\x56\x64\x54\x44\xc1\xd1\xa1\xc8\x28\x2a\xa2\x58\x10\x05\x15\xec\x13\x64\x10\xb0\x0d\x16\x54\x44\xcd\x3b\xd1\xcf\x67\xc6\xf7\xde\xbd\x77\x66\xee\xef\x0f\xce\x77\xaf\xb5\xf6\x66\xef\xb5\xd6\xc9\x67\x9f\x40\x6e\x00\xdb\x95\x17\x00\x48\x60\x0b\x50\x49\xa9\x54\x7e\x7d\x02\xa3\x71\x0c\x11\x00\x18\x18\x29\xc9\x5c\x0e\x27\x32\xd0\x37\xd0\xd1\xc1\xd1\x09\x75\x52\x82\x42\x44\xe2\x4c\x58\x03\x80\x0c\xb1\x54\x82\x3a\x58\xb1\x71\xf1\x2c\x7c\x17\x1a\x4b\x04\x38\xe0\x08\x00\x8f\x9f\x9d\x15\x3a\x3b\x20\x4a\xb5\x0c\xd7\x9f\xc3\xca\x46\x83\xc0\xff\xd2\xbb\x5e\x00\xa9\x9e\x37\x6d\x83\xc2\x59\x2c\xf0\xcf\xa4\xc9\xcf\x92\x48\x01\x80\xc2\x51\x9e\x2a\x10\x66\xf3\x51\xce\x43\x39\x5d\x2e\xcd\x52\xd9\x47\x51\x66\x2c\x4c\x53\x31\x8c\x51\xb1\x04\xdd\x20\xca\xda\x2a\x4e\xfe\xc6\x53\xbe\xc6\x7c\x63\x6f\x15\x0b\x32\xc4\x02\x94\x55\x7b\xce\x12\x64\x08\x54\xdc\x8e\xf2\x86\x1c\x99\x10\x65\x24\x04\xe5\xfc\x1c\x91\x50\x29\x47\x07\xb7\xd0\x81\x69\xba\x2c\x43\x84\xf2\x07\xd5\xe4\x0c\x21\x2f\x1b\x00\x0c\x45\x65\x97\x0a\xf9\x29\x28\x3b\xa0\x4c\x91\x44\x45\x72\x50\x9e\x0e\x00\x81\x92\xfc\x1d\x2f\xfc\x8e\xa5\xc2\xc5\x52\xd5\xa9\x38\x99\x59\x4b\x24\xa2\xe4\x14\x29\xcb\x92\x6f\xc5\x72\x74\x73\x63\xb3\x82\x84\xf2\x74\xa1\x54\x6a\x1b\xce\xe3\xa7\xf1\x24\x02\x16\x27\x33\x23\x8b\x27\x5e\x02\xc0\xb7\x43\x7f\x15\x4d\x95\x5c\x16\x9a\x65\x17\x47\x37\x17\x17\x5b\x27\x3b\xc7\xef\x32\xf5\x1f\x9d\x7f\x53\xaa\xe2\x7e\xa3\x37\x11\x00\x52\x35\x07\xc4\xec\xfc\xd3\xf8\xaf\x02\x33\xab\x00\x60\x8f\xa1\xc9\xd9\xfc\xa7\x6d\x61\x39\x00\x4d\xeb\x00\xd0\xbe\xf7\xa7\xcd\x74\x2f\x00\xea\x68\xe5\x1a\xaf\x7f\x77\x20\xa6\xaa\x63\x52\xa4\xd2\x2c\x77\x7b\x7b\xb9\x5c\x6e\x27\x12\xf2\xed\x54\x19\xfd\x43\x7f\x19\xf0\x37\xf4\xdd\xff\xb3\x53\x2d\xf7\x47\x7e\x58\x7e\xc2\x24\x9e\x2c\x5d\xca\x52\x25\x8e\x9f\x99\x9e\x29\x93\xb0\xb2\xb3\x78\x7c\x21\xcb\xf6\x6b\x1b\x2b\xbf\x5b\xe4\x9f\xcd\xfc\xcb\x8d\x4c\x89\x14\x26\x09\x25\x42\x31\x3a\x23\x5a\x24\x94\x8b\xc4\xc9\x68\xc1\xc5\x02\x91\x54\x94\x29\x66\x89\xc4\xff\xae\x8c\xff\xe5\xb4\xff\x23\xb4\xb5\xe5\x5f\x81\x5e\xfd\x05\x30\x16\xd8\x01\x8d\xeb\x0c\x80\xbc\xe8\x04\x18\x3a\x19\x20\x09\xbb\x51\x0f\xa4\x2a\xdc\xb7\xf3\x87\x10\xa3\x81\xea\xed\x8b\x31\x1c\xfa\xd6\xfa\x5f\x05\xfd\xff\x65\xe1\x62\xd5\x9f\x6c\x51\x32\x4b\x35\xe2\x44\x46\xb1\xf8\x32\x49\xce\x37\x9f\xea\xd5\x04\x58\xf4\x87\x47\x1d\x30\x80\x0e\x30\x00\x26\xc0\x12\xd8\x02\x27\xe0\x0a\x3c\x80\x37\xf0\x07\x33\x41\x18\x88\x02\x71\x60\x3e\xe0\x83\x14\x90\x01\x24\x40\x0e\x96\x81\xd5\x20\x1f\x14\x82\xcd\x60\x3b\xa8\x00\x7b\x40\x35\xa8\x01\x75\xe0\x38\x68\x02\xa7\xc0\x39\x70\x11\x5c\x05\x37\xc0\x6d\x70\x1f\x28\xc0\x20\x78\x06\x46\xc1\x3b\x30\x01\x41\x10\x1e\xa2\x42\x74\x48\x07\x32\x84\xcc\x20\x1b\xc8\x09\x62\x43\x33\x20\x7f\x28\x04\x8a\x84\xe2\xa0\x44\x28\x19\x12\x43\x32\x68\x19\xb4\x16\x2a\x84\x4a\xa0\x0a\x68\x1f\x54\x03\xfd\x04\x9d\x84\xce\x41\x97\xa1\x6e\xe8\x2e\xd4\x0f\x8d\x40\xaf\xa1\x8f\x30\x02\x53\x60\x25\x03\xd6\x87\xcd\x61\x7b\x98\x0d\xfb\xc0\xc1\x70\x14\x3c\x0f\x4e\x86\x17\xc1\xb9\x70\x1e\xbc\x09\x2e\x83\xab\xe0\x23\x70\x23\x7c\x0e\xbe\x0a\xdf\x86\x15\xf0\x33\x78\x0c\x01\x08\x19\x61\x22\x46\x88\x2d\xc2\x46\x38\x48\x18\x12\x8f\x24\x21\x12\x64\x05\x52\x80\x94\x22\x55\x48\x1d\xd2\x82\x74\x20\x37\x11\x05\xf2\x1c\x19\xc7\xe0\x30\x74\x0c\x0b\x63\x8b\xf1\xc0\x04\x61\xe6\x60\xf8\x98\x45\x98\x15\x98\x22\x4c\x05\xe6\x10\xa6\x11\xd3\x8e\xb9\x89\xe9\xc7\x8c\x62\xbe\x60\xa9\x58\x3d\xac\x0d\xd6\x1d\xcb\xc5\xc6\x62\x93\xb1\x72\x6c\x3e\xb6\x14\x7b\x00\xdb\x80\xbd\x80\xbd\x8d\x1d\xc4\xbe\xc3\xe1\x70\x4c\x9c\x05\xce\x15\x17\x84\x8b\xc3\xa5\xe2\x96\xe2\x8a\x70\xbb\x70\xf5\xb8\xb3\xb8\x6e\xdc\x00\x6e\x0c\x8f\xc7\xeb\xe0\x6d\xf0\x9e\xf8\x30\x3c\x0f\x2f\xc5\xe7\xe3\xcb\xf1\x47\xf0\x67\xf0\x3d\xf8\x41\xfc\x07\x02\x99\x60\x48\x70\x22\x04\x10\xe2\x09\x62\xc2\x1a\x42\x29\xe1\x30\xa1\x95\xd0\x43\x18\x22\x4c\x10\x35\x88\x66\x44\x77\x62\x18\x51\xa0\x24\x2e\x21\x16\x13\xab\x89\x2d\xc4\xeb\xc4\x41\xe2\x04\x49\x93\x64\x41\xf2\x24\x45\x91\x52\x49\xab\x49\x65\xa4\x3a\xd2\x05\xd2\x03\xd2\x1b\x32\x99\x6c\x4c\x76\x23\x47\x90\x45\xe4\x55\xe4\x32\xf2\x31\xf2\x25\x72\x3f\x79\x9c\x42\xa3\x58\x53\x38\x94\x04\x8a\x8c\xb2\x89\x72\x90\x72\x96\x72\x97\xf2\x86\x4a\xa5\x9a\x53\xbd\xa9\xf1\x54\x29\x75\x13\xb5\x86\x7a\x9e\xfa\x88\xfa\x41\x8d\xae\x66\xa7\xc6\x55\x13\xa8\xad\x54\xab\x54\x6b\x54\xeb\x51\x7b\xa9\x4e\x54\x37\x53\xf7\x51\x9f\xaf\x9e\xab\x5e\xaa\x7e\x42\xfd\xba\xfa\x73\x0d\xa2\x86\xb9\x06\x47\x83\xa7\xb1\x42\xa3\x52\xe3\xa4\x46\x9f\xc6\x98\x26\x5d\xd3\x51\x33\x4c\x33\x43\xb3\x48\xf3\xb0\xe6\x65\xcd\x61\x1a\x9e\x66\x4e\xf3\xa7\x09\x68\x79\xb4\xfd\xb4\xf3\xb4\x01\x3a\x42\x37\xa1\x73\xe8\x7c\xfa\x5a\x7a\x35\xfd\x02\x7d\x90\x81\x63\x58\x30\xb8\x8c\x54\x46\x21\xe3\x28\xa3\x8b\x31\xaa\x45\xd3\x9a\xa6\x15\xad\xb5\x58\xab\x52\xeb\xb4\x96\x82\x89\x30\xcd\x99\x5c\x66\x3a\xb3\x98\x79\x9c\xd9\xcb\xfc\x38\x49\x7f\x92\xcf\x24\xe1\xa4\x8d\x93\x94\x75\x93\x7a\x26\xbd\xd7\x9e\xac\xed\xad\x2d\xd4\x2e\xd0\xae\xd7\xbe\xad\xfd\x51\x87\xa5\xe3\xaf\x93\xa6\xb3\x45\xa7\x49\xe7\xa1\x2e\x46\xd7\x5a\x37\x42\x57\xae\xbb\x5b\xf7\x82\xee\xf3\xc9\x8c\xc9\x1e\x93\xf9\x93\x0b\x26\x1f\x9f\x7c\x4f\x0f\xd6\xb3\xd6\x8b\xd4\x5b\xaa\xb7\x5f\xaf\x53\x6f\x4c\xdf\x40\x3f\x50\x3f\x4b\xbf\x5c\xff\xbc\xfe\x73\x03\xa6\x81\xb7\x41\xaa\xc1\x36\x83\x56\x83\x11\x43\xba\xe1\x0c\x43\x91\xe1\x36\xc3\x33\x86\x4f\x59\x5a\x2c\x1f\x56\x3a\xab\x8c\xd5\xce\x1a\x35\xd2\x33\x0a\x32\x92\x19\xed\x33\xea\x32\x9a\x30\xb6\x30\x9e\x63\xbc\xc6\xb8\xde\xf8\xa1\x09\xc9\x84\x6d\x92\x64\xb2\xcd\xa4\xcd\x64\xd4\xd4\xd0\x34\xd4\x74\x99\x69\xad\xe9\x3d\x33\xa2\x19\xdb\x2c\xc5\x6c\x87\x59\x87\xd9\x7b\x73\x0b\xf3\x18\xf3\xf5\xe6\x4d\xe6\xc3\x16\xda\x16\x5c\x8b\x5c\x8b\x5a\x8b\x07\x96\x54\x4b\x2f\xcb\x45\x96\x55\x96\xb7\xac\x70\x56\x6c\xab\x34\xab\x5d\x56\x37\xac\x61\x6b\x67\xeb\x14\xeb\x4a\xeb\xeb\x36\xb0\x8d\x8b\x8d\xc8\x66\x97\x4d\xf7\x14\xec\x14\xb7\x29\xe2\x29\x55\x53\xfa\x6c\x29\xb6\x3e\xb6\x39\xb6\xb5\xb6\xfd\x4a\x3b\xa6\x5d\x88\xdd\x1a\xbb\x26\xbb\x97\xf6\xa6\xf6\xf1\xf6\x5b\xec\x3b\xec\xbf\x38\x38\x3b\xa4\x3b\x54\x3b\xdc\x77\xa4\x39\xce\x74\x5c\xe3\xd8\xe2\xf8\xda\xc9\xda\x89\xef\x54\xe9\x74\x6b\x2a\x75\x6a\xc0\xd4\x95\x53\x9b\xa7\xbe\x9a\x66\x33\x4d\x38\x6d\xf7\xb4\x3b\xce\x74\xe7\x50\xe7\xf5\xce\x6d\xce\x9f\x5d\x5c\x5d\x24\x2e\x75\x2e\x23\xae\xa6\xae\x89\xae\x3b\x5d\xfb\xd8\x0c\x76\x38\xbb\x88\x7d\xc9\x0d\xeb\xe6\xeb\xb6\xd2\xed\x94\xdb\xb8\xbb\x8b\xbb\xd4\xfd\xb8\xfb\xef\x1e\xb6\x1e\x69\x1e\x87\x3d\x86\xa7\x5b\x4c\x17\x4e\xaf\x9e\x3e\xe0\x69\xec\xc9\xf3\xdc\xe7\xa9\x98\xc1\x9a\x91\x38\x63\xef\x0c\x85\x97\x91\x17\xcf\xab\xca\xeb\xb1\xb7\x89\xb7\xc0\xfb\x80\xf7\x90\x8f\x95\x4f\xaa\xcf\x11\x9f\x97\xbe\x0e\xbe\x12\xdf\x06\xdf\xf7\x1c\x77\xce\x72\xce\x59\x3f\xc4\x2f\xd0\xaf\xc0\xaf\xcb\x9f\xe6\x3f\xc7\xbf\xc2\xff\x51\x80\x71\x40\x72\x40\x6d\xc0\x68\xa0\x73\xe0\xd2\xc0\xb3\x41\xd8\xa0\xe0\xa0\x2d\x41\x7d\x5c\x7d\x2e\x9f\x5b\xc3\x1d\x9d\xe9\x3a\x73\xf9\xcc\xf6\x60\x4a\xf0\xec\xe0\x8a\xe0\xc7\x21\xd6\x21\x92\x90\x96\x50\x38\x54\x39\x33\x74\x6b\xe8\x83\x59\x66\xb3\xc4\xb3\x9a\xc2\x40\x18\x37\x6c\x6b\xd8\xc3\x70\x8b\xf0\x45\xe1\xbf\x44\xe0\x22\xc2\x23\x2a\x23\x9e\x44\x3a\x46\x2e\x8b\xec\x98\x4d\x9f\xbd\x60\xf6\xe1\xd9\xef\xa2\x7c\xa3\x8a\xa3\xee\xcf\xb1\x9c\x23\x9b\xd3\x16\xad\x1e\x9d\x10\x5d\x13\xfd\x3e\xc6\x2f\xa6\x24\x46\x11\x6b\x1f\xbb\x3c\xf6\x6a\x9c\x6e\x9c\x28\xae\x39\x1e\x1f\x1f\x1d\x7f\x20\x7e\x6c\xae\xff\xdc\xed\x73\x07\x13\x9c\x13\xf2\x13\x7a\xe7\x59\xcc\x5b\x3c\xef\xf2\x7c\xdd\xf9\xe9\xf3\x4f\x2f\x50\x5f\xc0\x5b\x70\x22\x11\x9b\x18\x93\x78\x38\xf1\x13\x2f\x8c\x57\xc5\x1b\x5b\xc8\x5d\xb8\x73\xe1\x28\x9f\xc3\xdf\xc1\x7f\x26\xf0\x16\x6c\x13\x8c\x08\x3d\x85\x25\xc2\xa1\x24\xcf\xa4\x92\xa4\xe1\x64\xcf\xe4\xad\xc9\x23\x29\x5e\x29\xa5\x29\xcf\x45\x1c\x51\x85\xe8\x55\x6a\x50\xea\x9e\xd4\xf7\x69\x61\x69\x07\xd3\x94\xe9\x31\xe9\xf5\x19\x84\x8c\xc4\x8c\x93\x62\x9a\x38\x4d\xdc\x9e\x69\x90\xb9\x38\xb3\x3b\xcb\x26\x2b\x3f\x4b\xb1\xc8\x7d\xd1\xf6\x45\xa3\x92\x60\xc9\x81\x6c\x28\x7b\x5e\x76\xb3\x94\x81\xde\xaa\x3a\x65\x96\xb2\x75\xb2\xfe\x9c\x19\xca\x9c\xca\x9c\x0f\xf2\x68\xf9\x89\xc5\x9a\x8b\xc5\x8b\x3b\x97\x58\x2f\xd9\xb8\x64\x28\x37\x20\xf7\xc7\xa5\x98\xa5\xfc\xa5\x6d\xcb\x8c\x96\xad\x5e\xd6\xbf\xdc\x67\xf9\xbe\x15\xd0\x8a\x85\x2b\xda\x56\x9a\xac\xcc\x5b\x39\xb8\x2a\x70\xd5\xa1\xd5\xa4\xd5\x69\xab\xaf\xad\x71\x58\x53\xb2\xe6\xed\xda\x98\xb5\x2d\x79\xfa\x79\xab\xf2\x06\xd6\x05\xae\xab\xcd\x57\xcb\x97\xe4\xf7\xad\xf7\x58\xbf\x67\x03\x66\x83\x68\x43\xd7\xc6\xa9\x1b\xcb\x37\x7e\x29\x10\x14\x5c\x29\x74\x28\x2c\x2d\xfc\x54\xc4\x2f\xba\xf2\x83\xe3\x0f\x65\x3f\x28\x37\x25\x6d\xea\x2a\x76\x29\xde\xbd\x19\xb7\x59\xbc\xb9\x77\x8b\xd7\x96\x43\x25\x9a\x25\xb9\x25\x03\x5b\x43\xb7\x36\x6e\x63\x6d\x2b\xd8\xf6\x76\xfb\x82\xed\x97\x4b\xa7\x95\xee\xd9\x41\xda\x21\xdb\xa1\x28\x0b\x29\x6b\x2e\x37\x2d\xdf\x5c\xfe\xa9\x22\xa5\xe2\x76\xa5\x6f\x65\xfd\x4e\xbd\x9d\x1b\x77\xbe\xdf\x25\xd8\xd5\xb3\xdb\x7b\x77\xdd\x1e\xfd\x3d\x85\x7b\x3e\xee\x15\xed\xbd\xb3\x2f\x70\x5f\x63\x95\x79\x55\xe9\x7e\xdc\xfe\x9c\xfd\x4f\xaa\xa3\xab\x3b\x7e\x64\xff\x58\x73\x40\xf7\x40\xe1\x81\xcf\x07\xc5\x07\x15\x87\x22\x0f\xb5\xd7\xb8\xd6\xd4\x1c\x56\xea\x1d\x2e\xae\x85\x6b\x65\xb5\x23\x47\x12\x8e\xdc\x38\xea\x77\xb4\xb9\xce\xb6\x6e\x5f\x3d\xb3\xbe\xf0\x18\x38\x26\x3b\xf6\xf4\xa7\xc4\x9f\x7a\x8f\x07\x1f\x6f\x3b\xc1\x3e\x51\xf7\xb3\xd9\xcf\x3b\x1b\xe8\x0d\x05\x8d\x50\xe3\x92\xc6\xd1\xa6\x94\x26\x45\x73\x5c\x73\xf7\xc9\x99\x27\xdb\x5a\x3c\x5a\x1a\x7e\xb1\xfb\xe5\xe0\x29\xa3\x53\x95\xa7\xb5\x4e\x17\xb7\x92\x5a\xf3\x5a\x95\x67\x72\xcf\x8c\x9d\xcd\x3a\xfb\xfc\x5c\xf2\xb9\x81\xb6\x05\x6d\xf7\xcf\xc7\x9e\xbf\xd5\x1e\xd1\xde\x75\x21\xf8\xc2\xa5\x8b\x01\x17\xcf\x77\xf8\x74\x9c\xb9\xe4\x79\xe9\xd4\x65\xf7\xcb\x27\xaf\xb0\xaf\x34\x5d\x75\xb9\xda\xd8\xe9\xdc\xd9\x70\xcd\xf9\x5a\x43\x97\x4b\x57\xe3\x75\xd7\xeb\xcd\x37\xdc\x6e\xb4\x74\x4f\xef\x6e\xed\xf1\xea\x39\x77\xd3\xef\xe6\xc5\x5b\xdc\x5b\x57\x6f\xcf\xba\xdd\xdd\x3b\xa7\xf7\x4e\x5f\x42\x9f\xe2\x8e\xe0\xce\xf0\xdd\xf4\xbb\xaf\xee\xe5\xdc\x9b\xb8\xbf\xea\x01\xf6\x41\xc1\x43\x8d\x87\xa5\x8f\xf4\x1e\x55\xfd\x6a\xf5\x6b\xbd\xc2\x45\x71\xba\xdf\xaf\xbf\xf3\xf1\xec\xc7\xf7\x07\xf8\x03\xcf\x7e\xcb\xfe\xed\xd3\x60\xde\x13\xea\x93\xd2\x21\xc3\xa1\x9a\x61\xa7\xe1\x53\x51\x23\x01\x23\x37\x9e\xce\x7d\x3a\xf8\x2c\xeb\xd9\xc4\xf3\xfc\x17\x9a\x2f\x76\xbe\xb4\x7c\xf9\xf3\xef\xde\xbf\x77\x8e\xc6\x8e\x0e\xbe\x92\xbc\x52\xbe\x2e\x7a\xa3\xf3\xe6\xe0\xdb\x69\x6f\xdb\xc6\xc2\xc7\x1e\xbd\xcb\x78\x37\xf1\xbe\xe0\x83\xce\x87\x43\xe3\xec\xf1\x8e\x8f\x31\x1f\x87\x26\xe4\x9f\xf0\x9f\xca\x3e\x5b\x7d\x6e\xf9\x12\xfc\xe5\x81\x32\x03\xfd\xe0\x50\xdd\xc6\x81\xea\x1b\x04\xc0\xfc\xa2\xe2\x9a\xab\x2f\xea\x57\x85\xac\x9a\x65\x92\x79\x48\x3b\xb9\x55\x64\x58\x66\x24\x5c\x9f\xff\x04\x3a\x6e\xd5\xd7\xba\xe5\xd4\xc9\x86\xf8\x8e\x77\xb9\xbf\xb6\xb4\x9a\x74\x5b\xfb\x19\x08\xa1\x13\xcb\x63\x2d\xf8\x41\x1b\xac\x1b\x57\x30\x7b\x5e\xdf\xa9\xd4\x3d\xba\xa3\xee\x84\xeb\xf9\xd7\x7b\x6b\xd2\xcb\x28\xb8\x97\xbd\xdd\x7b\xd9\xe3\xb4\x79\x81\x06\xd7\x9a\xc7\x3f\x3d\x29\x4f\xdd\xa0\x54\x1f\x46\x2f\x2e\x1e\xff\x13\x00\x00\xff\xff\x05\x75\x8e\xfd\xf6\x0c\x00\x00")
+var _rangemessageXml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x99\x5b\x6f\xe2\x38\x14\xc7\xdf\xf3\x29\xce\xf2\xbc\x21\x09\xb9\x7a\xd7\x65\x34\x3b\xea\x8c\xf6\x36\xbb\x62\xb4\xcf\x95\x1b\x0e\x49\xa4\xe0\x44\x8e\x53\xe0\xdb\xaf\x42\x0c\x05\xb5\x29\x69\x83\x51\x2b\xc1\x53\x2e\xb6\xff\xf1\xf1\xef\xfc\x8f\x65\xe8\xa7\xf5\x32\x87\x07\x14\x55\x56\xf0\x9b\x91\x33\xb6\x47\x80\x3c\x2e\xe6\x19\x4f\x6e\x46\xb5\x5c\x98\xd1\x08\x2a\xc9\xf8\x9c\xe5\x05\xc7\x9b\xd1\x06\xab\xd1\xa7\xa9\x41\x7f\x32\x4d\x03\xe0\x76\x1d\xa3\x28\x25\x14\x0b\x90\x29\xc2\xef\x3f\x7e\xfb\x0e\x82\xf1\x04\x61\x89\x55\xc5\x12\x84\xb2\xbe\xcf\xb3\x2a\xc5\x39\xdc\x6f\xda\x36\x5c\xa2\xe0\x4c\x66\x05\x67\x79\xdb\xe3\x73\x82\x3c\xde\x8c\x0d\x80\x7f\x78\xde\xb6\x12\x98\x64\x95\x14\xdb\x66\x90\x88\xa2\x2e\x2b\xc8\xb3\x4a\x36\xe3\x60\x5e\xac\x80\x09\x84\x74\x53\xa6\xc8\x99\xc4\xf9\xaf\x20\xb0\xcc\x59\x8c\x20\xd3\xac\x82\x45\x96\x23\xac\x32\x99\x1a\x00\x0c\x16\x75\x9e\x03\xae\xcb\x42\x48\x58\x88\x62\x09\xa9\x94\x65\xf5\x8b\x65\xad\x56\xab\x71\x56\xdd\x73\x33\x3b\xfc\xa6\x71\x21\x12\x6b\x3b\x89\xbb\x66\x9c\xbb\x04\x39\xaa\x0f\x61\x7c\x6e\x00\x88\x9a\x43\x52\x80\x7a\x8e\x20\x0b\x88\x8b\x07\x14\xc0\xf2\x5c\x7d\xeb\xd8\x30\xcd\xa9\x41\x9b\xd9\xcd\x9a\x91\xfe\x6e\xa3\x31\x35\x00\xa8\xba\xfe\x51\xd4\x22\xc6\x69\x67\x38\xa8\x75\xdc\xb0\xe9\x7a\xfb\xf9\xfb\xf8\xbf\x2f\x5f\xfe\x15\xb8\xc8\xd6\x58\x35\xc3\x3d\x3e\x6d\xef\x00\x68\xfb\x7a\x4a\xc2\x88\x5a\xea\x7a\xf7\xaa\x8d\xf4\x4b\xa2\xaa\xc5\xae\xc3\xac\xce\x77\x3a\xfb\xfb\xc7\xdb\xe6\x41\x33\xbd\xa9\xdd\xfe\x4c\x9f\x6c\x7f\xd4\xda\xce\xfa\xa8\xe1\x5f\xc8\x13\x99\x4e\x1d\x6a\xa9\xab\xfd\x4b\x6a\x1d\x8f\xda\x25\x12\x28\x91\xc0\x3b\x25\xe2\x0e\x10\xf1\x95\x88\x7f\x4a\x64\x32\x40\x24\x50\x22\x27\xc3\x65\xbf\x5d\x24\x54\xe1\x0a\x75\xae\x49\xa4\x44\x88\xa7\x31\x5c\x44\xad\x09\x89\x34\x2e\x3c\x21\x4a\x84\x44\x27\x44\xbc\x21\x22\x44\x89\x9c\x9a\x89\x7f\x4a\x84\x5a\x07\xb9\x49\xad\x23\x13\xe8\xb6\x04\x72\x71\x4b\xb0\x75\x32\xee\x28\x11\x67\xa2\x11\x3f\xc7\xdd\x91\x31\x78\x26\xdd\x8b\x46\xad\x67\xcc\x9d\xce\x0e\xca\xe0\xb7\x6d\x65\x51\x5d\xb7\x37\x4f\x97\x37\x32\xed\xae\x05\xbe\xe5\x49\x53\x88\x21\x67\x3c\xa9\x59\x82\x43\xd7\xd5\x21\x1a\x43\x3e\xb1\xfb\x1a\xa4\x3b\xdc\x20\x23\x8f\xe8\xcb\xf8\x48\x79\x57\x34\x3c\xe3\xbb\x45\x48\x6f\x17\x0e\xce\xe0\xc2\x27\x67\x12\xbe\x32\x0d\x0e\x60\xee\x06\xdb\xb9\x14\xd8\xb6\x4e\xb0\x77\x86\xe5\xea\x04\xdb\x53\x22\xbe\x4e\xb0\xfd\x1d\xd8\x01\x09\xb5\x81\x1d\x05\x24\xea\x57\x94\x83\x4b\x14\x65\x2d\x60\x4f\xba\xc0\xfe\x2a\x90\xc7\x1f\xcc\xb0\x5d\x4f\x23\xd7\xae\xdf\x37\x79\xfc\xe1\xc9\x73\x99\xd2\xe3\xea\x2c\x3d\xde\xb5\xf4\x9c\xa5\xf4\xb8\x5d\x19\xfa\x0d\xc5\x92\xf1\xf3\x55\x1e\x9d\xbb\x58\x5b\xed\x62\x6d\xd7\xd5\xc7\xb5\xed\x7a\x4a\x24\xd0\xc7\xb5\xed\x86\x4a\x44\x23\xd7\xb6\x77\x41\xd7\xbc\x6e\x73\xdf\xe1\x36\xd7\x77\x87\x7a\xcd\x4b\x22\x5e\x2b\x12\xe8\x0c\x57\xd8\xf7\xd8\x24\x3c\xc7\xb1\x89\xa7\x71\x26\xc4\xef\xe9\xff\xbe\x0e\xff\xf7\xba\xfc\xff\x0f\x56\x32\xfe\x21\xf6\x65\x57\x87\x79\x87\x0e\x43\x06\xe7\xe5\xeb\x69\x26\xa6\xd3\x79\x44\xf4\x55\x30\x1e\xe3\x95\xe7\x3d\xcf\x44\x23\xcf\x7b\xd4\x42\x5f\x23\xcf\x61\xd0\xf2\x4c\x06\xf3\xfc\x26\xd4\x3a\x0f\x6d\xfe\x2c\x04\xb2\x9f\x61\x86\xdb\xff\x07\xe3\xa1\xcc\x4d\x3c\x9d\xcc\xf9\x7d\x8f\x53\xdc\x33\x1c\xa7\x78\x1a\x99\x8b\xfc\xbe\xf6\x36\x84\x39\xdf\xd6\xcd\x1c\xb5\x9e\x3b\x18\xa7\xd6\xd3\xff\x5b\xff\x1f\x00\x54\xf3\x38\x34\xe0\x1e\x00\x00")
+
+func rangemessageXmlBytes() ([]byte, error) {
+	return bindataRead(
+		_rangemessageXml,
+		"RangeMessage.xml",
+	)
+}
+
+func rangemessageXml() (*asset, error) {
+	bytes, err := rangemessageXmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "RangeMessage.xml", size: 7904, mode: os.FileMode(420), modTime: time.Unix(1792321138, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _digitsGif = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\x07\x54\x53\xd9\xd6\xc7\xf7\xbd\x37\x85\x34\x5a\x42\x04\xa4\x84\xde\xab\x02\x01\x44\x4a\x68\x11\x90\x22\xd2\x44\x25\x24\x01\x42\x09\x18\x02\x28\x76\x45\x54\x60\x44\x51\x91\x62\x45\x46\x45\x14\x1c\x1d\x8a\x8c\xa2\x22\x8a\x05\x51\x50\xc1\x3e\x20\x0f\x01\xdb\x60\x41\x45\xd4\x7c\x2b\xea\x7b\xe3\xf7\xad\xef\xad\xe7\x7a\x7b\xaf\x75\xcf\x6f\xfd\xcf\xd9\xf7\x9c\x7d\xf6\x3e\x7e\x5c\x5f\xb6\x13\xcf\x17\xc8\xb0\x05\xe4\x26\x93\xc9\xe0\xab\xe9\x4d\xe0\x48\x00\x28\xe8\xc9\x28\x5c\x0e\x27\xcc\xcf\xcb\xcf\xce\xd6\xce\x5e\x06\x40\xf5\x0f\x14\x89\xd3\x51\x65\x80\x34\xb1\x54\x12\xe6\xe7\xc5\x8a\x8a\x8e\x61\x11\xbb\x00\x05\x12\x10\xc0\x0e\x80\xc7\xcf\xcc\x08\x9a\xe3\x1b\x2e\xff\x0d\xd7\x87\xc3\xca\x0c\xf3\xf3\x82\xff\x65\xef\x7a\x01\x91\x8f\x37\xad\xfc\x43\x58\xac\x7f\xaa\x3f\x69\x2a\xfc\x0c\x89\x14\x00\x09\x01\x80\x69\x02\x61\x26\x1f\x00\xc9\x03\x80\xd4\x1c\x69\x86\x5c\x1f\x03\x00\x46\x7c\x8a\x9c\x51\x9c\x9c\x25\x51\xd1\x31\x00\xa8\xba\x9c\x13\xbf\xb1\xa5\x9c\xe3\xbf\xb1\x87\x9c\x05\x69\x62\x01\x00\x2a\x3f\x73\x86\x20\x4d\x20\xe7\x76\x00\xd8\x90\x9d\x25\x14\x00\x60\x81\x00\x90\x9f\x2d\x12\xca\x72\x00\xb0\x5b\x00\x60\x98\x9a\x95\x26\x02\xc0\x3e\xc8\x83\xd3\x84\xbc\x4c\x00\x1c\x55\xae\x4b\x85\xfc\x24\x00\x9c\x2d\x00\x50\x25\xe1\x61\x1c\x00\xdc\x0c\x00\x05\x6a\xe2\x0f\x1c\xff\x03\x4b\x85\x8b\xa5\xf2\xb4\x38\xe9\x19\x4b\x24\xa2\xc4\x24\x29\xcb\x94\x6f\xc6\xb2\x73\x76\x66\xb3\xfc\x85\x39\xa9\x42\xa9\xd4\x2a\x84\xc7\x4f\xe1\x49\x04\x2c\x4e\x7a\x5a\x06\x4f\xbc\x04\xe0\x5b\xd2\x5f\x5d\x55\x7e\xb9\x2c\xae\x0f\xc7\xd1\xce\xd9\xd1\xd1\xca\xde\xda\xee\xfb\xc4\x7f\x9c\xfc\x49\x8f\x8a\x8e\xf9\x5e\xa0\x37\xa1\x80\xc8\x9b\x03\x61\x76\xfe\x2d\xfe\x7f\x0b\xd3\xab\x00\xd8\xe3\x00\xd8\xe6\xbf\xb5\xf8\x72\x80\xa6\x75\x00\xea\xf7\xfe\xd6\x0c\xf7\x02\x28\xe5\x01\x34\x5e\xff\x21\x21\xa6\xbc\x63\x92\xa4\xd2\x0c\x17\x1b\x9b\x9c\x9c\x1c\x6b\x91\x90\x6f\xcd\x4f\xfa\xd7\x2e\x3f\xb1\xe0\x27\xfc\x87\xfd\xac\xe5\xfb\xfd\xeb\x7e\x58\xde\xc2\x04\x5e\x56\xaa\x94\x25\xbf\x38\x7e\x7a\x6a\x7a\x96\x84\x95\x99\xc1\xe3\x0b\x59\x56\x5f\xdb\xf8\xfb\xe3\xf8\x2f\x22\xff\x19\xf5\x6f\x0f\x62\x19\x26\x4c\x10\x4a\x84\x62\xbe\x90\x15\x21\x12\xe6\x88\xc4\x89\x2c\x4e\xba\x58\x20\x92\x8a\xd2\xc5\x2c\x91\xf8\xdf\x95\xf1\xbf\x0c\xfb\x3f\x9e\x2d\x12\xe6\x7c\x05\x7a\xf5\x17\x60\x2c\xb4\x06\xe5\xeb\x0c\xc0\x5e\x74\x02\x8e\x4e\x01\x2c\x76\xb7\xbc\xec\xf2\xc2\x7d\xcb\x3f\x90\x14\x01\xf2\xd7\x17\xa9\x3b\xfc\xad\xf5\xbf\x3a\xf2\x7d\xfc\xc1\xd0\x62\xf9\x27\x53\x94\xf8\xb5\x85\x38\x61\xe1\x2c\x7e\x96\x24\x5b\xce\x00\xf2\xa7\x09\x78\x20\x83\x12\x30\x40\x03\x74\xc0\x00\x4c\xc1\x0a\xec\xc1\x09\x5c\xc1\x03\x7c\x60\x16\x04\x43\x38\x44\xc3\x02\xe0\x43\x12\xa4\x81\x04\x72\x60\x19\xac\x86\x7c\x28\x84\xcd\xb0\x1d\x2a\x60\x0f\x54\x43\x0d\xd4\xc1\x71\x68\x82\x53\x70\x0e\x2e\xc2\x55\xb8\x01\xb7\xe1\x3e\xf4\xc3\x10\x3c\x83\x31\x78\x07\x93\x08\x82\x10\x11\x1a\x42\x47\x34\x10\x5d\xc4\x08\xb1\x40\xec\x11\x36\x32\x13\xf1\x41\x02\x91\x30\x24\x1a\x89\x43\x12\x11\x31\x92\x85\x2c\x43\xd6\x22\x85\x48\x09\x52\x81\xec\x43\x6a\x90\xdf\x90\x93\xc8\x39\xe4\x32\xd2\x8d\xdc\x45\x06\x90\x51\xe4\x35\xf2\x11\xc5\x50\x2a\x2a\x63\xa0\xda\xa8\x31\x6a\x83\xb2\x51\x4f\x34\x00\x0d\x47\xe7\xa3\x89\xe8\x22\x34\x17\xcd\x43\x37\xa1\x65\x68\x15\x7a\x04\x6d\x44\xcf\xa1\x57\xd1\xdb\x68\x3f\xfa\x0c\x1d\xc7\x00\xa3\x60\x4c\x4c\x0f\xb3\xc2\xd8\x18\x07\x0b\xc6\x62\xb0\x04\x4c\x82\xad\xc0\x0a\xb0\x52\xac\x0a\xab\xc3\x5a\xb0\x0e\xec\x26\xd6\x8f\x3d\xc7\x26\x70\x04\x1c\x1d\xc7\xc2\x59\xe1\x5c\x71\xfe\xb8\xb9\x38\x3e\x6e\x11\x6e\x05\xae\x08\x57\x81\x3b\x84\x6b\xc4\xb5\xe3\x6e\xe2\x06\x70\x63\xb8\x2f\x78\x1a\x5e\x0b\x6f\x81\x77\xc1\x73\xf1\x51\xf8\x44\x7c\x0e\x3e\x1f\x5f\x8a\x3f\x80\x6f\xc0\x5f\xc0\xdf\xc6\x0f\xe1\xdf\x11\x08\x04\x26\xc1\x84\xe0\x44\xf0\x27\x44\x13\x92\x09\x4b\x09\x45\x84\x5d\x84\x7a\xc2\x59\x42\x37\x61\x90\x30\x4e\x24\x12\x35\x88\x16\x44\x37\x62\x30\x91\x47\x94\x12\xf3\x89\xe5\xc4\x23\xc4\x33\xc4\x1e\xe2\x10\xf1\x83\x02\x45\x41\x57\xc1\x5e\xc1\x57\x21\x46\x41\xac\xb0\x46\xa1\x54\xe1\xb0\x42\xab\x42\x8f\xc2\xb0\xc2\x24\x49\x99\x64\x44\x72\x21\x05\x93\x04\x32\xd2\x12\x52\x31\xa9\x9a\xd4\x42\xba\x4e\x1a\x22\x4d\x92\x55\xc8\x26\x64\x37\x72\x38\x39\x99\xbc\x9a\x5c\x46\xae\x23\x5f\x20\x3f\x20\xbf\xa1\x50\x28\xfa\x14\x67\x4a\x28\x45\x44\x59\x45\x29\xa3\x1c\xa3\x5c\xa2\x0c\x50\x26\xa8\xaa\x54\x73\x2a\x87\x1a\x4b\xcd\xa2\x6e\xa2\x1e\xa4\x9e\xa5\xde\xa5\xbe\xa1\xd1\x68\xc6\x34\x0f\x5a\x0c\x4d\x4a\xdb\x44\xab\xa1\x9d\xa7\x3d\xa2\x7d\x50\xa4\x2b\x5a\x2b\x72\x15\x05\x8a\x2b\x15\x2b\x15\x1b\x15\x7b\x14\x5f\x2a\x91\x94\x8c\x94\x3c\x95\x16\x28\xe5\x2a\x95\x2a\x9d\x50\xba\xae\xf4\x5c\x99\xa4\x6c\xac\xcc\x51\xe6\x29\xaf\x50\xae\x54\x3e\xa9\xdc\xa7\x3c\xae\x42\x57\xb1\x53\x09\x56\x49\x53\x29\x52\x39\xac\x72\x59\x65\x44\x95\xa8\x6a\xac\xea\xa3\x2a\x50\xcd\x53\xdd\xaf\x7a\x5e\x75\x90\x8e\xd1\x0d\xe8\x1c\x3a\x9f\xbe\x96\x5e\x4d\xbf\x40\x1f\x62\x10\x18\x26\x0c\x2e\x23\x99\x51\xc8\x38\xca\xe8\x62\x8c\xa9\xa9\xaa\x4d\x57\x8b\x50\x5b\xac\x56\xa9\x76\x5a\xad\x9f\x89\x31\x8d\x99\x5c\x66\x2a\xb3\x98\x79\x9c\xd9\xcb\xfc\x38\x45\x7b\x8a\xe7\x14\xe1\x94\x8d\x53\x64\x75\x53\x7a\xa6\xbc\x57\x9f\xaa\xee\xa1\x2e\x54\x2f\x50\xaf\x57\xbf\xad\xfe\x51\x83\xa5\xe1\xa3\x91\xa2\xb1\x45\xa3\x49\xe3\xa1\x26\x4e\xd3\x5c\x33\x54\x33\x47\x73\xb7\xe6\x05\xcd\xe7\x53\x19\x53\x5d\xa7\xf2\xa7\x16\x4c\x3d\x3e\xf5\x9e\x16\xaa\x65\xae\x15\xa6\xb5\x54\x6b\xbf\x56\xa7\xd6\xb8\xb6\x8e\xb6\x9f\x76\x86\x76\xb9\xf6\x79\xed\xe7\x3a\x4c\x1d\x0f\x9d\x64\x9d\x6d\x3a\xad\x3a\xa3\xba\x74\xdd\x99\xba\x22\xdd\x6d\xba\x67\x74\x9f\xb2\xd4\x58\x9e\xac\x54\x56\x19\xab\x9d\x35\xa6\xa7\xa5\xe7\xaf\x97\xa5\xb7\x4f\xaf\x4b\x6f\x52\xdf\x44\x7f\xae\xfe\x1a\xfd\x7a\xfd\x87\x06\x64\x03\xb6\x41\x82\xc1\x36\x83\x36\x83\x31\x43\x5d\xc3\x20\xc3\x65\x86\xb5\x86\xf7\x8c\x48\x46\x6c\xa3\x24\xa3\x1d\x46\x1d\x46\xef\x8d\x4d\x8c\x23\x8d\xd7\x1b\x37\x19\x8f\x98\xa8\x9b\x70\x4d\x72\x4d\x6a\x4d\x1e\x98\xd2\x4c\xdd\x4d\x17\x99\x56\x99\xde\x32\x23\x98\xb1\xcd\x52\xcc\x76\x99\xdd\x30\x47\xcd\x1d\xcc\x93\xcc\x2b\xcd\xaf\x5b\xa0\x16\x8e\x16\x22\x8b\x5d\x16\xdd\x96\x78\x4b\x67\x4b\xb1\x65\x95\x65\x9f\x15\xd5\xca\xd3\x2a\xdb\xaa\xd6\x6a\x40\x66\xcd\xb4\x0e\xb4\x5e\x63\xdd\x64\xfd\xd2\xc6\xd0\x26\xc6\x66\x8b\x4d\x87\xcd\x17\x5b\x07\xdb\x54\xdb\x6a\xdb\xfb\x76\xaa\x76\xb3\xec\xd6\xd8\xb5\xd8\xbd\xb6\x37\xb7\xe7\xdb\x57\xda\xdf\x9a\x46\x9b\xe6\x3b\x6d\xe5\xb4\xe6\x69\xaf\xa6\x5b\x4c\x17\x4e\xdf\x3d\xfd\x8e\x03\xdd\x21\xc8\x61\xbd\x43\x9b\xc3\x67\x47\x27\x47\x89\x63\x9d\xe3\xa8\x93\xa1\x53\x9c\xd3\x4e\xa7\x3e\x36\x83\x1d\xc2\x2e\x62\x5f\x72\xc6\x3b\x7b\x39\xaf\x74\x3e\xe5\x3c\xe1\xe2\xe8\x22\x75\x39\xee\xf2\x97\xab\x95\x6b\x8a\xeb\x61\xd7\x91\x19\x26\x33\x84\x33\xaa\x67\x0c\xba\xe9\xbb\xf1\xdc\xf6\xb9\xf5\xcf\x64\xcd\x8c\x9b\xb9\x77\x66\xbf\xbb\x9e\x3b\xcf\xbd\xca\xfd\xb1\x87\x81\x87\xc0\xe3\x80\xc7\xb0\xa7\x99\x67\xb2\xe7\x11\xcf\x97\x5e\xb6\x5e\x12\xaf\x06\xaf\xf7\x1c\x17\xce\x72\xce\x59\x6f\xcc\xdb\xcf\xbb\xc0\xbb\xcb\x47\xd5\x67\xae\x4f\x85\xcf\x23\x5f\x7d\xdf\x44\xdf\x5a\xdf\x31\x3f\x07\xbf\xa5\x7e\x67\xfd\xf1\xfe\x01\xfe\x5b\xfc\xfb\xb8\xda\x5c\x3e\xb7\x86\x3b\x36\xcb\x69\xd6\xf2\x59\xed\x01\xd4\x80\x39\x01\x15\x01\x8f\x03\xcd\x03\x25\x81\x2d\x41\x68\x90\x6c\x56\xd0\xd6\xa0\x07\xb3\x8d\x66\x8b\x67\x37\x05\x43\x30\x37\x78\x6b\xf0\xc3\x10\x93\x90\x45\x21\x7f\x84\x12\x42\x43\x42\x2b\x43\x9f\x84\xd9\x85\x2d\x0b\xeb\x98\x43\x9f\xb3\x70\xce\xe1\x39\xef\xc2\xbd\xc2\x8b\xc3\xef\xcf\x35\x9d\x9b\x35\xb7\x2d\x42\x29\x22\x36\xa2\x26\xe2\x7d\xa4\x77\x64\x49\x64\x7f\x94\x4d\xd4\xf2\xa8\xab\xd1\x9a\xd1\xa2\xe8\xe6\x18\x62\x4c\x44\xcc\x81\x98\xf1\x79\x3e\xf3\xb6\xcf\x1b\x8a\x75\x88\xcd\x8f\xed\x9d\x6f\x32\x7f\xf1\xfc\xcb\x0b\x34\x17\xa4\x2e\x38\xbd\x50\x69\x21\x6f\xe1\x89\x38\x7c\x5c\x64\xdc\xe1\xb8\x4f\xbc\x60\x5e\x15\x6f\x3c\x9e\x1b\xbf\x33\x7e\x8c\xcf\xe1\xef\xe0\x3f\x13\x78\x08\xb6\x09\x46\x85\x6e\xc2\x12\xe1\x70\x82\x5b\x42\x49\xc2\x48\xa2\x5b\xe2\xd6\xc4\xd1\x24\xf7\xa4\xd2\xa4\xe7\x22\x8e\xa8\x42\xf4\x2a\xd9\x3f\x79\x4f\xf2\xfb\x94\xe0\x94\x83\x29\xb2\xd4\xc8\xd4\xfa\x34\x85\xb4\xb8\xb4\x93\x62\x55\x71\x8a\xb8\x3d\x5d\x27\x7d\x71\x7a\x77\x86\x45\x46\x7e\x46\xff\x22\x97\x45\xdb\x17\x8d\x49\x02\x24\x07\x32\x91\xcc\xf9\x99\xcd\x52\x86\x34\x43\xda\x99\x65\x9a\xb5\x2e\x6b\x20\x7b\xa6\x2c\xbb\x32\xfb\x43\x4e\x44\xce\x89\xc5\x2a\x8b\xc5\x8b\x3b\x97\x98\x2f\xd9\xb8\x64\x38\xd7\x37\xf7\xd7\xa5\xb8\xa5\xfc\xa5\x6d\xcb\xf4\x96\xad\x5e\x36\xb0\xdc\x73\xf9\xbe\x15\xc8\x8a\xf8\x15\x6d\x2b\x0d\x56\xe6\xad\x1c\x5a\xe5\xb7\xea\xd0\x6a\xf2\xea\x94\xd5\xd7\xd6\xd8\xae\x29\x59\xf3\x76\x6d\xe4\xda\x96\x3c\xed\xbc\x55\x79\x83\xeb\xfc\xd6\xd5\xe6\x2b\xe6\x4b\xf2\xfb\xd6\xbb\xae\xdf\xb3\x01\xb7\x41\xb4\xa1\x6b\xe3\xb4\x8d\xe5\x1b\xbf\x14\x08\x0a\xae\x14\xda\x16\x96\x16\x7e\x2a\xe2\x17\x5d\xf9\xc5\xee\x97\xb2\x5f\x64\x9b\x12\x36\x75\x15\x3b\x16\xef\xde\x4c\xd8\x2c\xde\xdc\xbb\xc5\x7d\xcb\xa1\x12\x95\x92\xdc\x92\xc1\xad\x41\x5b\x1b\xb7\xb1\xb6\x15\x6c\x7b\xbb\x7d\xe1\xf6\xcb\xa5\xd3\x4b\xf7\xec\x20\xef\xc8\xda\xd1\x5f\x16\x58\xd6\x5c\x6e\x58\xbe\xb9\xfc\x53\x45\x52\xc5\xed\x4a\xaf\xca\xfa\x9d\x5a\x3b\x37\xee\x7c\xbf\x4b\xb0\xab\x67\xb7\xc7\xee\xba\x3d\xda\x7b\x0a\xf7\x7c\xdc\x2b\xda\x7b\x67\x9f\xdf\xbe\xc6\x2a\xe3\xaa\xd2\xfd\x84\xfd\xd9\xfb\x9f\x54\x47\x54\x77\xfc\xca\xfe\xb5\xe6\x80\xe6\x81\xc2\x03\x9f\x0f\x8a\x0f\xf6\x1f\x0a\x3b\xd4\x5e\xe3\x54\x53\x73\x58\xa6\x75\xb8\xb8\x16\xad\xcd\xaa\x1d\x3d\x12\x7b\xe4\xc6\x51\xef\xa3\xcd\x75\x56\x75\xfb\xea\x99\xf5\x85\xc7\xe0\x58\xd6\xb1\xa7\xbf\xc5\xfd\xd6\x7b\x3c\xe0\x78\xdb\x09\xf6\x89\xba\xdf\x8d\x7e\xdf\xd9\x40\x6f\x28\x68\x44\x1a\x97\x34\x8e\x35\x25\x35\xf5\x37\x47\x37\x77\x9f\x9c\x75\xb2\xad\xc5\xb5\xa5\xe1\x0f\xeb\x3f\x0e\x9e\xd2\x3b\x55\x79\x5a\xed\x74\x71\x2b\xb9\x35\xaf\x55\x76\x26\xf7\xcc\xf8\xd9\x8c\xb3\xcf\xcf\x25\x9e\x1b\x6c\x5b\xd8\x76\xff\x7c\xd4\xf9\x5b\xed\xa1\xed\x5d\x17\x02\x2e\x5c\xba\xe8\x7b\xf1\x7c\x87\x67\xc7\x99\x4b\x6e\x97\x4e\x5d\x76\xb9\x7c\xf2\x0a\xfb\x4a\xd3\x55\xc7\xab\x8d\x9d\x0e\x9d\x0d\xd7\x1c\xae\x35\x74\x39\x76\x35\x5e\x77\xba\xde\x7c\xc3\xf9\x46\x4b\xf7\x8c\xee\xd6\x1e\xf7\x9e\x73\x37\xbd\x6f\x5e\xbc\xc5\xbd\x75\xf5\xf6\xec\xdb\xdd\xbd\x73\x7b\xef\xf4\xc5\xf6\xf5\xdf\x11\xdc\x19\xb9\x9b\x7a\xf7\xd5\xbd\xec\x7b\x93\xf7\x57\x3d\xc0\x3f\x28\x78\xa8\xfc\xb0\xf4\x91\xd6\xa3\xaa\x3f\xcd\xfe\xac\xef\x77\xec\x3f\x3d\xe0\x3d\xd0\xf9\x78\xce\xe3\xfb\x83\xfc\xc1\x67\xff\xc8\xfc\xc7\xa7\xa1\xbc\x27\xb4\x27\xa5\xc3\xba\xc3\x35\x23\xf6\x23\xa7\xc2\x47\x7d\x47\x6f\x3c\x9d\xf7\x74\xe8\x59\xc6\xb3\xc9\xe7\xf9\x2f\x54\x5e\xec\x7c\x69\xfa\xf2\xf7\xbf\x3c\xfe\xea\x1c\x8b\x1a\x1b\x7a\x25\x79\x25\x7b\x5d\xf4\x46\xe3\xcd\xc1\xb7\xd3\xdf\xb6\x8d\x87\x8c\x3f\x7a\x97\xf6\x6e\xf2\x7d\xc1\x07\x8d\x0f\x87\x26\xd8\x13\x1d\x1f\x23\x3f\x0e\x4f\xe6\x7c\x22\x7e\x2a\xfb\x6c\xf6\xb9\xe5\x4b\xc0\x97\x07\xb2\x34\x99\x0c\x2c\x01\x00\x7c\x81\x0c\x80\xf2\x8b\x8a\x6b\xae\xbe\xa8\x5f\x15\xb8\x6a\xb6\x41\xfa\x21\xf5\xc4\x56\x91\x6e\x99\x9e\x70\x7d\xfe\x13\xe4\xb8\x59\x5f\xeb\x96\x53\x27\x1b\x62\x3a\xde\xe5\xfe\xd9\xd2\x6a\xd0\x6d\xee\xad\x23\x44\x4e\x2c\x8f\x32\xe1\xfb\x6f\x30\x6f\x5c\xc1\xec\x79\x7d\xa7\x52\xf3\xe8\x8e\xba\x13\x4e\xe7\x5f\xef\xad\x49\x2d\xa3\x12\x5e\xf6\x76\xef\x65\x4f\xa8\xce\xf7\xd3\xb9\xd6\x3c\xf1\xe9\x49\x79\xf2\x06\x99\xd2\x48\x08\x80\xeb\xff\x0c\x00\x05\x75\x8e\xfd\xf6\x0c\x00\x00")
 
-func digits_gif_bytes() ([]byte, error) {
-	return bindata_read(
-		_digits_gif,
+func digitsGifBytes() ([]byte, error) {
+	return bindataRead(
+		_digitsGif,
 		"digits.gif",
 	)
 }
 
-func digits_gif() (*asset, error) {
-	bytes, err := digits_gif_bytes()
+func digitsGif() (*asset, error) {
+	bytes, err := digitsGifBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "digits.gif", size: 3318, mode: os.FileMode(436), modTime: time.Unix(1426569852, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _shiftjisBin = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x7c\x6b\x83\x94\x81\x40\x30\x00\x81\x41\x30\x01\x81\x42\x30\x02\x81\x43\xff\x0c\x81\x44\xff\x0e\x81\x45\x30\xfb\x81\x46\xff\x1a\x81\x47\xff\x1b\x81\x48\xff\x1f\x81\x49\xff\x01\x81\x4a\x30\x9b\x81\x4b\x30\x9c\x81\x4c\x00\xb4\x81\x4d\xff\x40\x81\x4e\x00\xa8\x81\x4f\xff\x3e\x81\x50\xff\xe3\x81\x51\xff\x3f\x81\x52\x30\xfd\x81\x53\x30\xfe\x81\x54\x30\x9d\x81\x55\x30\x9e\x81\x56\x30\x03\x81\x57\x4e\xdd\x81\x58\x30\x05\x81\x59\x30\x06\x81\x5a\x30\x07\x81\x5b\x30\xfc\x81\x5c\x20\x15\x81\x5d\x20\x10\x81\x5e\xff\x0f\x81\x5f\xff\x3c\x81\x60\x30\x1c\x81\x61\x20\x16\x81\x62\xff\x5c\x81\x63\x20\x26\x81\x64\x20\x25\x81\x65\x20\x18\x81\x66\x20\x19\x81\x67\x20\x1c\x81\x68\x20\x1d\x81\x69\xff\x08\x81\x6a\xff\x09\x81\x6b\x30\x14\x81\x6c\x30\x15\x81\x6d\xff\x3b\x81\x6e\xff\x3d\x81\x6f\xff\x5b\x81\x70\xff\x5d\x81\x71\x30\x08\x81\x72\x30\x09\x81\x73\x30\x0a\x81\x74\x30\x0b\x81\x75\x30\x0c\x81\x76\x30\x0d\x81\x77\x30\x0e\x81\x78\x30\x0f\x81\x79\x30\x10\x81\x7a\x30\x11\x81\x7b\xff\x0b\x81\x7c\x22\x12\x81\x7d\x00\xb1\x81\x7e\x00\xd7\x81\x80\x00\xf7\x81\x81\xff\x1d\x81\x82\x22\x60\x81\x83\xff\x1c\x81\x84\xff\x1e\x81\x85\x22\x66\x81\x86\x22\x67\x81\x87\x22\x1e\x81\x88\x22\x34\x81\x89\x26\x42\x81\x8a\x26\x40\x81\x8b\x00\xb0\x81\x8c\x20\x32\x81\x8d\x20\x33\x81\x8e\x21\x03\x81\x8f\xff\xe5\x81\x90\xff\x04\x81\x91\x00\xa2\x81\x92\x00\xa3\x81\x93\xff\x05\x81\x94\xff\x03\x81\x95\xff\x06\x81\x96\xff\x0a\x81\x97\xff\x20\x81\x98\x00\xa7\x81\x99\x26\x06\x81\x9a\x26\x05\x81\x9b\x25\xcb\x81\x9c\x25\xcf\x81\x9d\x25\xce\x81\x9e\x25\xc7\x81\x9f\x25\xc6\x81\xa0\x25\xa1\x81\xa1\x25\xa0\x81\xa2\x25\xb3\x81\xa3\x25\xb2\x81\xa4\x25\xbd\x81\xa5\x25\xbc\x81\xa6\x20\x3b\x81\xa7\x30\x12\x81\xa8\x21\x92\x81\xa9\x21\x90\x81\xaa\x21\x91\x81\xab\x21\x93\x81\xac\x30\x13\x81\xb8\x22\x08\x81\xb9\x22\x0b\x81\xba\x22\x86\x81\xbb\x22\x87\x81\xbc\x22\x82\x81\xbd\x22\x83\x81\xbe\x22\x2a\x81\xbf\x22\x29\x81\xc8\x22\x27\x81\xc9\x22\x28\x81\xca\x00\xac\x81\xcb\x21\xd2\x81\xcc\x21\xd4\x81\xcd\x22\x00\x81\xce\x22\x03\x81\xda\x22\x20\x81\xdb\x22\xa5\x81\xdc\x23\x12\x81\xdd\x22\x02\x81\xde\x22\x07\x81\xdf\x22\x61\x81\xe0\x22\x52\x81\xe1\x22\x6a\x81\xe2\x22\x6b\x81\xe3\x22\x1a\x81\xe4\x22\x3d\x81\xe5\x22\x1d\x81\xe6\x22\x35\x81\xe7\x22\x2b\x81\xe8\x22\x2c\x81\xf0\x21\x2b\x81\xf1\x20\x30\x81\xf2\x26\x6f\x81\xf3\x26\x6d\x81\xf4\x26\x6a\x81\xf5\x20\x20\x81\xf6\x20\x21\x81\xf7\x00\xb6\x81\xfc\x25\xef\x82\x4f\xff\x10\x82\x50\xff\x11\x82\x51\xff\x12\x82\x52\xff\x13\x82\x53\xff\x14\x82\x54\xff\x15\x82\x55\xff\x16\x82\x56\xff\x17\x82\x57\xff\x18\x82\x58\xff\x19\x82\x60\xff\x21\x82\x61\xff\x22\x82\x62\xff\x23\x82\x63\xff\x24\x82\x64\xff\x25\x82\x65\xff\x26\x82\x66\xff\x27\x82\x67\xff\x28\x82\x68\xff\x29\x82\x69\xff\x2a\x82\x6a\xff\x2b\x82\x6b\xff\x2c\x82\x6c\xff\x2d\x82\x6d\xff\x2e\x82\x6e\xff\x2f\x82\x6f\xff\x30\x82\x70\xff\x31\x82\x71\xff\x32\x82\x72\xff\x33\x82\x73\xff\x34\x82\x74\xff\x35\x82\x75\xff\x36\x82\x76\xff\x37\x82\x77\xff\x38\x82\x78\xff\x39\x82\x79\xff\x3a\x82\x81\xff\x41\x82\x82\xff\x42\x82\x83\xff\x43\x82\x84\xff\x44\x82\x85\xff\x45\x82\x86\xff\x46\x82\x87\xff\x47\x82\x88\xff\x48\x82\x89\xff\x49\x82\x8a\xff\x4a\x82\x8b\xff\x4b\x82\x8c\xff\x4c\x82\x8d\xff\x4d\x82\x8e\xff\x4e\x82\x8f\xff\x4f\x82\x90\xff\x50\x82\x91\xff\x51\x82\x92\xff\x52\x82\x93\xff\x53\x82\x94\xff\x54\x82\x95\xff\x55\x82\x96\xff\x56\x82\x97\xff\x57\x82\x98\xff\x58\x82\x99\xff\x59\x82\x9a\xff\x5a\x82\x9f\x30\x41\x82\xa0\x30\x42\x82\xa1\x30\x43\x82\xa2\x30\x44\x82\xa3\x30\x45\x82\xa4\x30\x46\x82\xa5\x30\x47\x82\xa6\x30\x48\x82\xa7\x30\x49\x82\xa8\x30\x4a\x82\xa9\x30\x4b\x82\xaa\x30\x4c\x82\xab\x30\x4d\x82\xac\x30\x4e\x82\xad\x30\x4f\x82\xae\x30\x50\x82\xaf\x30\x51\x82\xb0\x30\x52\x82\xb1\x30\x53\x82\xb2\x30\x54\x82\xb3\x30\x55\x82\xb4\x30\x56\x82\xb5\x30\x57\x82\xb6\x30\x58\x82\xb7\x30\x59\x82\xb8\x30\x5a\x82\xb9\x30\x5b\x82\xba\x30\x5c\x82\xbb\x30\x5d\x82\xbc\x30\x5e\x82\xbd\x30\x5f\x82\xbe\x30\x60\x82\xbf\x30\x61\x82\xc0\x30\x62\x82\xc1\x30\x63\x82\xc2\x30\x64\x82\xc3\x30\x65\x82\xc4\x30\x66\x82\xc5\x30\x67\x82\xc6\x30\x68\x82\xc7\x30\x69\x82\xc8\x30\x6a\x82\xc9\x30\x6b\x82\xca\x30\x6c\x82\xcb\x30\x6d\x82\xcc\x30\x6e\x82\xcd\x30\x6f\x82\xce\x30\x70\x82\xcf\x30\x71\x82\xd0\x30\x72\x82\xd1\x30\x73\x82\xd2\x30\x74\x82\xd3\x30\x75\x82\xd4\x30\x76\x82\xd5\x30\x77\x82\xd6\x30\x78\x82\xd7\x30\x79\x82\xd8\x30\x7a\x82\xd9\x30\x7b\x82\xda\x30\x7c\x82\xdb\x30\x7d\x82\xdc\x30\x7e\x82\xdd\x30\x7f\x82\xde\x30\x80\x82\xdf\x30\x81\x82\xe0\x30\x82\x82\xe1\x30\x83\x82\xe2\x30\x84\x82\xe3\x30\x85\x82\xe4\x30\x86\x82\xe5\x30\x87\x82\xe6\x30\x88\x82\xe7\x30\x89\x82\xe8\x30\x8a\x82\xe9\x30\x8b\x82\xea\x30\x8c\x82\xeb\x30\x8d\x82\xec\x30\x8e\x82\xed\x30\x8f\x82\xee\x30\x90\x82\xef\x30\x91\x82\xf0\x30\x92\x82\xf1\x30\x93\x83\x40\x30\xa1\x83\x41\x30\xa2\x83\x42\x30\xa3\x83\x43\x30\xa4\x83\x44\x30\xa5\x83\x45\x30\xa6\x83\x46\x30\xa7\x83\x47\x30\xa8\x83\x48\x30\xa9\x83\x49\x30\xaa\x83\x4a\x30\xab\x83\x4b\x30\xac\x83\x4c\x30\xad\x83\x4d\x30\xae\x83\x4e\x30\xaf\x83\x4f\x30\xb0\x83\x50\x30\xb1\x83\x51\x30\xb2\x83\x52\x30\xb3\x83\x53\x30\xb4\x83\x54\x30\xb5\x83\x55\x30\xb6\x83\x56\x30\xb7\x83\x57\x30\xb8\x83\x58\x30\xb9\x83\x59\x30\xba\x83\x5a\x30\xbb\x83\x5b\x30\xbc\x83\x5c\x30\xbd\x83\x5d\x30\xbe\x83\x5e\x30\xbf\x83\x5f\x30\xc0\x83\x60\x30\xc1\x83\x61\x30\xc2\x83\x62\x30\xc3\x83\x63\x30\xc4\x83\x64\x30\xc5\x83\x65\x30\xc6\x83\x66\x30\xc7\x83\x67\x30\xc8\x83\x68\x30\xc9\x83\x69\x30\xca\x83\x6a\x30\xcb\x83\x6b\x30\xcc\x83\x6c\x30\xcd\x83\x6d\x30\xce\x83\x6e\x30\xcf\x83\x6f\x30\xd0\x83\x70\x30\xd1\x83\x71\x30\xd2\x83\x72\x30\xd3\x83\x73\x30\xd4\x83\x74\x30\xd5\x83\x75\x30\xd6\x83\x76\x30\xd7\x83\x77\x30\xd8\x83\x78\x30\xd9\x83\x79\x30\xda\x83\x7a\x30\xdb\x83\x7b\x30\xdc\x83\x7c\x30\xdd\x83\x7d\x30\xde\x83\x7e\x30\xdf\x83\x80\x30\xe0\x83\x81\x30\xe1\x83\x82\x30\xe2\x83\x83\x30\xe3\x83\x84\x30\xe4\x83\x85\x30\xe5\x83\x86\x30\xe6\x83\x87\x30\xe7\x83\x88\x30\xe8\x83\x89\x30\xe9\x83\x8a\x30\xea\x83\x8b\x30\xeb\x83\x8c\x30\xec\x83\x8d\x30\xed\x83\x8e\x30\xee\x83\x8f\x30\xef\x83\x90\x30\xf0\x83\x91\x30\xf1\x83\x92\x30\xf2\x83\x93\x30\xf3\x83\x94\x30\xf4\x83\x95\x30\xf5\x83\x96\x30\xf6\x83\x9f\x03\x91\x83\xa0\x03\x92\x83\xa1\x03\x93\x83\xa2\x03\x94\x83\xa3\x03\x95\x83\xa4\x03\x96\x83\xa5\x03\x97\x83\xa6\x03\x98\x83\xa7\x03\x99\x83\xa8\x03\x9a\x83\xa9\x03\x9b\x83\xaa\x03\x9c\x83\xab\x03\x9d\x83\xac\x03\x9e\x83\xad\x03\x9f\x83\xae\x03\xa0\x83\xaf\x03\xa1\x83\xb0\x03\xa3\x83\xb1\x03\xa4\x83\xb2\x03\xa5\x83\xb3\x03\xa6\x83\xb4\x03\xa7\x83\xb5\x03\xa8\x83\xb6\x03\xa9\x83\xbf\x03\xb1\x83\xc0\x03\xb2\x83\xc1\x03\xb3\x83\xc2\x03\xb4\x83\xc3\x03\xb5\x83\xc4\x03\xb6\x83\xc5\x03\xb7\x83\xc6\x03\xb8\x83\xc7\x03\xb9\x83\xc8\x03\xba\x83\xc9\x03\xbb\x83\xca\x03\xbc\x83\xcb\x03\xbd\x83\xcc\x03\xbe\x83\xcd\x03\xbf\x83\xce\x03\xc0\x83\xcf\x03\xc1\x83\xd0\x03\xc3\x83\xd1\x03\xc4\x83\xd2\x03\xc5\x83\xd3\x03\xc6\x83\xd4\x03\xc7\x83\xd5\x03\xc8\x83\xd6\x03\xc9\x84\x40\x04\x10\x84\x41\x04\x11\x84\x42\x04\x12\x84\x43\x04\x13\x84\x44\x04\x14\x84\x45\x04\x15\x84\x46\x04\x01\x84\x47\x04\x16\x84\x48\x04\x17\x84\x49\x04\x18\x84\x4a\x04\x19\x84\x4b\x04\x1a\x84\x4c\x04\x1b\x84\x4d\x04\x1c\x84\x4e\x04\x1d\x84\x4f\x04\x1e\x84\x50\x04\x1f\x84\x51\x04\x20\x84\x52\x04\x21\x84\x53\x04\x22\x84\x54\x04\x23\x84\x55\x04\x24\x84\x56\x04\x25\x84\x57\x04\x26\x84\x58\x04\x27\x84\x59\x04\x28\x84\x5a\x04\x29\x84\x5b\x04\x2a\x84\x5c\x04\x2b\x84\x5d\x04\x2c\x84\x5e\x04\x2d\x84\x5f\x04\x2e\x84\x60\x04\x2f\x84\x70\x04\x30\x84\x71\x04\x31\x84\x72\x04\x32\x84\x73\x04\x33\x84\x74\x04\x34\x84\x75\x04\x35\x84\x76\x04\x51\x84\x77\x04\x36\x84\x78\x04\x37\x84\x79\x04\x38\x84\x7a\x04\x39\x84\x7b\x04\x3a\x84\x7c\x04\x3b\x84\x7d\x04\x3c\x84\x7e\x04\x3d\x84\x80\x04\x3e\x84\x81\x04\x3f\x84\x82\x04\x40\x84\x83\x04\x41\x84\x84\x04\x42\x84\x85\x04\x43\x84\x86\x04\x44\x84\x87\x04\x45\x84\x88\x04\x46\x84\x89\x04\x47\x84\x8a\x04\x48\x84\x8b\x04\x49\x84\x8c\x04\x4a\x84\x8d\x04\x4b\x84\x8e\x04\x4c\x84\x8f\x04\x4d\x84\x90\x04\x4e\x84\x91\x04\x4f\x84\x9f\x25\x00\x84\xa0\x25\x02\x84\xa1\x25\x0c\x84\xa2\x25\x10\x84\xa3\x25\x18\x84\xa4\x25\x14\x84\xa5\x25\x1c\x84\xa6\x25\x2c\x84\xa7\x25\x24\x84\xa8\x25\x34\x84\xa9\x25\x3c\x84\xaa\x25\x01\x84\xab\x25\x03\x84\xac\x25\x0f\x84\xad\x25\x13\x84\xae\x25\x1b\x84\xaf\x25\x17\x84\xb0\x25\x23\x84\xb1\x25\x33\x84\xb2\x25\x2b\x84\xb3\x25\x3b\x84\xb4\x25\x4b\x84\xb5\x25\x20\x84\xb6\x25\x2f\x84\xb7\x25\x28\x84\xb8\x25\x37\x84\xb9\x25\x3f\x84\xba\x25\x1d\x84\xbb\x25\x30\x84\xbc\x25\x25\x84\xbd\x25\x38\x84\xbe\x25\x42\x88\x9f\x4e\x9c\x88\xa0\x55\x16\x88\xa1\x5a\x03\x88\xa2\x96\x3f\x88\xa3\x54\xc0\x88\xa4\x61\x1b\x88\xa5\x63\x28\x88\xa6\x59\xf6\x88\xa7\x90\x22\x88\xa8\x84\x75\x88\xa9\x83\x1c\x88\xaa\x7a\x50\x88\xab\x60\xaa\x88\xac\x63\xe1\x88\xad\x6e\x25\x88\xae\x65\xed\x88\xaf\x84\x66\x88\xb0\x82\xa6\x88\xb1\x9b\xf5\x88\xb2\x68\x93\x88\xb3\x57\x27\x88\xb4\x65\xa1\x88\xb5\x62\x71\x88\xb6\x5b\x9b\x88\xb7\x59\xd0\x88\xb8\x86\x7b\x88\xb9\x98\xf4\x88\xba\x7d\x62\x88\xbb\x7d\xbe\x88\xbc\x9b\x8e\x88\xbd\x62\x16\x88\xbe\x7c\x9f\x88\xbf\x88\xb7\x88\xc0\x5b\x89\x88\xc1\x5e\xb5\x88\xc2\x63\x09\x88\xc3\x66\x97\x88\xc4\x68\x48\x88\xc5\x95\xc7\x88\xc6\x97\x8d\x88\xc7\x67\x4f\x88\xc8\x4e\xe5\x88\xc9\x4f\x0a\x88\xca\x4f\x4d\x88\xcb\x4f\x9d\x88\xcc\x50\x49\x88\xcd\x56\xf2\x88\xce\x59\x37\x88\xcf\x59\xd4\x88\xd0\x5a\x01\x88\xd1\x5c\x09\x88\xd2\x60\xdf\x88\xd3\x61\x0f\x88\xd4\x61\x70\x88\xd5\x66\x13\x88\xd6\x69\x05\x88\xd7\x70\xba\x88\xd8\x75\x4f\x88\xd9\x75\x70\x88\xda\x79\xfb\x88\xdb\x7d\xad\x88\xdc\x7d\xef\x88\xdd\x80\xc3\x88\xde\x84\x0e\x88\xdf\x88\x63\x88\xe0\x8b\x02\x88\xe1\x90\x55\x88\xe2\x90\x7a\x88\xe3\x53\x3b\x88\xe4\x4e\x95\x88\xe5\x4e\xa5\x88\xe6\x57\xdf\x88\xe7\x80\xb2\x88\xe8\x90\xc1\x88\xe9\x78\xef\x88\xea\x4e\x00\x88\xeb\x58\xf1\x88\xec\x6e\xa2\x88\xed\x90\x38\x88\xee\x7a\x32\x88\xef\x83\x28\x88\xf0\x82\x8b\x88\xf1\x9c\x2f\x88\xf2\x51\x41\x88\xf3\x53\x70\x88\xf4\x54\xbd\x88\xf5\x54\xe1\x88\xf6\x56\xe0\x88\xf7\x59\xfb\x88\xf8\x5f\x15\x88\xf9\x98\xf2\x88\xfa\x6d\xeb\x88\xfb\x80\xe4\x88\xfc\x85\x2d\x89\x40\x96\x62\x89\x41\x96\x70\x89\x42\x96\xa0\x89\x43\x97\xfb\x89\x44\x54\x0b\x89\x45\x53\xf3\x89\x46\x5b\x87\x89\x47\x70\xcf\x89\x48\x7f\xbd\x89\x49\x8f\xc2\x89\x4a\x96\xe8\x89\x4b\x53\x6f\x89\x4c\x9d\x5c\x89\x4d\x7a\xba\x89\x4e\x4e\x11\x89\x4f\x78\x93\x89\x50\x81\xfc\x89\x51\x6e\x26\x89\x52\x56\x18\x89\x53\x55\x04\x89\x54\x6b\x1d\x89\x55\x85\x1a\x89\x56\x9c\x3b\x89\x57\x59\xe5\x89\x58\x53\xa9\x89\x59\x6d\x66\x89\x5a\x74\xdc\x89\x5b\x95\x8f\x89\x5c\x56\x42\x89\x5d\x4e\x91\x89\x5e\x90\x4b\x89\x5f\x96\xf2\x89\x60\x83\x4f\x89\x61\x99\x0c\x89\x62\x53\xe1\x89\x63\x55\xb6\x89\x64\x5b\x30\x89\x65\x5f\x71\x89\x66\x66\x20\x89\x67\x66\xf3\x89\x68\x68\x04\x89\x69\x6c\x38\x89\x6a\x6c\xf3\x89\x6b\x6d\x29\x89\x6c\x74\x5b\x89\x6d\x76\xc8\x89\x6e\x7a\x4e\x89\x6f\x98\x34\x89\x70\x82\xf1\x89\x71\x88\x5b\x89\x72\x8a\x60\x89\x73\x92\xed\x89\x74\x6d\xb2\x89\x75\x75\xab\x89\x76\x76\xca\x89\x77\x99\xc5\x89\x78\x60\xa6\x89\x79\x8b\x01\x89\x7a\x8d\x8a\x89\x7b\x95\xb2\x89\x7c\x69\x8e\x89\x7d\x53\xad\x89\x7e\x51\x86\x89\x80\x57\x12\x89\x81\x58\x30\x89\x82\x59\x44\x89\x83\x5b\xb4\x89\x84\x5e\xf6\x89\x85\x60\x28\x89\x86\x63\xa9\x89\x87\x63\xf4\x89\x88\x6c\xbf\x89\x89\x6f\x14\x89\x8a\x70\x8e\x89\x8b\x71\x14\x89\x8c\x71\x59\x89\x8d\x71\xd5\x89\x8e\x73\x3f\x89\x8f\x7e\x01\x89\x90\x82\x76\x89\x91\x82\xd1\x89\x92\x85\x97\x89\x93\x90\x60\x89\x94\x92\x5b\x89\x95\x9d\x1b\x89\x96\x58\x69\x89\x97\x65\xbc\x89\x98\x6c\x5a\x89\x99\x75\x25\x89\x9a\x51\xf9\x89\x9b\x59\x2e\x89\x9c\x59\x65\x89\x9d\x5f\x80\x89\x9e\x5f\xdc\x89\x9f\x62\xbc\x89\xa0\x65\xfa\x89\xa1\x6a\x2a\x89\xa2\x6b\x27\x89\xa3\x6b\xb4\x89\xa4\x73\x8b\x89\xa5\x7f\xc1\x89\xa6\x89\x56\x89\xa7\x9d\x2c\x89\xa8\x9d\x0e\x89\xa9\x9e\xc4\x89\xaa\x5c\xa1\x89\xab\x6c\x96\x89\xac\x83\x7b\x89\xad\x51\x04\x89\xae\x5c\x4b\x89\xaf\x61\xb6\x89\xb0\x81\xc6\x89\xb1\x68\x76\x89\xb2\x72\x61\x89\xb3\x4e\x59\x89\xb4\x4f\xfa\x89\xb5\x53\x78\x89\xb6\x60\x69\x89\xb7\x6e\x29\x89\xb8\x7a\x4f\x89\xb9\x97\xf3\x89\xba\x4e\x0b\x89\xbb\x53\x16\x89\xbc\x4e\xee\x89\xbd\x4f\x55\x89\xbe\x4f\x3d\x89\xbf\x4f\xa1\x89\xc0\x4f\x73\x89\xc1\x52\xa0\x89\xc2\x53\xef\x89\xc3\x56\x09\x89\xc4\x59\x0f\x89\xc5\x5a\xc1\x89\xc6\x5b\xb6\x89\xc7\x5b\xe1\x89\xc8\x79\xd1\x89\xc9\x66\x87\x89\xca\x67\x9c\x89\xcb\x67\xb6\x89\xcc\x6b\x4c\x89\xcd\x6c\xb3\x89\xce\x70\x6b\x89\xcf\x73\xc2\x89\xd0\x79\x8d\x89\xd1\x79\xbe\x89\xd2\x7a\x3c\x89\xd3\x7b\x87\x89\xd4\x82\xb1\x89\xd5\x82\xdb\x89\xd6\x83\x04\x89\xd7\x83\x77\x89\xd8\x83\xef\x89\xd9\x83\xd3\x89\xda\x87\x66\x89\xdb\x8a\xb2\x89\xdc\x56\x29\x89\xdd\x8c\xa8\x89\xde\x8f\xe6\x89\xdf\x90\x4e\x89\xe0\x97\x1e\x89\xe1\x86\x8a\x89\xe2\x4f\xc4\x89\xe3\x5c\xe8\x89\xe4\x62\x11\x89\xe5\x72\x59\x89\xe6\x75\x3b\x89\xe7\x81\xe5\x89\xe8\x82\xbd\x89\xe9\x86\xfe\x89\xea\x8c\xc0\x89\xeb\x96\xc5\x89\xec\x99\x13\x89\xed\x99\xd5\x89\xee\x4e\xcb\x89\xef\x4f\x1a\x89\xf0\x89\xe3\x89\xf1\x56\xde\x89\xf2\x58\x4a\x89\xf3\x58\xca\x89\xf4\x5e\xfb\x89\xf5\x5f\xeb\x89\xf6\x60\x2a\x89\xf7\x60\x94\x89\xf8\x60\x62\x89\xf9\x61\xd0\x89\xfa\x62\x12\x89\xfb\x62\xd0\x89\xfc\x65\x39\x8a\x40\x9b\x41\x8a\x41\x66\x66\x8a\x42\x68\xb0\x8a\x43\x6d\x77\x8a\x44\x70\x70\x8a\x45\x75\x4c\x8a\x46\x76\x86\x8a\x47\x7d\x75\x8a\x48\x82\xa5\x8a\x49\x87\xf9\x8a\x4a\x95\x8b\x8a\x4b\x96\x8e\x8a\x4c\x8c\x9d\x8a\x4d\x51\xf1\x8a\x4e\x52\xbe\x8a\x4f\x59\x16\x8a\x50\x54\xb3\x8a\x51\x5b\xb3\x8a\x52\x5d\x16\x8a\x53\x61\x68\x8a\x54\x69\x82\x8a\x55\x6d\xaf\x8a\x56\x78\x8d\x8a\x57\x84\xcb\x8a\x58\x88\x57\x8a\x59\x8a\x72\x8a\x5a\x93\xa7\x8a\x5b\x9a\xb8\x8a\x5c\x6d\x6c\x8a\x5d\x99\xa8\x8a\x5e\x86\xd9\x8a\x5f\x57\xa3\x8a\x60\x67\xff\x8a\x61\x86\xce\x8a\x62\x92\x0e\x8a\x63\x52\x83\x8a\x64\x56\x87\x8a\x65\x54\x04\x8a\x66\x5e\xd3\x8a\x67\x62\xe1\x8a\x68\x64\xb9\x8a\x69\x68\x3c\x8a\x6a\x68\x38\x8a\x6b\x6b\xbb\x8a\x6c\x73\x72\x8a\x6d\x78\xba\x8a\x6e\x7a\x6b\x8a\x6f\x89\x9a\x8a\x70\x89\xd2\x8a\x71\x8d\x6b\x8a\x72\x8f\x03\x8a\x73\x90\xed\x8a\x74\x95\xa3\x8a\x75\x96\x94\x8a\x76\x97\x69\x8a\x77\x5b\x66\x8a\x78\x5c\xb3\x8a\x79\x69\x7d\x8a\x7a\x98\x4d\x8a\x7b\x98\x4e\x8a\x7c\x63\x9b\x8a\x7d\x7b\x20\x8a\x7e\x6a\x2b\x8a\x80\x6a\x7f\x8a\x81\x68\xb6\x8a\x82\x9c\x0d\x8a\x83\x6f\x5f\x8a\x84\x52\x72\x8a\x85\x55\x9d\x8a\x86\x60\x70\x8a\x87\x62\xec\x8a\x88\x6d\x3b\x8a\x89\x6e\x07\x8a\x8a\x6e\xd1\x8a\x8b\x84\x5b\x8a\x8c\x89\x10\x8a\x8d\x8f\x44\x8a\x8e\x4e\x14\x8a\x8f\x9c\x39\x8a\x90\x53\xf6\x8a\x91\x69\x1b\x8a\x92\x6a\x3a\x8a\x93\x97\x84\x8a\x94\x68\x2a\x8a\x95\x51\x5c\x8a\x96\x7a\xc3\x8a\x97\x84\xb2\x8a\x98\x91\xdc\x8a\x99\x93\x8c\x8a\x9a\x56\x5b\x8a\x9b\x9d\x28\x8a\x9c\x68\x22\x8a\x9d\x83\x05\x8a\x9e\x84\x31\x8a\x9f\x7c\xa5\x8a\xa0\x52\x08\x8a\xa1\x82\xc5\x8a\xa2\x74\xe6\x8a\xa3\x4e\x7e\x8a\xa4\x4f\x83\x8a\xa5\x51\xa0\x8a\xa6\x5b\xd2\x8a\xa7\x52\x0a\x8a\xa8\x52\xd8\x8a\xa9\x52\xe7\x8a\xaa\x5d\xfb\x8a\xab\x55\x9a\x8a\xac\x58\x2a\x8a\xad\x59\xe6\x8a\xae\x5b\x8c\x8a\xaf\x5b\x98\x8a\xb0\x5b\xdb\x8a\xb1\x5e\x72\x8a\xb2\x5e\x79\x8a\xb3\x60\xa3\x8a\xb4\x61\x1f\x8a\xb5\x61\x63\x8a\xb6\x61\xbe\x8a\xb7\x63\xdb\x8a\xb8\x65\x62\x8a\xb9\x67\xd1\x8a\xba\x68\x53\x8a\xbb\x68\xfa\x8a\xbc\x6b\x3e\x8a\xbd\x6b\x53\x8a\xbe\x6c\x57\x8a\xbf\x6f\x22\x8a\xc0\x6f\x97\x8a\xc1\x6f\x45\x8a\xc2\x74\xb0\x8a\xc3\x75\x18\x8a\xc4\x76\xe3\x8a\xc5\x77\x0b\x8a\xc6\x7a\xff\x8a\xc7\x7b\xa1\x8a\xc8\x7c\x21\x8a\xc9\x7d\xe9\x8a\xca\x7f\x36\x8a\xcb\x7f\xf0\x8a\xcc\x80\x9d\x8a\xcd\x82\x66\x8a\xce\x83\x9e\x8a\xcf\x89\xb3\x8a\xd0\x8a\xcc\x8a\xd1\x8c\xab\x8a\xd2\x90\x84\x8a\xd3\x94\x51\x8a\xd4\x95\x93\x8a\xd5\x95\x91\x8a\xd6\x95\xa2\x8a\xd7\x96\x65\x8a\xd8\x97\xd3\x8a\xd9\x99\x28\x8a\xda\x82\x18\x8a\xdb\x4e\x38\x8a\xdc\x54\x2b\x8a\xdd\x5c\xb8\x8a\xde\x5d\xcc\x8a\xdf\x73\xa9\x8a\xe0\x76\x4c\x8a\xe1\x77\x3c\x8a\xe2\x5c\xa9\x8a\xe3\x7f\xeb\x8a\xe4\x8d\x0b\x8a\xe5\x96\xc1\x8a\xe6\x98\x11\x8a\xe7\x98\x54\x8a\xe8\x98\x58\x8a\xe9\x4f\x01\x8a\xea\x4f\x0e\x8a\xeb\x53\x71\x8a\xec\x55\x9c\x8a\xed\x56\x68\x8a\xee\x57\xfa\x8a\xef\x59\x47\x8a\xf0\x5b\x09\x8a\xf1\x5b\xc4\x8a\xf2\x5c\x90\x8a\xf3\x5e\x0c\x8a\xf4\x5e\x7e\x8a\xf5\x5f\xcc\x8a\xf6\x63\xee\x8a\xf7\x67\x3a\x8a\xf8\x65\xd7\x8a\xf9\x65\xe2\x8a\xfa\x67\x1f\x8a\xfb\x68\xcb\x8a\xfc\x68\xc4\x8b\x40\x6a\x5f\x8b\x41\x5e\x30\x8b\x42\x6b\xc5\x8b\x43\x6c\x17\x8b\x44\x6c\x7d\x8b\x45\x75\x7f\x8b\x46\x79\x48\x8b\x47\x5b\x63\x8b\x48\x7a\x00\x8b\x49\x7d\x00\x8b\x4a\x5f\xbd\x8b\x4b\x89\x8f\x8b\x4c\x8a\x18\x8b\x4d\x8c\xb4\x8b\x4e\x8d\x77\x8b\x4f\x8e\xcc\x8b\x50\x8f\x1d\x8b\x51\x98\xe2\x8b\x52\x9a\x0e\x8b\x53\x9b\x3c\x8b\x54\x4e\x80\x8b\x55\x50\x7d\x8b\x56\x51\x00\x8b\x57\x59\x93\x8b\x58\x5b\x9c\x8b\x59\x62\x2f\x8b\x5a\x62\x80\x8b\x5b\x64\xec\x8b\x5c\x6b\x3a\x8b\x5d\x72\xa0\x8b\x5e\x75\x91\x8b\x5f\x79\x47\x8b\x60\x7f\xa9\x8b\x61\x87\xfb\x8b\x62\x8a\xbc\x8b\x63\x8b\x70\x8b\x64\x63\xac\x8b\x65\x83\xca\x8b\x66\x97\xa0\x8b\x67\x54\x09\x8b\x68\x54\x03\x8b\x69\x55\xab\x8b\x6a\x68\x54\x8b\x6b\x6a\x58\x8b\x6c\x8a\x70\x8b\x6d\x78\x27\x8b\x6e\x67\x75\x8b\x6f\x9e\xcd\x8b\x70\x53\x74\x8b\x71\x5b\xa2\x8b\x72\x81\x1a\x8b\x73\x86\x50\x8b\x74\x90\x06\x8b\x75\x4e\x18\x8b\x76\x4e\x45\x8b\x77\x4e\xc7\x8b\x78\x4f\x11\x8b\x79\x53\xca\x8b\x7a\x54\x38\x8b\x7b\x5b\xae\x8b\x7c\x5f\x13\x8b\x7d\x60\x25\x8b\x7e\x65\x51\x8b\x80\x67\x3d\x8b\x81\x6c\x42\x8b\x82\x6c\x72\x8b\x83\x6c\xe3\x8b\x84\x70\x78\x8b\x85\x74\x03\x8b\x86\x7a\x76\x8b\x87\x7a\xae\x8b\x88\x7b\x08\x8b\x89\x7d\x1a\x8b\x8a\x7c\xfe\x8b\x8b\x7d\x66\x8b\x8c\x65\xe7\x8b\x8d\x72\x5b\x8b\x8e\x53\xbb\x8b\x8f\x5c\x45\x8b\x90\x5d\xe8\x8b\x91\x62\xd2\x8b\x92\x62\xe0\x8b\x93\x63\x19\x8b\x94\x6e\x20\x8b\x95\x86\x5a\x8b\x96\x8a\x31\x8b\x97\x8d\xdd\x8b\x98\x92\xf8\x8b\x99\x6f\x01\x8b\x9a\x79\xa6\x8b\x9b\x9b\x5a\x8b\x9c\x4e\xa8\x8b\x9d\x4e\xab\x8b\x9e\x4e\xac\x8b\x9f\x4f\x9b\x8b\xa0\x4f\xa0\x8b\xa1\x50\xd1\x8b\xa2\x51\x47\x8b\xa3\x7a\xf6\x8b\xa4\x51\x71\x8b\xa5\x51\xf6\x8b\xa6\x53\x54\x8b\xa7\x53\x21\x8b\xa8\x53\x7f\x8b\xa9\x53\xeb\x8b\xaa\x55\xac\x8b\xab\x58\x83\x8b\xac\x5c\xe1\x8b\xad\x5f\x37\x8b\xae\x5f\x4a\x8b\xaf\x60\x2f\x8b\xb0\x60\x50\x8b\xb1\x60\x6d\x8b\xb2\x63\x1f\x8b\xb3\x65\x59\x8b\xb4\x6a\x4b\x8b\xb5\x6c\xc1\x8b\xb6\x72\xc2\x8b\xb7\x72\xed\x8b\xb8\x77\xef\x8b\xb9\x80\xf8\x8b\xba\x81\x05\x8b\xbb\x82\x08\x8b\xbc\x85\x4e\x8b\xbd\x90\xf7\x8b\xbe\x93\xe1\x8b\xbf\x97\xff\x8b\xc0\x99\x57\x8b\xc1\x9a\x5a\x8b\xc2\x4e\xf0\x8b\xc3\x51\xdd\x8b\xc4\x5c\x2d\x8b\xc5\x66\x81\x8b\xc6\x69\x6d\x8b\xc7\x5c\x40\x8b\xc8\x66\xf2\x8b\xc9\x69\x75\x8b\xca\x73\x89\x8b\xcb\x68\x50\x8b\xcc\x7c\x81\x8b\xcd\x50\xc5\x8b\xce\x52\xe4\x8b\xcf\x57\x47\x8b\xd0\x5d\xfe\x8b\xd1\x93\x26\x8b\xd2\x65\xa4\x8b\xd3\x6b\x23\x8b\xd4\x6b\x3d\x8b\xd5\x74\x34\x8b\xd6\x79\x81\x8b\xd7\x79\xbd\x8b\xd8\x7b\x4b\x8b\xd9\x7d\xca\x8b\xda\x82\xb9\x8b\xdb\x83\xcc\x8b\xdc\x88\x7f\x8b\xdd\x89\x5f\x8b\xde\x8b\x39\x8b\xdf\x8f\xd1\x8b\xe0\x91\xd1\x8b\xe1\x54\x1f\x8b\xe2\x92\x80\x8b\xe3\x4e\x5d\x8b\xe4\x50\x36\x8b\xe5\x53\xe5\x8b\xe6\x53\x3a\x8b\xe7\x72\xd7\x8b\xe8\x73\x96\x8b\xe9\x77\xe9\x8b\xea\x82\xe6\x8b\xeb\x8e\xaf\x8b\xec\x99\xc6\x8b\xed\x99\xc8\x8b\xee\x99\xd2\x8b\xef\x51\x77\x8b\xf0\x61\x1a\x8b\xf1\x86\x5e\x8b\xf2\x55\xb0\x8b\xf3\x7a\x7a\x8b\xf4\x50\x76\x8b\xf5\x5b\xd3\x8b\xf6\x90\x47\x8b\xf7\x96\x85\x8b\xf8\x4e\x32\x8b\xf9\x6a\xdb\x8b\xfa\x91\xe7\x8b\xfb\x5c\x51\x8b\xfc\x5c\x48\x8c\x40\x63\x98\x8c\x41\x7a\x9f\x8c\x42\x6c\x93\x8c\x43\x97\x74\x8c\x44\x8f\x61\x8c\x45\x7a\xaa\x8c\x46\x71\x8a\x8c\x47\x96\x88\x8c\x48\x7c\x82\x8c\x49\x68\x17\x8c\x4a\x7e\x70\x8c\x4b\x68\x51\x8c\x4c\x93\x6c\x8c\x4d\x52\xf2\x8c\x4e\x54\x1b\x8c\x4f\x85\xab\x8c\x50\x8a\x13\x8c\x51\x7f\xa4\x8c\x52\x8e\xcd\x8c\x53\x90\xe1\x8c\x54\x53\x66\x8c\x55\x88\x88\x8c\x56\x79\x41\x8c\x57\x4f\xc2\x8c\x58\x50\xbe\x8c\x59\x52\x11\x8c\x5a\x51\x44\x8c\x5b\x55\x53\x8c\x5c\x57\x2d\x8c\x5d\x73\xea\x8c\x5e\x57\x8b\x8c\x5f\x59\x51\x8c\x60\x5f\x62\x8c\x61\x5f\x84\x8c\x62\x60\x75\x8c\x63\x61\x76\x8c\x64\x61\x67\x8c\x65\x61\xa9\x8c\x66\x63\xb2\x8c\x67\x64\x3a\x8c\x68\x65\x6c\x8c\x69\x66\x6f\x8c\x6a\x68\x42\x8c\x6b\x6e\x13\x8c\x6c\x75\x66\x8c\x6d\x7a\x3d\x8c\x6e\x7c\xfb\x8c\x6f\x7d\x4c\x8c\x70\x7d\x99\x8c\x71\x7e\x4b\x8c\x72\x7f\x6b\x8c\x73\x83\x0e\x8c\x74\x83\x4a\x8c\x75\x86\xcd\x8c\x76\x8a\x08\x8c\x77\x8a\x63\x8c\x78\x8b\x66\x8c\x79\x8e\xfd\x8c\x7a\x98\x1a\x8c\x7b\x9d\x8f\x8c\x7c\x82\xb8\x8c\x7d\x8f\xce\x8c\x7e\x9b\xe8\x8c\x80\x52\x87\x8c\x81\x62\x1f\x8c\x82\x64\x83\x8c\x83\x6f\xc0\x8c\x84\x96\x99\x8c\x85\x68\x41\x8c\x86\x50\x91\x8c\x87\x6b\x20\x8c\x88\x6c\x7a\x8c\x89\x6f\x54\x8c\x8a\x7a\x74\x8c\x8b\x7d\x50\x8c\x8c\x88\x40\x8c\x8d\x8a\x23\x8c\x8e\x67\x08\x8c\x8f\x4e\xf6\x8c\x90\x50\x39\x8c\x91\x50\x26\x8c\x92\x50\x65\x8c\x93\x51\x7c\x8c\x94\x52\x38\x8c\x95\x52\x63\x8c\x96\x55\xa7\x8c\x97\x57\x0f\x8c\x98\x58\x05\x8c\x99\x5a\xcc\x8c\x9a\x5e\xfa\x8c\x9b\x61\xb2\x8c\x9c\x61\xf8\x8c\x9d\x62\xf3\x8c\x9e\x63\x72\x8c\x9f\x69\x1c\x8c\xa0\x6a\x29\x8c\xa1\x72\x7d\x8c\xa2\x72\xac\x8c\xa3\x73\x2e\x8c\xa4\x78\x14\x8c\xa5\x78\x6f\x8c\xa6\x7d\x79\x8c\xa7\x77\x0c\x8c\xa8\x80\xa9\x8c\xa9\x89\x8b\x8c\xaa\x8b\x19\x8c\xab\x8c\xe2\x8c\xac\x8e\xd2\x8c\xad\x90\x63\x8c\xae\x93\x75\x8c\xaf\x96\x7a\x8c\xb0\x98\x55\x8c\xb1\x9a\x13\x8c\xb2\x9e\x78\x8c\xb3\x51\x43\x8c\xb4\x53\x9f\x8c\xb5\x53\xb3\x8c\xb6\x5e\x7b\x8c\xb7\x5f\x26\x8c\xb8\x6e\x1b\x8c\xb9\x6e\x90\x8c\xba\x73\x84\x8c\xbb\x73\xfe\x8c\xbc\x7d\x43\x8c\xbd\x82\x37\x8c\xbe\x8a\x00\x8c\xbf\x8a\xfa\x8c\xc0\x96\x50\x8c\xc1\x4e\x4e\x8c\xc2\x50\x0b\x8c\xc3\x53\xe4\x8c\xc4\x54\x7c\x8c\xc5\x56\xfa\x8c\xc6\x59\xd1\x8c\xc7\x5b\x64\x8c\xc8\x5d\xf1\x8c\xc9\x5e\xab\x8c\xca\x5f\x27\x8c\xcb\x62\x38\x8c\xcc\x65\x45\x8c\xcd\x67\xaf\x8c\xce\x6e\x56\x8c\xcf\x72\xd0\x8c\xd0\x7c\xca\x8c\xd1\x88\xb4\x8c\xd2\x80\xa1\x8c\xd3\x80\xe1\x8c\xd4\x83\xf0\x8c\xd5\x86\x4e\x8c\xd6\x8a\x87\x8c\xd7\x8d\xe8\x8c\xd8\x92\x37\x8c\xd9\x96\xc7\x8c\xda\x98\x67\x8c\xdb\x9f\x13\x8c\xdc\x4e\x94\x8c\xdd\x4e\x92\x8c\xde\x4f\x0d\x8c\xdf\x53\x48\x8c\xe0\x54\x49\x8c\xe1\x54\x3e\x8c\xe2\x5a\x2f\x8c\xe3\x5f\x8c\x8c\xe4\x5f\xa1\x8c\xe5\x60\x9f\x8c\xe6\x68\xa7\x8c\xe7\x6a\x8e\x8c\xe8\x74\x5a\x8c\xe9\x78\x81\x8c\xea\x8a\x9e\x8c\xeb\x8a\xa4\x8c\xec\x8b\x77\x8c\xed\x91\x90\x8c\xee\x4e\x5e\x8c\xef\x9b\xc9\x8c\xf0\x4e\xa4\x8c\xf1\x4f\x7c\x8c\xf2\x4f\xaf\x8c\xf3\x50\x19\x8c\xf4\x50\x16\x8c\xf5\x51\x49\x8c\xf6\x51\x6c\x8c\xf7\x52\x9f\x8c\xf8\x52\xb9\x8c\xf9\x52\xfe\x8c\xfa\x53\x9a\x8c\xfb\x53\xe3\x8c\xfc\x54\x11\x8d\x40\x54\x0e\x8d\x41\x55\x89\x8d\x42\x57\x51\x8d\x43\x57\xa2\x8d\x44\x59\x7d\x8d\x45\x5b\x54\x8d\x46\x5b\x5d\x8d\x47\x5b\x8f\x8d\x48\x5d\xe5\x8d\x49\x5d\xe7\x8d\x4a\x5d\xf7\x8d\x4b\x5e\x78\x8d\x4c\x5e\x83\x8d\x4d\x5e\x9a\x8d\x4e\x5e\xb7\x8d\x4f\x5f\x18\x8d\x50\x60\x52\x8d\x51\x61\x4c\x8d\x52\x62\x97\x8d\x53\x62\xd8\x8d\x54\x63\xa7\x8d\x55\x65\x3b\x8d\x56\x66\x02\x8d\x57\x66\x43\x8d\x58\x66\xf4\x8d\x59\x67\x6d\x8d\x5a\x68\x21\x8d\x5b\x68\x97\x8d\x5c\x69\xcb\x8d\x5d\x6c\x5f\x8d\x5e\x6d\x2a\x8d\x5f\x6d\x69\x8d\x60\x6e\x2f\x8d\x61\x6e\x9d\x8d\x62\x75\x32\x8d\x63\x76\x87\x8d\x64\x78\x6c\x8d\x65\x7a\x3f\x8d\x66\x7c\xe0\x8d\x67\x7d\x05\x8d\x68\x7d\x18\x8d\x69\x7d\x5e\x8d\x6a\x7d\xb1\x8d\x6b\x80\x15\x8d\x6c\x80\x03\x8d\x6d\x80\xaf\x8d\x6e\x80\xb1\x8d\x6f\x81\x54\x8d\x70\x81\x8f\x8d\x71\x82\x2a\x8d\x72\x83\x52\x8d\x73\x88\x4c\x8d\x74\x88\x61\x8d\x75\x8b\x1b\x8d\x76\x8c\xa2\x8d\x77\x8c\xfc\x8d\x78\x90\xca\x8d\x79\x91\x75\x8d\x7a\x92\x71\x8d\x7b\x78\x3f\x8d\x7c\x92\xfc\x8d\x7d\x95\xa4\x8d\x7e\x96\x4d\x8d\x80\x98\x05\x8d\x81\x99\x99\x8d\x82\x9a\xd8\x8d\x83\x9d\x3b\x8d\x84\x52\x5b\x8d\x85\x52\xab\x8d\x86\x53\xf7\x8d\x87\x54\x08\x8d\x88\x58\xd5\x8d\x89\x62\xf7\x8d\x8a\x6f\xe0\x8d\x8b\x8c\x6a\x8d\x8c\x8f\x5f\x8d\x8d\x9e\xb9\x8d\x8e\x51\x4b\x8d\x8f\x52\x3b\x8d\x90\x54\x4a\x8d\x91\x56\xfd\x8d\x92\x7a\x40\x8d\x93\x91\x77\x8d\x94\x9d\x60\x8d\x95\x9e\xd2\x8d\x96\x73\x44\x8d\x97\x6f\x09\x8d\x98\x81\x70\x8d\x99\x75\x11\x8d\x9a\x5f\xfd\x8d\x9b\x60\xda\x8d\x9c\x9a\xa8\x8d\x9d\x72\xdb\x8d\x9e\x8f\xbc\x8d\x9f\x6b\x64\x8d\xa0\x98\x03\x8d\xa1\x4e\xca\x8d\xa2\x56\xf0\x8d\xa3\x57\x64\x8d\xa4\x58\xbe\x8d\xa5\x5a\x5a\x8d\xa6\x60\x68\x8d\xa7\x61\xc7\x8d\xa8\x66\x0f\x8d\xa9\x66\x06\x8d\xaa\x68\x39\x8d\xab\x68\xb1\x8d\xac\x6d\xf7\x8d\xad\x75\xd5\x8d\xae\x7d\x3a\x8d\xaf\x82\x6e\x8d\xb0\x9b\x42\x8d\xb1\x4e\x9b\x8d\xb2\x4f\x50\x8d\xb3\x53\xc9\x8d\xb4\x55\x06\x8d\xb5\x5d\x6f\x8d\xb6\x5d\xe6\x8d\xb7\x5d\xee\x8d\xb8\x67\xfb\x8d\xb9\x6c\x99\x8d\xba\x74\x73\x8d\xbb\x78\x02\x8d\xbc\x8a\x50\x8d\xbd\x93\x96\x8d\xbe\x88\xdf\x8d\xbf\x57\x50\x8d\xc0\x5e\xa7\x8d\xc1\x63\x2b\x8d\xc2\x50\xb5\x8d\xc3\x50\xac\x8d\xc4\x51\x8d\x8d\xc5\x67\x00\x8d\xc6\x54\xc9\x8d\xc7\x58\x5e\x8d\xc8\x59\xbb\x8d\xc9\x5b\xb0\x8d\xca\x5f\x69\x8d\xcb\x62\x4d\x8d\xcc\x63\xa1\x8d\xcd\x68\x3d\x8d\xce\x6b\x73\x8d\xcf\x6e\x08\x8d\xd0\x70\x7d\x8d\xd1\x91\xc7\x8d\xd2\x72\x80\x8d\xd3\x78\x15\x8d\xd4\x78\x26\x8d\xd5\x79\x6d\x8d\xd6\x65\x8e\x8d\xd7\x7d\x30\x8d\xd8\x83\xdc\x8d\xd9\x88\xc1\x8d\xda\x8f\x09\x8d\xdb\x96\x9b\x8d\xdc\x52\x64\x8d\xdd\x57\x28\x8d\xde\x67\x50\x8d\xdf\x7f\x6a\x8d\xe0\x8c\xa1\x8d\xe1\x51\xb4\x8d\xe2\x57\x42\x8d\xe3\x96\x2a\x8d\xe4\x58\x3a\x8d\xe5\x69\x8a\x8d\xe6\x80\xb4\x8d\xe7\x54\xb2\x8d\xe8\x5d\x0e\x8d\xe9\x57\xfc\x8d\xea\x78\x95\x8d\xeb\x9d\xfa\x8d\xec\x4f\x5c\x8d\xed\x52\x4a\x8d\xee\x54\x8b\x8d\xef\x64\x3e\x8d\xf0\x66\x28\x8d\xf1\x67\x14\x8d\xf2\x67\xf5\x8d\xf3\x7a\x84\x8d\xf4\x7b\x56\x8d\xf5\x7d\x22\x8d\xf6\x93\x2f\x8d\xf7\x68\x5c\x8d\xf8\x9b\xad\x8d\xf9\x7b\x39\x8d\xfa\x53\x19\x8d\xfb\x51\x8a\x8d\xfc\x52\x37\x8e\x40\x5b\xdf\x8e\x41\x62\xf6\x8e\x42\x64\xae\x8e\x43\x64\xe6\x8e\x44\x67\x2d\x8e\x45\x6b\xba\x8e\x46\x85\xa9\x8e\x47\x96\xd1\x8e\x48\x76\x90\x8e\x49\x9b\xd6\x8e\x4a\x63\x4c\x8e\x4b\x93\x06\x8e\x4c\x9b\xab\x8e\x4d\x76\xbf\x8e\x4e\x66\x52\x8e\x4f\x4e\x09\x8e\x50\x50\x98\x8e\x51\x53\xc2\x8e\x52\x5c\x71\x8e\x53\x60\xe8\x8e\x54\x64\x92\x8e\x55\x65\x63\x8e\x56\x68\x5f\x8e\x57\x71\xe6\x8e\x58\x73\xca\x8e\x59\x75\x23\x8e\x5a\x7b\x97\x8e\x5b\x7e\x82\x8e\x5c\x86\x95\x8e\x5d\x8b\x83\x8e\x5e\x8c\xdb\x8e\x5f\x91\x78\x8e\x60\x99\x10\x8e\x61\x65\xac\x8e\x62\x66\xab\x8e\x63\x6b\x8b\x8e\x64\x4e\xd5\x8e\x65\x4e\xd4\x8e\x66\x4f\x3a\x8e\x67\x4f\x7f\x8e\x68\x52\x3a\x8e\x69\x53\xf8\x8e\x6a\x53\xf2\x8e\x6b\x55\xe3\x8e\x6c\x56\xdb\x8e\x6d\x58\xeb\x8e\x6e\x59\xcb\x8e\x6f\x59\xc9\x8e\x70\x59\xff\x8e\x71\x5b\x50\x8e\x72\x5c\x4d\x8e\x73\x5e\x02\x8e\x74\x5e\x2b\x8e\x75\x5f\xd7\x8e\x76\x60\x1d\x8e\x77\x63\x07\x8e\x78\x65\x2f\x8e\x79\x5b\x5c\x8e\x7a\x65\xaf\x8e\x7b\x65\xbd\x8e\x7c\x65\xe8\x8e\x7d\x67\x9d\x8e\x7e\x6b\x62\x8e\x80\x6b\x7b\x8e\x81\x6c\x0f\x8e\x82\x73\x45\x8e\x83\x79\x49\x8e\x84\x79\xc1\x8e\x85\x7c\xf8\x8e\x86\x7d\x19\x8e\x87\x7d\x2b\x8e\x88\x80\xa2\x8e\x89\x81\x02\x8e\x8a\x81\xf3\x8e\x8b\x89\x96\x8e\x8c\x8a\x5e\x8e\x8d\x8a\x69\x8e\x8e\x8a\x66\x8e\x8f\x8a\x8c\x8e\x90\x8a\xee\x8e\x91\x8c\xc7\x8e\x92\x8c\xdc\x8e\x93\x96\xcc\x8e\x94\x98\xfc\x8e\x95\x6b\x6f\x8e\x96\x4e\x8b\x8e\x97\x4f\x3c\x8e\x98\x4f\x8d\x8e\x99\x51\x50\x8e\x9a\x5b\x57\x8e\x9b\x5b\xfa\x8e\x9c\x61\x48\x8e\x9d\x63\x01\x8e\x9e\x66\x42\x8e\x9f\x6b\x21\x8e\xa0\x6e\xcb\x8e\xa1\x6c\xbb\x8e\xa2\x72\x3e\x8e\xa3\x74\xbd\x8e\xa4\x75\xd4\x8e\xa5\x78\xc1\x8e\xa6\x79\x3a\x8e\xa7\x80\x0c\x8e\xa8\x80\x33\x8e\xa9\x81\xea\x8e\xaa\x84\x94\x8e\xab\x8f\x9e\x8e\xac\x6c\x50\x8e\xad\x9e\x7f\x8e\xae\x5f\x0f\x8e\xaf\x8b\x58\x8e\xb0\x9d\x2b\x8e\xb1\x7a\xfa\x8e\xb2\x8e\xf8\x8e\xb3\x5b\x8d\x8e\xb4\x96\xeb\x8e\xb5\x4e\x03\x8e\xb6\x53\xf1\x8e\xb7\x57\xf7\x8e\xb8\x59\x31\x8e\xb9\x5a\xc9\x8e\xba\x5b\xa4\x8e\xbb\x60\x89\x8e\xbc\x6e\x7f\x8e\xbd\x6f\x06\x8e\xbe\x75\xbe\x8e\xbf\x8c\xea\x8e\xc0\x5b\x9f\x8e\xc1\x85\x00\x8e\xc2\x7b\xe0\x8e\xc3\x50\x72\x8e\xc4\x67\xf4\x8e\xc5\x82\x9d\x8e\xc6\x5c\x61\x8e\xc7\x85\x4a\x8e\xc8\x7e\x1e\x8e\xc9\x82\x0e\x8e\xca\x51\x99\x8e\xcb\x5c\x04\x8e\xcc\x63\x68\x8e\xcd\x8d\x66\x8e\xce\x65\x9c\x8e\xcf\x71\x6e\x8e\xd0\x79\x3e\x8e\xd1\x7d\x17\x8e\xd2\x80\x05\x8e\xd3\x8b\x1d\x8e\xd4\x8e\xca\x8e\xd5\x90\x6e\x8e\xd6\x86\xc7\x8e\xd7\x90\xaa\x8e\xd8\x50\x1f\x8e\xd9\x52\xfa\x8e\xda\x5c\x3a\x8e\xdb\x67\x53\x8e\xdc\x70\x7c\x8e\xdd\x72\x35\x8e\xde\x91\x4c\x8e\xdf\x91\xc8\x8e\xe0\x93\x2b\x8e\xe1\x82\xe5\x8e\xe2\x5b\xc2\x8e\xe3\x5f\x31\x8e\xe4\x60\xf9\x8e\xe5\x4e\x3b\x8e\xe6\x53\xd6\x8e\xe7\x5b\x88\x8e\xe8\x62\x4b\x8e\xe9\x67\x31\x8e\xea\x6b\x8a\x8e\xeb\x72\xe9\x8e\xec\x73\xe0\x8e\xed\x7a\x2e\x8e\xee\x81\x6b\x8e\xef\x8d\xa3\x8e\xf0\x91\x52\x8e\xf1\x99\x96\x8e\xf2\x51\x12\x8e\xf3\x53\xd7\x8e\xf4\x54\x6a\x8e\xf5\x5b\xff\x8e\xf6\x63\x88\x8e\xf7\x6a\x39\x8e\xf8\x7d\xac\x8e\xf9\x97\x00\x8e\xfa\x56\xda\x8e\xfb\x53\xce\x8e\xfc\x54\x68\x8f\x40\x5b\x97\x8f\x41\x5c\x31\x8f\x42\x5d\xde\x8f\x43\x4f\xee\x8f\x44\x61\x01\x8f\x45\x62\xfe\x8f\x46\x6d\x32\x8f\x47\x79\xc0\x8f\x48\x79\xcb\x8f\x49\x7d\x42\x8f\x4a\x7e\x4d\x8f\x4b\x7f\xd2\x8f\x4c\x81\xed\x8f\x4d\x82\x1f\x8f\x4e\x84\x90\x8f\x4f\x88\x46\x8f\x50\x89\x72\x8f\x51\x8b\x90\x8f\x52\x8e\x74\x8f\x53\x8f\x2f\x8f\x54\x90\x31\x8f\x55\x91\x4b\x8f\x56\x91\x6c\x8f\x57\x96\xc6\x8f\x58\x91\x9c\x8f\x59\x4e\xc0\x8f\x5a\x4f\x4f\x8f\x5b\x51\x45\x8f\x5c\x53\x41\x8f\x5d\x5f\x93\x8f\x5e\x62\x0e\x8f\x5f\x67\xd4\x8f\x60\x6c\x41\x8f\x61\x6e\x0b\x8f\x62\x73\x63\x8f\x63\x7e\x26\x8f\x64\x91\xcd\x8f\x65\x92\x83\x8f\x66\x53\xd4\x8f\x67\x59\x19\x8f\x68\x5b\xbf\x8f\x69\x6d\xd1\x8f\x6a\x79\x5d\x8f\x6b\x7e\x2e\x8f\x6c\x7c\x9b\x8f\x6d\x58\x7e\x8f\x6e\x71\x9f\x8f\x6f\x51\xfa\x8f\x70\x88\x53\x8f\x71\x8f\xf0\x8f\x72\x4f\xca\x8f\x73\x5c\xfb\x8f\x74\x66\x25\x8f\x75\x77\xac\x8f\x76\x7a\xe3\x8f\x77\x82\x1c\x8f\x78\x99\xff\x8f\x79\x51\xc6\x8f\x7a\x5f\xaa\x8f\x7b\x65\xec\x8f\x7c\x69\x6f\x8f\x7d\x6b\x89\x8f\x7e\x6d\xf3\x8f\x80\x6e\x96\x8f\x81\x6f\x64\x8f\x82\x76\xfe\x8f\x83\x7d\x14\x8f\x84\x5d\xe1\x8f\x85\x90\x75\x8f\x86\x91\x87\x8f\x87\x98\x06\x8f\x88\x51\xe6\x8f\x89\x52\x1d\x8f\x8a\x62\x40\x8f\x8b\x66\x91\x8f\x8c\x66\xd9\x8f\x8d\x6e\x1a\x8f\x8e\x5e\xb6\x8f\x8f\x7d\xd2\x8f\x90\x7f\x72\x8f\x91\x66\xf8\x8f\x92\x85\xaf\x8f\x93\x85\xf7\x8f\x94\x8a\xf8\x8f\x95\x52\xa9\x8f\x96\x53\xd9\x8f\x97\x59\x73\x8f\x98\x5e\x8f\x8f\x99\x5f\x90\x8f\x9a\x60\x55\x8f\x9b\x92\xe4\x8f\x9c\x96\x64\x8f\x9d\x50\xb7\x8f\x9e\x51\x1f\x8f\x9f\x52\xdd\x8f\xa0\x53\x20\x8f\xa1\x53\x47\x8f\xa2\x53\xec\x8f\xa3\x54\xe8\x8f\xa4\x55\x46\x8f\xa5\x55\x31\x8f\xa6\x56\x17\x8f\xa7\x59\x68\x8f\xa8\x59\xbe\x8f\xa9\x5a\x3c\x8f\xaa\x5b\xb5\x8f\xab\x5c\x06\x8f\xac\x5c\x0f\x8f\xad\x5c\x11\x8f\xae\x5c\x1a\x8f\xaf\x5e\x84\x8f\xb0\x5e\x8a\x8f\xb1\x5e\xe0\x8f\xb2\x5f\x70\x8f\xb3\x62\x7f\x8f\xb4\x62\x84\x8f\xb5\x62\xdb\x8f\xb6\x63\x8c\x8f\xb7\x63\x77\x8f\xb8\x66\x07\x8f\xb9\x66\x0c\x8f\xba\x66\x2d\x8f\xbb\x66\x76\x8f\xbc\x67\x7e\x8f\xbd\x68\xa2\x8f\xbe\x6a\x1f\x8f\xbf\x6a\x35\x8f\xc0\x6c\xbc\x8f\xc1\x6d\x88\x8f\xc2\x6e\x09\x8f\xc3\x6e\x58\x8f\xc4\x71\x3c\x8f\xc5\x71\x26\x8f\xc6\x71\x67\x8f\xc7\x75\xc7\x8f\xc8\x77\x01\x8f\xc9\x78\x5d\x8f\xca\x79\x01\x8f\xcb\x79\x65\x8f\xcc\x79\xf0\x8f\xcd\x7a\xe0\x8f\xce\x7b\x11\x8f\xcf\x7c\xa7\x8f\xd0\x7d\x39\x8f\xd1\x80\x96\x8f\xd2\x83\xd6\x8f\xd3\x84\x8b\x8f\xd4\x85\x49\x8f\xd5\x88\x5d\x8f\xd6\x88\xf3\x8f\xd7\x8a\x1f\x8f\xd8\x8a\x3c\x8f\xd9\x8a\x54\x8f\xda\x8a\x73\x8f\xdb\x8c\x61\x8f\xdc\x8c\xde\x8f\xdd\x91\xa4\x8f\xde\x92\x66\x8f\xdf\x93\x7e\x8f\xe0\x94\x18\x8f\xe1\x96\x9c\x8f\xe2\x97\x98\x8f\xe3\x4e\x0a\x8f\xe4\x4e\x08\x8f\xe5\x4e\x1e\x8f\xe6\x4e\x57\x8f\xe7\x51\x97\x8f\xe8\x52\x70\x8f\xe9\x57\xce\x8f\xea\x58\x34\x8f\xeb\x58\xcc\x8f\xec\x5b\x22\x8f\xed\x5e\x38\x8f\xee\x60\xc5\x8f\xef\x64\xfe\x8f\xf0\x67\x61\x8f\xf1\x67\x56\x8f\xf2\x6d\x44\x8f\xf3\x72\xb6\x8f\xf4\x75\x73\x8f\xf5\x7a\x63\x8f\xf6\x84\xb8\x8f\xf7\x8b\x72\x8f\xf8\x91\xb8\x8f\xf9\x93\x20\x8f\xfa\x56\x31\x8f\xfb\x57\xf4\x8f\xfc\x98\xfe\x90\x40\x62\xed\x90\x41\x69\x0d\x90\x42\x6b\x96\x90\x43\x71\xed\x90\x44\x7e\x54\x90\x45\x80\x77\x90\x46\x82\x72\x90\x47\x89\xe6\x90\x48\x98\xdf\x90\x49\x87\x55\x90\x4a\x8f\xb1\x90\x4b\x5c\x3b\x90\x4c\x4f\x38\x90\x4d\x4f\xe1\x90\x4e\x4f\xb5\x90\x4f\x55\x07\x90\x50\x5a\x20\x90\x51\x5b\xdd\x90\x52\x5b\xe9\x90\x53\x5f\xc3\x90\x54\x61\x4e\x90\x55\x63\x2f\x90\x56\x65\xb0\x90\x57\x66\x4b\x90\x58\x68\xee\x90\x59\x69\x9b\x90\x5a\x6d\x78\x90\x5b\x6d\xf1\x90\x5c\x75\x33\x90\x5d\x75\xb9\x90\x5e\x77\x1f\x90\x5f\x79\x5e\x90\x60\x79\xe6\x90\x61\x7d\x33\x90\x62\x81\xe3\x90\x63\x82\xaf\x90\x64\x85\xaa\x90\x65\x89\xaa\x90\x66\x8a\x3a\x90\x67\x8e\xab\x90\x68\x8f\x9b\x90\x69\x90\x32\x90\x6a\x91\xdd\x90\x6b\x97\x07\x90\x6c\x4e\xba\x90\x6d\x4e\xc1\x90\x6e\x52\x03\x90\x6f\x58\x75\x90\x70\x58\xec\x90\x71\x5c\x0b\x90\x72\x75\x1a\x90\x73\x5c\x3d\x90\x74\x81\x4e\x90\x75\x8a\x0a\x90\x76\x8f\xc5\x90\x77\x96\x63\x90\x78\x97\x6d\x90\x79\x7b\x25\x90\x7a\x8a\xcf\x90\x7b\x98\x08\x90\x7c\x91\x62\x90\x7d\x56\xf3\x90\x7e\x53\xa8\x90\x80\x90\x17\x90\x81\x54\x39\x90\x82\x57\x82\x90\x83\x5e\x25\x90\x84\x63\xa8\x90\x85\x6c\x34\x90\x86\x70\x8a\x90\x87\x77\x61\x90\x88\x7c\x8b\x90\x89\x7f\xe0\x90\x8a\x88\x70\x90\x8b\x90\x42\x90\x8c\x91\x54\x90\x8d\x93\x10\x90\x8e\x93\x18\x90\x8f\x96\x8f\x90\x90\x74\x5e\x90\x91\x9a\xc4\x90\x92\x5d\x07\x90\x93\x5d\x69\x90\x94\x65\x70\x90\x95\x67\xa2\x90\x96\x8d\xa8\x90\x97\x96\xdb\x90\x98\x63\x6e\x90\x99\x67\x49\x90\x9a\x69\x19\x90\x9b\x83\xc5\x90\x9c\x98\x17\x90\x9d\x96\xc0\x90\x9e\x88\xfe\x90\x9f\x6f\x84\x90\xa0\x64\x7a\x90\xa1\x5b\xf8\x90\xa2\x4e\x16\x90\xa3\x70\x2c\x90\xa4\x75\x5d\x90\xa5\x66\x2f\x90\xa6\x51\xc4\x90\xa7\x52\x36\x90\xa8\x52\xe2\x90\xa9\x59\xd3\x90\xaa\x5f\x81\x90\xab\x60\x27\x90\xac\x62\x10\x90\xad\x65\x3f\x90\xae\x65\x74\x90\xaf\x66\x1f\x90\xb0\x66\x74\x90\xb1\x68\xf2\x90\xb2\x68\x16\x90\xb3\x6b\x63\x90\xb4\x6e\x05\x90\xb5\x72\x72\x90\xb6\x75\x1f\x90\xb7\x76\xdb\x90\xb8\x7c\xbe\x90\xb9\x80\x56\x90\xba\x58\xf0\x90\xbb\x88\xfd\x90\xbc\x89\x7f\x90\xbd\x8a\xa0\x90\xbe\x8a\x93\x90\xbf\x8a\xcb\x90\xc0\x90\x1d\x90\xc1\x91\x92\x90\xc2\x97\x52\x90\xc3\x97\x59\x90\xc4\x65\x89\x90\xc5\x7a\x0e\x90\xc6\x81\x06\x90\xc7\x96\xbb\x90\xc8\x5e\x2d\x90\xc9\x60\xdc\x90\xca\x62\x1a\x90\xcb\x65\xa5\x90\xcc\x66\x14\x90\xcd\x67\x90\x90\xce\x77\xf3\x90\xcf\x7a\x4d\x90\xd0\x7c\x4d\x90\xd1\x7e\x3e\x90\xd2\x81\x0a\x90\xd3\x8c\xac\x90\xd4\x8d\x64\x90\xd5\x8d\xe1\x90\xd6\x8e\x5f\x90\xd7\x78\xa9\x90\xd8\x52\x07\x90\xd9\x62\xd9\x90\xda\x63\xa5\x90\xdb\x64\x42\x90\xdc\x62\x98\x90\xdd\x8a\x2d\x90\xde\x7a\x83\x90\xdf\x7b\xc0\x90\xe0\x8a\xac\x90\xe1\x96\xea\x90\xe2\x7d\x76\x90\xe3\x82\x0c\x90\xe4\x87\x49\x90\xe5\x4e\xd9\x90\xe6\x51\x48\x90\xe7\x53\x43\x90\xe8\x53\x60\x90\xe9\x5b\xa3\x90\xea\x5c\x02\x90\xeb\x5c\x16\x90\xec\x5d\xdd\x90\xed\x62\x26\x90\xee\x62\x47\x90\xef\x64\xb0\x90\xf0\x68\x13\x90\xf1\x68\x34\x90\xf2\x6c\xc9\x90\xf3\x6d\x45\x90\xf4\x6d\x17\x90\xf5\x67\xd3\x90\xf6\x6f\x5c\x90\xf7\x71\x4e\x90\xf8\x71\x7d\x90\xf9\x65\xcb\x90\xfa\x7a\x7f\x90\xfb\x7b\xad\x90\xfc\x7d\xda\x91\x40\x7e\x4a\x91\x41\x7f\xa8\x91\x42\x81\x7a\x91\x43\x82\x1b\x91\x44\x82\x39\x91\x45\x85\xa6\x91\x46\x8a\x6e\x91\x47\x8c\xce\x91\x48\x8d\xf5\x91\x49\x90\x78\x91\x4a\x90\x77\x91\x4b\x92\xad\x91\x4c\x92\x91\x91\x4d\x95\x83\x91\x4e\x9b\xae\x91\x4f\x52\x4d\x91\x50\x55\x84\x91\x51\x6f\x38\x91\x52\x71\x36\x91\x53\x51\x68\x91\x54\x79\x85\x91\x55\x7e\x55\x91\x56\x81\xb3\x91\x57\x7c\xce\x91\x58\x56\x4c\x91\x59\x58\x51\x91\x5a\x5c\xa8\x91\x5b\x63\xaa\x91\x5c\x66\xfe\x91\x5d\x66\xfd\x91\x5e\x69\x5a\x91\x5f\x72\xd9\x91\x60\x75\x8f\x91\x61\x75\x8e\x91\x62\x79\x0e\x91\x63\x79\x56\x91\x64\x79\xdf\x91\x65\x7c\x97\x91\x66\x7d\x20\x91\x67\x7d\x44\x91\x68\x86\x07\x91\x69\x8a\x34\x91\x6a\x96\x3b\x91\x6b\x90\x61\x91\x6c\x9f\x20\x91\x6d\x50\xe7\x91\x6e\x52\x75\x91\x6f\x53\xcc\x91\x70\x53\xe2\x91\x71\x50\x09\x91\x72\x55\xaa\x91\x73\x58\xee\x91\x74\x59\x4f\x91\x75\x72\x3d\x91\x76\x5b\x8b\x91\x77\x5c\x64\x91\x78\x53\x1d\x91\x79\x60\xe3\x91\x7a\x60\xf3\x91\x7b\x63\x5c\x91\x7c\x63\x83\x91\x7d\x63\x3f\x91\x7e\x63\xbb\x91\x80\x64\xcd\x91\x81\x65\xe9\x91\x82\x66\xf9\x91\x83\x5d\xe3\x91\x84\x69\xcd\x91\x85\x69\xfd\x91\x86\x6f\x15\x91\x87\x71\xe5\x91\x88\x4e\x89\x91\x89\x75\xe9\x91\x8a\x76\xf8\x91\x8b\x7a\x93\x91\x8c\x7c\xdf\x91\x8d\x7d\xcf\x91\x8e\x7d\x9c\x91\x8f\x80\x61\x91\x90\x83\x49\x91\x91\x83\x58\x91\x92\x84\x6c\x91\x93\x84\xbc\x91\x94\x85\xfb\x91\x95\x88\xc5\x91\x96\x8d\x70\x91\x97\x90\x01\x91\x98\x90\x6d\x91\x99\x93\x97\x91\x9a\x97\x1c\x91\x9b\x9a\x12\x91\x9c\x50\xcf\x91\x9d\x58\x97\x91\x9e\x61\x8e\x91\x9f\x81\xd3\x91\xa0\x85\x35\x91\xa1\x8d\x08\x91\xa2\x90\x20\x91\xa3\x4f\xc3\x91\xa4\x50\x74\x91\xa5\x52\x47\x91\xa6\x53\x73\x91\xa7\x60\x6f\x91\xa8\x63\x49\x91\xa9\x67\x5f\x91\xaa\x6e\x2c\x91\xab\x8d\xb3\x91\xac\x90\x1f\x91\xad\x4f\xd7\x91\xae\x5c\x5e\x91\xaf\x8c\xca\x91\xb0\x65\xcf\x91\xb1\x7d\x9a\x91\xb2\x53\x52\x91\xb3\x88\x96\x91\xb4\x51\x76\x91\xb5\x63\xc3\x91\xb6\x5b\x58\x91\xb7\x5b\x6b\x91\xb8\x5c\x0a\x91\xb9\x64\x0d\x91\xba\x67\x51\x91\xbb\x90\x5c\x91\xbc\x4e\xd6\x91\xbd\x59\x1a\x91\xbe\x59\x2a\x91\xbf\x6c\x70\x91\xc0\x8a\x51\x91\xc1\x55\x3e\x91\xc2\x58\x15\x91\xc3\x59\xa5\x91\xc4\x60\xf0\x91\xc5\x62\x53\x91\xc6\x67\xc1\x91\xc7\x82\x35\x91\xc8\x69\x55\x91\xc9\x96\x40\x91\xca\x99\xc4\x91\xcb\x9a\x28\x91\xcc\x4f\x53\x91\xcd\x58\x06\x91\xce\x5b\xfe\x91\xcf\x80\x10\x91\xd0\x5c\xb1\x91\xd1\x5e\x2f\x91\xd2\x5f\x85\x91\xd3\x60\x20\x91\xd4\x61\x4b\x91\xd5\x62\x34\x91\xd6\x66\xff\x91\xd7\x6c\xf0\x91\xd8\x6e\xde\x91\xd9\x80\xce\x91\xda\x81\x7f\x91\xdb\x82\xd4\x91\xdc\x88\x8b\x91\xdd\x8c\xb8\x91\xde\x90\x00\x91\xdf\x90\x2e\x91\xe0\x96\x8a\x91\xe1\x9e\xdb\x91\xe2\x9b\xdb\x91\xe3\x4e\xe3\x91\xe4\x53\xf0\x91\xe5\x59\x27\x91\xe6\x7b\x2c\x91\xe7\x91\x8d\x91\xe8\x98\x4c\x91\xe9\x9d\xf9\x91\xea\x6e\xdd\x91\xeb\x70\x27\x91\xec\x53\x53\x91\xed\x55\x44\x91\xee\x5b\x85\x91\xef\x62\x58\x91\xf0\x62\x9e\x91\xf1\x62\xd3\x91\xf2\x6c\xa2\x91\xf3\x6f\xef\x91\xf4\x74\x22\x91\xf5\x8a\x17\x91\xf6\x94\x38\x91\xf7\x6f\xc1\x91\xf8\x8a\xfe\x91\xf9\x83\x38\x91\xfa\x51\xe7\x91\xfb\x86\xf8\x91\xfc\x53\xea\x92\x40\x53\xe9\x92\x41\x4f\x46\x92\x42\x90\x54\x92\x43\x8f\xb0\x92\x44\x59\x6a\x92\x45\x81\x31\x92\x46\x5d\xfd\x92\x47\x7a\xea\x92\x48\x8f\xbf\x92\x49\x68\xda\x92\x4a\x8c\x37\x92\x4b\x72\xf8\x92\x4c\x9c\x48\x92\x4d\x6a\x3d\x92\x4e\x8a\xb0\x92\x4f\x4e\x39\x92\x50\x53\x58\x92\x51\x56\x06\x92\x52\x57\x66\x92\x53\x62\xc5\x92\x54\x63\xa2\x92\x55\x65\xe6\x92\x56\x6b\x4e\x92\x57\x6d\xe1\x92\x58\x6e\x5b\x92\x59\x70\xad\x92\x5a\x77\xed\x92\x5b\x7a\xef\x92\x5c\x7b\xaa\x92\x5d\x7d\xbb\x92\x5e\x80\x3d\x92\x5f\x80\xc6\x92\x60\x86\xcb\x92\x61\x8a\x95\x92\x62\x93\x5b\x92\x63\x56\xe3\x92\x64\x58\xc7\x92\x65\x5f\x3e\x92\x66\x65\xad\x92\x67\x66\x96\x92\x68\x6a\x80\x92\x69\x6b\xb5\x92\x6a\x75\x37\x92\x6b\x8a\xc7\x92\x6c\x50\x24\x92\x6d\x77\xe5\x92\x6e\x57\x30\x92\x6f\x5f\x1b\x92\x70\x60\x65\x92\x71\x66\x7a\x92\x72\x6c\x60\x92\x73\x75\xf4\x92\x74\x7a\x1a\x92\x75\x7f\x6e\x92\x76\x81\xf4\x92\x77\x87\x18\x92\x78\x90\x45\x92\x79\x99\xb3\x92\x7a\x7b\xc9\x92\x7b\x75\x5c\x92\x7c\x7a\xf9\x92\x7d\x7b\x51\x92\x7e\x84\xc4\x92\x80\x90\x10\x92\x81\x79\xe9\x92\x82\x7a\x92\x92\x83\x83\x36\x92\x84\x5a\xe1\x92\x85\x77\x40\x92\x86\x4e\x2d\x92\x87\x4e\xf2\x92\x88\x5b\x99\x92\x89\x5f\xe0\x92\x8a\x62\xbd\x92\x8b\x66\x3c\x92\x8c\x67\xf1\x92\x8d\x6c\xe8\x92\x8e\x86\x6b\x92\x8f\x88\x77\x92\x90\x8a\x3b\x92\x91\x91\x4e\x92\x92\x92\xf3\x92\x93\x99\xd0\x92\x94\x6a\x17\x92\x95\x70\x26\x92\x96\x73\x2a\x92\x97\x82\xe7\x92\x98\x84\x57\x92\x99\x8c\xaf\x92\x9a\x4e\x01\x92\x9b\x51\x46\x92\x9c\x51\xcb\x92\x9d\x55\x8b\x92\x9e\x5b\xf5\x92\x9f\x5e\x16\x92\xa0\x5e\x33\x92\xa1\x5e\x81\x92\xa2\x5f\x14\x92\xa3\x5f\x35\x92\xa4\x5f\x6b\x92\xa5\x5f\xb4\x92\xa6\x61\xf2\x92\xa7\x63\x11\x92\xa8\x66\xa2\x92\xa9\x67\x1d\x92\xaa\x6f\x6e\x92\xab\x72\x52\x92\xac\x75\x3a\x92\xad\x77\x3a\x92\xae\x80\x74\x92\xaf\x81\x39\x92\xb0\x81\x78\x92\xb1\x87\x76\x92\xb2\x8a\xbf\x92\xb3\x8a\xdc\x92\xb4\x8d\x85\x92\xb5\x8d\xf3\x92\xb6\x92\x9a\x92\xb7\x95\x77\x92\xb8\x98\x02\x92\xb9\x9c\xe5\x92\xba\x52\xc5\x92\xbb\x63\x57\x92\xbc\x76\xf4\x92\xbd\x67\x15\x92\xbe\x6c\x88\x92\xbf\x73\xcd\x92\xc0\x8c\xc3\x92\xc1\x93\xae\x92\xc2\x96\x73\x92\xc3\x6d\x25\x92\xc4\x58\x9c\x92\xc5\x69\x0e\x92\xc6\x69\xcc\x92\xc7\x8f\xfd\x92\xc8\x93\x9a\x92\xc9\x75\xdb\x92\xca\x90\x1a\x92\xcb\x58\x5a\x92\xcc\x68\x02\x92\xcd\x63\xb4\x92\xce\x69\xfb\x92\xcf\x4f\x43\x92\xd0\x6f\x2c\x92\xd1\x67\xd8\x92\xd2\x8f\xbb\x92\xd3\x85\x26\x92\xd4\x7d\xb4\x92\xd5\x93\x54\x92\xd6\x69\x3f\x92\xd7\x6f\x70\x92\xd8\x57\x6a\x92\xd9\x58\xf7\x92\xda\x5b\x2c\x92\xdb\x7d\x2c\x92\xdc\x72\x2a\x92\xdd\x54\x0a\x92\xde\x91\xe3\x92\xdf\x9d\xb4\x92\xe0\x4e\xad\x92\xe1\x4f\x4e\x92\xe2\x50\x5c\x92\xe3\x50\x75\x92\xe4\x52\x43\x92\xe5\x8c\x9e\x92\xe6\x54\x48\x92\xe7\x58\x24\x92\xe8\x5b\x9a\x92\xe9\x5e\x1d\x92\xea\x5e\x95\x92\xeb\x5e\xad\x92\xec\x5e\xf7\x92\xed\x5f\x1f\x92\xee\x60\x8c\x92\xef\x62\xb5\x92\xf0\x63\x3a\x92\xf1\x63\xd0\x92\xf2\x68\xaf\x92\xf3\x6c\x40\x92\xf4\x78\x87\x92\xf5\x79\x8e\x92\xf6\x7a\x0b\x92\xf7\x7d\xe0\x92\xf8\x82\x47\x92\xf9\x8a\x02\x92\xfa\x8a\xe6\x92\xfb\x8e\x44\x92\xfc\x90\x13\x93\x40\x90\xb8\x93\x41\x91\x2d\x93\x42\x91\xd8\x93\x43\x9f\x0e\x93\x44\x6c\xe5\x93\x45\x64\x58\x93\x46\x64\xe2\x93\x47\x65\x75\x93\x48\x6e\xf4\x93\x49\x76\x84\x93\x4a\x7b\x1b\x93\x4b\x90\x69\x93\x4c\x93\xd1\x93\x4d\x6e\xba\x93\x4e\x54\xf2\x93\x4f\x5f\xb9\x93\x50\x64\xa4\x93\x51\x8f\x4d\x93\x52\x8f\xed\x93\x53\x92\x44\x93\x54\x51\x78\x93\x55\x58\x6b\x93\x56\x59\x29\x93\x57\x5c\x55\x93\x58\x5e\x97\x93\x59\x6d\xfb\x93\x5a\x7e\x8f\x93\x5b\x75\x1c\x93\x5c\x8c\xbc\x93\x5d\x8e\xe2\x93\x5e\x98\x5b\x93\x5f\x70\xb9\x93\x60\x4f\x1d\x93\x61\x6b\xbf\x93\x62\x6f\xb1\x93\x63\x75\x30\x93\x64\x96\xfb\x93\x65\x51\x4e\x93\x66\x54\x10\x93\x67\x58\x35\x93\x68\x58\x57\x93\x69\x59\xac\x93\x6a\x5c\x60\x93\x6b\x5f\x92\x93\x6c\x65\x97\x93\x6d\x67\x5c\x93\x6e\x6e\x21\x93\x6f\x76\x7b\x93\x70\x83\xdf\x93\x71\x8c\xed\x93\x72\x90\x14\x93\x73\x90\xfd\x93\x74\x93\x4d\x93\x75\x78\x25\x93\x76\x78\x3a\x93\x77\x52\xaa\x93\x78\x5e\xa6\x93\x79\x57\x1f\x93\x7a\x59\x74\x93\x7b\x60\x12\x93\x7c\x50\x12\x93\x7d\x51\x5a\x93\x7e\x51\xac\x93\x80\x51\xcd\x93\x81\x52\x00\x93\x82\x55\x10\x93\x83\x58\x54\x93\x84\x58\x58\x93\x85\x59\x57\x93\x86\x5b\x95\x93\x87\x5c\xf6\x93\x88\x5d\x8b\x93\x89\x60\xbc\x93\x8a\x62\x95\x93\x8b\x64\x2d\x93\x8c\x67\x71\x93\x8d\x68\x43\x93\x8e\x68\xbc\x93\x8f\x68\xdf\x93\x90\x76\xd7\x93\x91\x6d\xd8\x93\x92\x6e\x6f\x93\x93\x6d\x9b\x93\x94\x70\x6f\x93\x95\x71\xc8\x93\x96\x5f\x53\x93\x97\x75\xd8\x93\x98\x79\x77\x93\x99\x7b\x49\x93\x9a\x7b\x54\x93\x9b\x7b\x52\x93\x9c\x7c\xd6\x93\x9d\x7d\x71\x93\x9e\x52\x30\x93\x9f\x84\x63\x93\xa0\x85\x69\x93\xa1\x85\xe4\x93\xa2\x8a\x0e\x93\xa3\x8b\x04\x93\xa4\x8c\x46\x93\xa5\x8e\x0f\x93\xa6\x90\x03\x93\xa7\x90\x0f\x93\xa8\x94\x19\x93\xa9\x96\x76\x93\xaa\x98\x2d\x93\xab\x9a\x30\x93\xac\x95\xd8\x93\xad\x50\xcd\x93\xae\x52\xd5\x93\xaf\x54\x0c\x93\xb0\x58\x02\x93\xb1\x5c\x0e\x93\xb2\x61\xa7\x93\xb3\x64\x9e\x93\xb4\x6d\x1e\x93\xb5\x77\xb3\x93\xb6\x7a\xe5\x93\xb7\x80\xf4\x93\xb8\x84\x04\x93\xb9\x90\x53\x93\xba\x92\x85\x93\xbb\x5c\xe0\x93\xbc\x9d\x07\x93\xbd\x53\x3f\x93\xbe\x5f\x97\x93\xbf\x5f\xb3\x93\xc0\x6d\x9c\x93\xc1\x72\x79\x93\xc2\x77\x63\x93\xc3\x79\xbf\x93\xc4\x7b\xe4\x93\xc5\x6b\xd2\x93\xc6\x72\xec\x93\xc7\x8a\xad\x93\xc8\x68\x03\x93\xc9\x6a\x61\x93\xca\x51\xf8\x93\xcb\x7a\x81\x93\xcc\x69\x34\x93\xcd\x5c\x4a\x93\xce\x9c\xf6\x93\xcf\x82\xeb\x93\xd0\x5b\xc5\x93\xd1\x91\x49\x93\xd2\x70\x1e\x93\xd3\x56\x78\x93\xd4\x5c\x6f\x93\xd5\x60\xc7\x93\xd6\x65\x66\x93\xd7\x6c\x8c\x93\xd8\x8c\x5a\x93\xd9\x90\x41\x93\xda\x98\x13\x93\xdb\x54\x51\x93\xdc\x66\xc7\x93\xdd\x92\x0d\x93\xde\x59\x48\x93\xdf\x90\xa3\x93\xe0\x51\x85\x93\xe1\x4e\x4d\x93\xe2\x51\xea\x93\xe3\x85\x99\x93\xe4\x8b\x0e\x93\xe5\x70\x58\x93\xe6\x63\x7a\x93\xe7\x93\x4b\x93\xe8\x69\x62\x93\xe9\x99\xb4\x93\xea\x7e\x04\x93\xeb\x75\x77\x93\xec\x53\x57\x93\xed\x69\x60\x93\xee\x8e\xdf\x93\xef\x96\xe3\x93\xf0\x6c\x5d\x93\xf1\x4e\x8c\x93\xf2\x5c\x3c\x93\xf3\x5f\x10\x93\xf4\x8f\xe9\x93\xf5\x53\x02\x93\xf6\x8c\xd1\x93\xf7\x80\x89\x93\xf8\x86\x79\x93\xf9\x5e\xff\x93\xfa\x65\xe5\x93\xfb\x4e\x73\x93\xfc\x51\x65\x94\x40\x59\x82\x94\x41\x5c\x3f\x94\x42\x97\xee\x94\x43\x4e\xfb\x94\x44\x59\x8a\x94\x45\x5f\xcd\x94\x46\x8a\x8d\x94\x47\x6f\xe1\x94\x48\x79\xb0\x94\x49\x79\x62\x94\x4a\x5b\xe7\x94\x4b\x84\x71\x94\x4c\x73\x2b\x94\x4d\x71\xb1\x94\x4e\x5e\x74\x94\x4f\x5f\xf5\x94\x50\x63\x7b\x94\x51\x64\x9a\x94\x52\x71\xc3\x94\x53\x7c\x98\x94\x54\x4e\x43\x94\x55\x5e\xfc\x94\x56\x4e\x4b\x94\x57\x57\xdc\x94\x58\x56\xa2\x94\x59\x60\xa9\x94\x5a\x6f\xc3\x94\x5b\x7d\x0d\x94\x5c\x80\xfd\x94\x5d\x81\x33\x94\x5e\x81\xbf\x94\x5f\x8f\xb2\x94\x60\x89\x97\x94\x61\x86\xa4\x94\x62\x5d\xf4\x94\x63\x62\x8a\x94\x64\x64\xad\x94\x65\x89\x87\x94\x66\x67\x77\x94\x67\x6c\xe2\x94\x68\x6d\x3e\x94\x69\x74\x36\x94\x6a\x78\x34\x94\x6b\x5a\x46\x94\x6c\x7f\x75\x94\x6d\x82\xad\x94\x6e\x99\xac\x94\x6f\x4f\xf3\x94\x70\x5e\xc3\x94\x71\x62\xdd\x94\x72\x63\x92\x94\x73\x65\x57\x94\x74\x67\x6f\x94\x75\x76\xc3\x94\x76\x72\x4c\x94\x77\x80\xcc\x94\x78\x80\xba\x94\x79\x8f\x29\x94\x7a\x91\x4d\x94\x7b\x50\x0d\x94\x7c\x57\xf9\x94\x7d\x5a\x92\x94\x7e\x68\x85\x94\x80\x69\x73\x94\x81\x71\x64\x94\x82\x72\xfd\x94\x83\x8c\xb7\x94\x84\x58\xf2\x94\x85\x8c\xe0\x94\x86\x96\x6a\x94\x87\x90\x19\x94\x88\x87\x7f\x94\x89\x79\xe4\x94\x8a\x77\xe7\x94\x8b\x84\x29\x94\x8c\x4f\x2f\x94\x8d\x52\x65\x94\x8e\x53\x5a\x94\x8f\x62\xcd\x94\x90\x67\xcf\x94\x91\x6c\xca\x94\x92\x76\x7d\x94\x93\x7b\x94\x94\x94\x7c\x95\x94\x95\x82\x36\x94\x96\x85\x84\x94\x97\x8f\xeb\x94\x98\x66\xdd\x94\x99\x6f\x20\x94\x9a\x72\x06\x94\x9b\x7e\x1b\x94\x9c\x83\xab\x94\x9d\x99\xc1\x94\x9e\x9e\xa6\x94\x9f\x51\xfd\x94\xa0\x7b\xb1\x94\xa1\x78\x72\x94\xa2\x7b\xb8\x94\xa3\x80\x87\x94\xa4\x7b\x48\x94\xa5\x6a\xe8\x94\xa6\x5e\x61\x94\xa7\x80\x8c\x94\xa8\x75\x51\x94\xa9\x75\x60\x94\xaa\x51\x6b\x94\xab\x92\x62\x94\xac\x6e\x8c\x94\xad\x76\x7a\x94\xae\x91\x97\x94\xaf\x9a\xea\x94\xb0\x4f\x10\x94\xb1\x7f\x70\x94\xb2\x62\x9c\x94\xb3\x7b\x4f\x94\xb4\x95\xa5\x94\xb5\x9c\xe9\x94\xb6\x56\x7a\x94\xb7\x58\x59\x94\xb8\x86\xe4\x94\xb9\x96\xbc\x94\xba\x4f\x34\x94\xbb\x52\x24\x94\xbc\x53\x4a\x94\xbd\x53\xcd\x94\xbe\x53\xdb\x94\xbf\x5e\x06\x94\xc0\x64\x2c\x94\xc1\x65\x91\x94\xc2\x67\x7f\x94\xc3\x6c\x3e\x94\xc4\x6c\x4e\x94\xc5\x72\x48\x94\xc6\x72\xaf\x94\xc7\x73\xed\x94\xc8\x75\x54\x94\xc9\x7e\x41\x94\xca\x82\x2c\x94\xcb\x85\xe9\x94\xcc\x8c\xa9\x94\xcd\x7b\xc4\x94\xce\x91\xc6\x94\xcf\x71\x69\x94\xd0\x98\x12\x94\xd1\x98\xef\x94\xd2\x63\x3d\x94\xd3\x66\x69\x94\xd4\x75\x6a\x94\xd5\x76\xe4\x94\xd6\x78\xd0\x94\xd7\x85\x43\x94\xd8\x86\xee\x94\xd9\x53\x2a\x94\xda\x53\x51\x94\xdb\x54\x26\x94\xdc\x59\x83\x94\xdd\x5e\x87\x94\xde\x5f\x7c\x94\xdf\x60\xb2\x94\xe0\x62\x49\x94\xe1\x62\x79\x94\xe2\x62\xab\x94\xe3\x65\x90\x94\xe4\x6b\xd4\x94\xe5\x6c\xcc\x94\xe6\x75\xb2\x94\xe7\x76\xae\x94\xe8\x78\x91\x94\xe9\x79\xd8\x94\xea\x7d\xcb\x94\xeb\x7f\x77\x94\xec\x80\xa5\x94\xed\x88\xab\x94\xee\x8a\xb9\x94\xef\x8c\xbb\x94\xf0\x90\x7f\x94\xf1\x97\x5e\x94\xf2\x98\xdb\x94\xf3\x6a\x0b\x94\xf4\x7c\x38\x94\xf5\x50\x99\x94\xf6\x5c\x3e\x94\xf7\x5f\xae\x94\xf8\x67\x87\x94\xf9\x6b\xd8\x94\xfa\x74\x35\x94\xfb\x77\x09\x94\xfc\x7f\x8e\x95\x40\x9f\x3b\x95\x41\x67\xca\x95\x42\x7a\x17\x95\x43\x53\x39\x95\x44\x75\x8b\x95\x45\x9a\xed\x95\x46\x5f\x66\x95\x47\x81\x9d\x95\x48\x83\xf1\x95\x49\x80\x98\x95\x4a\x5f\x3c\x95\x4b\x5f\xc5\x95\x4c\x75\x62\x95\x4d\x7b\x46\x95\x4e\x90\x3c\x95\x4f\x68\x67\x95\x50\x59\xeb\x95\x51\x5a\x9b\x95\x52\x7d\x10\x95\x53\x76\x7e\x95\x54\x8b\x2c\x95\x55\x4f\xf5\x95\x56\x5f\x6a\x95\x57\x6a\x19\x95\x58\x6c\x37\x95\x59\x6f\x02\x95\x5a\x74\xe2\x95\x5b\x79\x68\x95\x5c\x88\x68\x95\x5d\x8a\x55\x95\x5e\x8c\x79\x95\x5f\x5e\xdf\x95\x60\x63\xcf\x95\x61\x75\xc5\x95\x62\x79\xd2\x95\x63\x82\xd7\x95\x64\x93\x28\x95\x65\x92\xf2\x95\x66\x84\x9c\x95\x67\x86\xed\x95\x68\x9c\x2d\x95\x69\x54\xc1\x95\x6a\x5f\x6c\x95\x6b\x65\x8c\x95\x6c\x6d\x5c\x95\x6d\x70\x15\x95\x6e\x8c\xa7\x95\x6f\x8c\xd3\x95\x70\x98\x3b\x95\x71\x65\x4f\x95\x72\x74\xf6\x95\x73\x4e\x0d\x95\x74\x4e\xd8\x95\x75\x57\xe0\x95\x76\x59\x2b\x95\x77\x5a\x66\x95\x78\x5b\xcc\x95\x79\x51\xa8\x95\x7a\x5e\x03\x95\x7b\x5e\x9c\x95\x7c\x60\x16\x95\x7d\x62\x76\x95\x7e\x65\x77\x95\x80\x65\xa7\x95\x81\x66\x6e\x95\x82\x6d\x6e\x95\x83\x72\x36\x95\x84\x7b\x26\x95\x85\x81\x50\x95\x86\x81\x9a\x95\x87\x82\x99\x95\x88\x8b\x5c\x95\x89\x8c\xa0\x95\x8a\x8c\xe6\x95\x8b\x8d\x74\x95\x8c\x96\x1c\x95\x8d\x96\x44\x95\x8e\x4f\xae\x95\x8f\x64\xab\x95\x90\x6b\x66\x95\x91\x82\x1e\x95\x92\x84\x61\x95\x93\x85\x6a\x95\x94\x90\xe8\x95\x95\x5c\x01\x95\x96\x69\x53\x95\x97\x98\xa8\x95\x98\x84\x7a\x95\x99\x85\x57\x95\x9a\x4f\x0f\x95\x9b\x52\x6f\x95\x9c\x5f\xa9\x95\x9d\x5e\x45\x95\x9e\x67\x0d\x95\x9f\x79\x8f\x95\xa0\x81\x79\x95\xa1\x89\x07\x95\xa2\x89\x86\x95\xa3\x6d\xf5\x95\xa4\x5f\x17\x95\xa5\x62\x55\x95\xa6\x6c\xb8\x95\xa7\x4e\xcf\x95\xa8\x72\x69\x95\xa9\x9b\x92\x95\xaa\x52\x06\x95\xab\x54\x3b\x95\xac\x56\x74\x95\xad\x58\xb3\x95\xae\x61\xa4\x95\xaf\x62\x6e\x95\xb0\x71\x1a\x95\xb1\x59\x6e\x95\xb2\x7c\x89\x95\xb3\x7c\xde\x95\xb4\x7d\x1b\x95\xb5\x96\xf0\x95\xb6\x65\x87\x95\xb7\x80\x5e\x95\xb8\x4e\x19\x95\xb9\x4f\x75\x95\xba\x51\x75\x95\xbb\x58\x40\x95\xbc\x5e\x63\x95\xbd\x5e\x73\x95\xbe\x5f\x0a\x95\xbf\x67\xc4\x95\xc0\x4e\x26\x95\xc1\x85\x3d\x95\xc2\x95\x89\x95\xc3\x96\x5b\x95\xc4\x7c\x73\x95\xc5\x98\x01\x95\xc6\x50\xfb\x95\xc7\x58\xc1\x95\xc8\x76\x56\x95\xc9\x78\xa7\x95\xca\x52\x25\x95\xcb\x77\xa5\x95\xcc\x85\x11\x95\xcd\x7b\x86\x95\xce\x50\x4f\x95\xcf\x59\x09\x95\xd0\x72\x47\x95\xd1\x7b\xc7\x95\xd2\x7d\xe8\x95\xd3\x8f\xba\x95\xd4\x8f\xd4\x95\xd5\x90\x4d\x95\xd6\x4f\xbf\x95\xd7\x52\xc9\x95\xd8\x5a\x29\x95\xd9\x5f\x01\x95\xda\x97\xad\x95\xdb\x4f\xdd\x95\xdc\x82\x17\x95\xdd\x92\xea\x95\xde\x57\x03\x95\xdf\x63\x55\x95\xe0\x6b\x69\x95\xe1\x75\x2b\x95\xe2\x88\xdc\x95\xe3\x8f\x14\x95\xe4\x7a\x42\x95\xe5\x52\xdf\x95\xe6\x58\x93\x95\xe7\x61\x55\x95\xe8\x62\x0a\x95\xe9\x66\xae\x95\xea\x6b\xcd\x95\xeb\x7c\x3f\x95\xec\x83\xe9\x95\xed\x50\x23\x95\xee\x4f\xf8\x95\xef\x53\x05\x95\xf0\x54\x46\x95\xf1\x58\x31\x95\xf2\x59\x49\x95\xf3\x5b\x9d\x95\xf4\x5c\xf0\x95\xf5\x5c\xef\x95\xf6\x5d\x29\x95\xf7\x5e\x96\x95\xf8\x62\xb1\x95\xf9\x63\x67\x95\xfa\x65\x3e\x95\xfb\x65\xb9\x95\xfc\x67\x0b\x96\x40\x6c\xd5\x96\x41\x6c\xe1\x96\x42\x70\xf9\x96\x43\x78\x32\x96\x44\x7e\x2b\x96\x45\x80\xde\x96\x46\x82\xb3\x96\x47\x84\x0c\x96\x48\x84\xec\x96\x49\x87\x02\x96\x4a\x89\x12\x96\x4b\x8a\x2a\x96\x4c\x8c\x4a\x96\x4d\x90\xa6\x96\x4e\x92\xd2\x96\x4f\x98\xfd\x96\x50\x9c\xf3\x96\x51\x9d\x6c\x96\x52\x4e\x4f\x96\x53\x4e\xa1\x96\x54\x50\x8d\x96\x55\x52\x56\x96\x56\x57\x4a\x96\x57\x59\xa8\x96\x58\x5e\x3d\x96\x59\x5f\xd8\x96\x5a\x5f\xd9\x96\x5b\x62\x3f\x96\x5c\x66\xb4\x96\x5d\x67\x1b\x96\x5e\x67\xd0\x96\x5f\x68\xd2\x96\x60\x51\x92\x96\x61\x7d\x21\x96\x62\x80\xaa\x96\x63\x81\xa8\x96\x64\x8b\x00\x96\x65\x8c\x8c\x96\x66\x8c\xbf\x96\x67\x92\x7e\x96\x68\x96\x32\x96\x69\x54\x20\x96\x6a\x98\x2c\x96\x6b\x53\x17\x96\x6c\x50\xd5\x96\x6d\x53\x5c\x96\x6e\x58\xa8\x96\x6f\x64\xb2\x96\x70\x67\x34\x96\x71\x72\x67\x96\x72\x77\x66\x96\x73\x7a\x46\x96\x74\x91\xe6\x96\x75\x52\xc3\x96\x76\x6c\xa1\x96\x77\x6b\x86\x96\x78\x58\x00\x96\x79\x5e\x4c\x96\x7a\x59\x54\x96\x7b\x67\x2c\x96\x7c\x7f\xfb\x96\x7d\x51\xe1\x96\x7e\x76\xc6\x96\x80\x64\x69\x96\x81\x78\xe8\x96\x82\x9b\x54\x96\x83\x9e\xbb\x96\x84\x57\xcb\x96\x85\x59\xb9\x96\x86\x66\x27\x96\x87\x67\x9a\x96\x88\x6b\xce\x96\x89\x54\xe9\x96\x8a\x69\xd9\x96\x8b\x5e\x55\x96\x8c\x81\x9c\x96\x8d\x67\x95\x96\x8e\x9b\xaa\x96\x8f\x67\xfe\x96\x90\x9c\x52\x96\x91\x68\x5d\x96\x92\x4e\xa6\x96\x93\x4f\xe3\x96\x94\x53\xc8\x96\x95\x62\xb9\x96\x96\x67\x2b\x96\x97\x6c\xab\x96\x98\x8f\xc4\x96\x99\x4f\xad\x96\x9a\x7e\x6d\x96\x9b\x9e\xbf\x96\x9c\x4e\x07\x96\x9d\x61\x62\x96\x9e\x6e\x80\x96\x9f\x6f\x2b\x96\xa0\x85\x13\x96\xa1\x54\x73\x96\xa2\x67\x2a\x96\xa3\x9b\x45\x96\xa4\x5d\xf3\x96\xa5\x7b\x95\x96\xa6\x5c\xac\x96\xa7\x5b\xc6\x96\xa8\x87\x1c\x96\xa9\x6e\x4a\x96\xaa\x84\xd1\x96\xab\x7a\x14\x96\xac\x81\x08\x96\xad\x59\x99\x96\xae\x7c\x8d\x96\xaf\x6c\x11\x96\xb0\x77\x20\x96\xb1\x52\xd9\x96\xb2\x59\x22\x96\xb3\x71\x21\x96\xb4\x72\x5f\x96\xb5\x77\xdb\x96\xb6\x97\x27\x96\xb7\x9d\x61\x96\xb8\x69\x0b\x96\xb9\x5a\x7f\x96\xba\x5a\x18\x96\xbb\x51\xa5\x96\xbc\x54\x0d\x96\xbd\x54\x7d\x96\xbe\x66\x0e\x96\xbf\x76\xdf\x96\xc0\x8f\xf7\x96\xc1\x92\x98\x96\xc2\x9c\xf4\x96\xc3\x59\xea\x96\xc4\x72\x5d\x96\xc5\x6e\xc5\x96\xc6\x51\x4d\x96\xc7\x68\xc9\x96\xc8\x7d\xbf\x96\xc9\x7d\xec\x96\xca\x97\x62\x96\xcb\x9e\xba\x96\xcc\x64\x78\x96\xcd\x6a\x21\x96\xce\x83\x02\x96\xcf\x59\x84\x96\xd0\x5b\x5f\x96\xd1\x6b\xdb\x96\xd2\x73\x1b\x96\xd3\x76\xf2\x96\xd4\x7d\xb2\x96\xd5\x80\x17\x96\xd6\x84\x99\x96\xd7\x51\x32\x96\xd8\x67\x28\x96\xd9\x9e\xd9\x96\xda\x76\xee\x96\xdb\x67\x62\x96\xdc\x52\xff\x96\xdd\x99\x05\x96\xde\x5c\x24\x96\xdf\x62\x3b\x96\xe0\x7c\x7e\x96\xe1\x8c\xb0\x96\xe2\x55\x4f\x96\xe3\x60\xb6\x96\xe4\x7d\x0b\x96\xe5\x95\x80\x96\xe6\x53\x01\x96\xe7\x4e\x5f\x96\xe8\x51\xb6\x96\xe9\x59\x1c\x96\xea\x72\x3a\x96\xeb\x80\x36\x96\xec\x91\xce\x96\xed\x5f\x25\x96\xee\x77\xe2\x96\xef\x53\x84\x96\xf0\x5f\x79\x96\xf1\x7d\x04\x96\xf2\x85\xac\x96\xf3\x8a\x33\x96\xf4\x8e\x8d\x96\xf5\x97\x56\x96\xf6\x67\xf3\x96\xf7\x85\xae\x96\xf8\x94\x53\x96\xf9\x61\x09\x96\xfa\x61\x08\x96\xfb\x6c\xb9\x96\xfc\x76\x52\x97\x40\x8a\xed\x97\x41\x8f\x38\x97\x42\x55\x2f\x97\x43\x4f\x51\x97\x44\x51\x2a\x97\x45\x52\xc7\x97\x46\x53\xcb\x97\x47\x5b\xa5\x97\x48\x5e\x7d\x97\x49\x60\xa0\x97\x4a\x61\x82\x97\x4b\x63\xd6\x97\x4c\x67\x09\x97\x4d\x67\xda\x97\x4e\x6e\x67\x97\x4f\x6d\x8c\x97\x50\x73\x36\x97\x51\x73\x37\x97\x52\x75\x31\x97\x53\x79\x50\x97\x54\x88\xd5\x97\x55\x8a\x98\x97\x56\x90\x4a\x97\x57\x90\x91\x97\x58\x90\xf5\x97\x59\x96\xc4\x97\x5a\x87\x8d\x97\x5b\x59\x15\x97\x5c\x4e\x88\x97\x5d\x4f\x59\x97\x5e\x4e\x0e\x97\x5f\x8a\x89\x97\x60\x8f\x3f\x97\x61\x98\x10\x97\x62\x50\xad\x97\x63\x5e\x7c\x97\x64\x59\x96\x97\x65\x5b\xb9\x97\x66\x5e\xb8\x97\x67\x63\xda\x97\x68\x63\xfa\x97\x69\x64\xc1\x97\x6a\x66\xdc\x97\x6b\x69\x4a\x97\x6c\x69\xd8\x97\x6d\x6d\x0b\x97\x6e\x6e\xb6\x97\x6f\x71\x94\x97\x70\x75\x28\x97\x71\x7a\xaf\x97\x72\x7f\x8a\x97\x73\x80\x00\x97\x74\x84\x49\x97\x75\x84\xc9\x97\x76\x89\x81\x97\x77\x8b\x21\x97\x78\x8e\x0a\x97\x79\x90\x65\x97\x7a\x96\x7d\x97\x7b\x99\x0a\x97\x7c\x61\x7e\x97\x7d\x62\x91\x97\x7e\x6b\x32\x97\x80\x6c\x83\x97\x81\x6d\x74\x97\x82\x7f\xcc\x97\x83\x7f\xfc\x97\x84\x6d\xc0\x97\x85\x7f\x85\x97\x86\x87\xba\x97\x87\x88\xf8\x97\x88\x67\x65\x97\x89\x83\xb1\x97\x8a\x98\x3c\x97\x8b\x96\xf7\x97\x8c\x6d\x1b\x97\x8d\x7d\x61\x97\x8e\x84\x3d\x97\x8f\x91\x6a\x97\x90\x4e\x71\x97\x91\x53\x75\x97\x92\x5d\x50\x97\x93\x6b\x04\x97\x94\x6f\xeb\x97\x95\x85\xcd\x97\x96\x86\x2d\x97\x97\x89\xa7\x97\x98\x52\x29\x97\x99\x54\x0f\x97\x9a\x5c\x65\x97\x9b\x67\x4e\x97\x9c\x68\xa8\x97\x9d\x74\x06\x97\x9e\x74\x83\x97\x9f\x75\xe2\x97\xa0\x88\xcf\x97\xa1\x88\xe1\x97\xa2\x91\xcc\x97\xa3\x96\xe2\x97\xa4\x96\x78\x97\xa5\x5f\x8b\x97\xa6\x73\x87\x97\xa7\x7a\xcb\x97\xa8\x84\x4e\x97\xa9\x63\xa0\x97\xaa\x75\x65\x97\xab\x52\x89\x97\xac\x6d\x41\x97\xad\x6e\x9c\x97\xae\x74\x09\x97\xaf\x75\x59\x97\xb0\x78\x6b\x97\xb1\x7c\x92\x97\xb2\x96\x86\x97\xb3\x7a\xdc\x97\xb4\x9f\x8d\x97\xb5\x4f\xb6\x97\xb6\x61\x6e\x97\xb7\x65\xc5\x97\xb8\x86\x5c\x97\xb9\x4e\x86\x97\xba\x4e\xae\x97\xbb\x50\xda\x97\xbc\x4e\x21\x97\xbd\x51\xcc\x97\xbe\x5b\xee\x97\xbf\x65\x99\x97\xc0\x68\x81\x97\xc1\x6d\xbc\x97\xc2\x73\x1f\x97\xc3\x76\x42\x97\xc4\x77\xad\x97\xc5\x7a\x1c\x97\xc6\x7c\xe7\x97\xc7\x82\x6f\x97\xc8\x8a\xd2\x97\xc9\x90\x7c\x97\xca\x91\xcf\x97\xcb\x96\x75\x97\xcc\x98\x18\x97\xcd\x52\x9b\x97\xce\x7d\xd1\x97\xcf\x50\x2b\x97\xd0\x53\x98\x97\xd1\x67\x97\x97\xd2\x6d\xcb\x97\xd3\x71\xd0\x97\xd4\x74\x33\x97\xd5\x81\xe8\x97\xd6\x8f\x2a\x97\xd7\x96\xa3\x97\xd8\x9c\x57\x97\xd9\x9e\x9f\x97\xda\x74\x60\x97\xdb\x58\x41\x97\xdc\x6d\x99\x97\xdd\x7d\x2f\x97\xde\x98\x5e\x97\xdf\x4e\xe4\x97\xe0\x4f\x36\x97\xe1\x4f\x8b\x97\xe2\x51\xb7\x97\xe3\x52\xb1\x97\xe4\x5d\xba\x97\xe5\x60\x1c\x97\xe6\x73\xb2\x97\xe7\x79\x3c\x97\xe8\x82\xd3\x97\xe9\x92\x34\x97\xea\x96\xb7\x97\xeb\x96\xf6\x97\xec\x97\x0a\x97\xed\x9e\x97\x97\xee\x9f\x62\x97\xef\x66\xa6\x97\xf0\x6b\x74\x97\xf1\x52\x17\x97\xf2\x52\xa3\x97\xf3\x70\xc8\x97\xf4\x88\xc2\x97\xf5\x5e\xc9\x97\xf6\x60\x4b\x97\xf7\x61\x90\x97\xf8\x6f\x23\x97\xf9\x71\x49\x97\xfa\x7c\x3e\x97\xfb\x7d\xf4\x97\xfc\x80\x6f\x98\x40\x84\xee\x98\x41\x90\x23\x98\x42\x93\x2c\x98\x43\x54\x42\x98\x44\x9b\x6f\x98\x45\x6a\xd3\x98\x46\x70\x89\x98\x47\x8c\xc2\x98\x48\x8d\xef\x98\x49\x97\x32\x98\x4a\x52\xb4\x98\x4b\x5a\x41\x98\x4c\x5e\xca\x98\x4d\x5f\x04\x98\x4e\x67\x17\x98\x4f\x69\x7c\x98\x50\x69\x94\x98\x51\x6d\x6a\x98\x52\x6f\x0f\x98\x53\x72\x62\x98\x54\x72\xfc\x98\x55\x7b\xed\x98\x56\x80\x01\x98\x57\x80\x7e\x98\x58\x87\x4b\x98\x59\x90\xce\x98\x5a\x51\x6d\x98\x5b\x9e\x93\x98\x5c\x79\x84\x98\x5d\x80\x8b\x98\x5e\x93\x32\x98\x5f\x8a\xd6\x98\x60\x50\x2d\x98\x61\x54\x8c\x98\x62\x8a\x71\x98\x63\x6b\x6a\x98\x64\x8c\xc4\x98\x65\x81\x07\x98\x66\x60\xd1\x98\x67\x67\xa0\x98\x68\x9d\xf2\x98\x69\x4e\x99\x98\x6a\x4e\x98\x98\x6b\x9c\x10\x98\x6c\x8a\x6b\x98\x6d\x85\xc1\x98\x6e\x85\x68\x98\x6f\x69\x00\x98\x70\x6e\x7e\x98\x71\x78\x97\x98\x72\x81\x55\x98\x9f\x5f\x0c\x98\xa0\x4e\x10\x98\xa1\x4e\x15\x98\xa2\x4e\x2a\x98\xa3\x4e\x31\x98\xa4\x4e\x36\x98\xa5\x4e\x3c\x98\xa6\x4e\x3f\x98\xa7\x4e\x42\x98\xa8\x4e\x56\x98\xa9\x4e\x58\x98\xaa\x4e\x82\x98\xab\x4e\x85\x98\xac\x8c\x6b\x98\xad\x4e\x8a\x98\xae\x82\x12\x98\xaf\x5f\x0d\x98\xb0\x4e\x8e\x98\xb1\x4e\x9e\x98\xb2\x4e\x9f\x98\xb3\x4e\xa0\x98\xb4\x4e\xa2\x98\xb5\x4e\xb0\x98\xb6\x4e\xb3\x98\xb7\x4e\xb6\x98\xb8\x4e\xce\x98\xb9\x4e\xcd\x98\xba\x4e\xc4\x98\xbb\x4e\xc6\x98\xbc\x4e\xc2\x98\xbd\x4e\xd7\x98\xbe\x4e\xde\x98\xbf\x4e\xed\x98\xc0\x4e\xdf\x98\xc1\x4e\xf7\x98\xc2\x4f\x09\x98\xc3\x4f\x5a\x98\xc4\x4f\x30\x98\xc5\x4f\x5b\x98\xc6\x4f\x5d\x98\xc7\x4f\x57\x98\xc8\x4f\x47\x98\xc9\x4f\x76\x98\xca\x4f\x88\x98\xcb\x4f\x8f\x98\xcc\x4f\x98\x98\xcd\x4f\x7b\x98\xce\x4f\x69\x98\xcf\x4f\x70\x98\xd0\x4f\x91\x98\xd1\x4f\x6f\x98\xd2\x4f\x86\x98\xd3\x4f\x96\x98\xd4\x51\x18\x98\xd5\x4f\xd4\x98\xd6\x4f\xdf\x98\xd7\x4f\xce\x98\xd8\x4f\xd8\x98\xd9\x4f\xdb\x98\xda\x4f\xd1\x98\xdb\x4f\xda\x98\xdc\x4f\xd0\x98\xdd\x4f\xe4\x98\xde\x4f\xe5\x98\xdf\x50\x1a\x98\xe0\x50\x28\x98\xe1\x50\x14\x98\xe2\x50\x2a\x98\xe3\x50\x25\x98\xe4\x50\x05\x98\xe5\x4f\x1c\x98\xe6\x4f\xf6\x98\xe7\x50\x21\x98\xe8\x50\x29\x98\xe9\x50\x2c\x98\xea\x4f\xfe\x98\xeb\x4f\xef\x98\xec\x50\x11\x98\xed\x50\x06\x98\xee\x50\x43\x98\xef\x50\x47\x98\xf0\x67\x03\x98\xf1\x50\x55\x98\xf2\x50\x50\x98\xf3\x50\x48\x98\xf4\x50\x5a\x98\xf5\x50\x56\x98\xf6\x50\x6c\x98\xf7\x50\x78\x98\xf8\x50\x80\x98\xf9\x50\x9a\x98\xfa\x50\x85\x98\xfb\x50\xb4\x98\xfc\x50\xb2\x99\x40\x50\xc9\x99\x41\x50\xca\x99\x42\x50\xb3\x99\x43\x50\xc2\x99\x44\x50\xd6\x99\x45\x50\xde\x99\x46\x50\xe5\x99\x47\x50\xed\x99\x48\x50\xe3\x99\x49\x50\xee\x99\x4a\x50\xf9\x99\x4b\x50\xf5\x99\x4c\x51\x09\x99\x4d\x51\x01\x99\x4e\x51\x02\x99\x4f\x51\x16\x99\x50\x51\x15\x99\x51\x51\x14\x99\x52\x51\x1a\x99\x53\x51\x21\x99\x54\x51\x3a\x99\x55\x51\x37\x99\x56\x51\x3c\x99\x57\x51\x3b\x99\x58\x51\x3f\x99\x59\x51\x40\x99\x5a\x51\x52\x99\x5b\x51\x4c\x99\x5c\x51\x54\x99\x5d\x51\x62\x99\x5e\x7a\xf8\x99\x5f\x51\x69\x99\x60\x51\x6a\x99\x61\x51\x6e\x99\x62\x51\x80\x99\x63\x51\x82\x99\x64\x56\xd8\x99\x65\x51\x8c\x99\x66\x51\x89\x99\x67\x51\x8f\x99\x68\x51\x91\x99\x69\x51\x93\x99\x6a\x51\x95\x99\x6b\x51\x96\x99\x6c\x51\xa4\x99\x6d\x51\xa6\x99\x6e\x51\xa2\x99\x6f\x51\xa9\x99\x70\x51\xaa\x99\x71\x51\xab\x99\x72\x51\xb3\x99\x73\x51\xb1\x99\x74\x51\xb2\x99\x75\x51\xb0\x99\x76\x51\xb5\x99\x77\x51\xbd\x99\x78\x51\xc5\x99\x79\x51\xc9\x99\x7a\x51\xdb\x99\x7b\x51\xe0\x99\x7c\x86\x55\x99\x7d\x51\xe9\x99\x7e\x51\xed\x99\x80\x51\xf0\x99\x81\x51\xf5\x99\x82\x51\xfe\x99\x83\x52\x04\x99\x84\x52\x0b\x99\x85\x52\x14\x99\x86\x52\x0e\x99\x87\x52\x27\x99\x88\x52\x2a\x99\x89\x52\x2e\x99\x8a\x52\x33\x99\x8b\x52\x39\x99\x8c\x52\x4f\x99\x8d\x52\x44\x99\x8e\x52\x4b\x99\x8f\x52\x4c\x99\x90\x52\x5e\x99\x91\x52\x54\x99\x92\x52\x6a\x99\x93\x52\x74\x99\x94\x52\x69\x99\x95\x52\x73\x99\x96\x52\x7f\x99\x97\x52\x7d\x99\x98\x52\x8d\x99\x99\x52\x94\x99\x9a\x52\x92\x99\x9b\x52\x71\x99\x9c\x52\x88\x99\x9d\x52\x91\x99\x9e\x8f\xa8\x99\x9f\x8f\xa7\x99\xa0\x52\xac\x99\xa1\x52\xad\x99\xa2\x52\xbc\x99\xa3\x52\xb5\x99\xa4\x52\xc1\x99\xa5\x52\xcd\x99\xa6\x52\xd7\x99\xa7\x52\xde\x99\xa8\x52\xe3\x99\xa9\x52\xe6\x99\xaa\x98\xed\x99\xab\x52\xe0\x99\xac\x52\xf3\x99\xad\x52\xf5\x99\xae\x52\xf8\x99\xaf\x52\xf9\x99\xb0\x53\x06\x99\xb1\x53\x08\x99\xb2\x75\x38\x99\xb3\x53\x0d\x99\xb4\x53\x10\x99\xb5\x53\x0f\x99\xb6\x53\x15\x99\xb7\x53\x1a\x99\xb8\x53\x23\x99\xb9\x53\x2f\x99\xba\x53\x31\x99\xbb\x53\x33\x99\xbc\x53\x38\x99\xbd\x53\x40\x99\xbe\x53\x46\x99\xbf\x53\x45\x99\xc0\x4e\x17\x99\xc1\x53\x49\x99\xc2\x53\x4d\x99\xc3\x51\xd6\x99\xc4\x53\x5e\x99\xc5\x53\x69\x99\xc6\x53\x6e\x99\xc7\x59\x18\x99\xc8\x53\x7b\x99\xc9\x53\x77\x99\xca\x53\x82\x99\xcb\x53\x96\x99\xcc\x53\xa0\x99\xcd\x53\xa6\x99\xce\x53\xa5\x99\xcf\x53\xae\x99\xd0\x53\xb0\x99\xd1\x53\xb6\x99\xd2\x53\xc3\x99\xd3\x7c\x12\x99\xd4\x96\xd9\x99\xd5\x53\xdf\x99\xd6\x66\xfc\x99\xd7\x71\xee\x99\xd8\x53\xee\x99\xd9\x53\xe8\x99\xda\x53\xed\x99\xdb\x53\xfa\x99\xdc\x54\x01\x99\xdd\x54\x3d\x99\xde\x54\x40\x99\xdf\x54\x2c\x99\xe0\x54\x2d\x99\xe1\x54\x3c\x99\xe2\x54\x2e\x99\xe3\x54\x36\x99\xe4\x54\x29\x99\xe5\x54\x1d\x99\xe6\x54\x4e\x99\xe7\x54\x8f\x99\xe8\x54\x75\x99\xe9\x54\x8e\x99\xea\x54\x5f\x99\xeb\x54\x71\x99\xec\x54\x77\x99\xed\x54\x70\x99\xee\x54\x92\x99\xef\x54\x7b\x99\xf0\x54\x80\x99\xf1\x54\x76\x99\xf2\x54\x84\x99\xf3\x54\x90\x99\xf4\x54\x86\x99\xf5\x54\xc7\x99\xf6\x54\xa2\x99\xf7\x54\xb8\x99\xf8\x54\xa5\x99\xf9\x54\xac\x99\xfa\x54\xc4\x99\xfb\x54\xc8\x99\xfc\x54\xa8\x9a\x40\x54\xab\x9a\x41\x54\xc2\x9a\x42\x54\xa4\x9a\x43\x54\xbe\x9a\x44\x54\xbc\x9a\x45\x54\xd8\x9a\x46\x54\xe5\x9a\x47\x54\xe6\x9a\x48\x55\x0f\x9a\x49\x55\x14\x9a\x4a\x54\xfd\x9a\x4b\x54\xee\x9a\x4c\x54\xed\x9a\x4d\x54\xfa\x9a\x4e\x54\xe2\x9a\x4f\x55\x39\x9a\x50\x55\x40\x9a\x51\x55\x63\x9a\x52\x55\x4c\x9a\x53\x55\x2e\x9a\x54\x55\x5c\x9a\x55\x55\x45\x9a\x56\x55\x56\x9a\x57\x55\x57\x9a\x58\x55\x38\x9a\x59\x55\x33\x9a\x5a\x55\x5d\x9a\x5b\x55\x99\x9a\x5c\x55\x80\x9a\x5d\x54\xaf\x9a\x5e\x55\x8a\x9a\x5f\x55\x9f\x9a\x60\x55\x7b\x9a\x61\x55\x7e\x9a\x62\x55\x98\x9a\x63\x55\x9e\x9a\x64\x55\xae\x9a\x65\x55\x7c\x9a\x66\x55\x83\x9a\x67\x55\xa9\x9a\x68\x55\x87\x9a\x69\x55\xa8\x9a\x6a\x55\xda\x9a\x6b\x55\xc5\x9a\x6c\x55\xdf\x9a\x6d\x55\xc4\x9a\x6e\x55\xdc\x9a\x6f\x55\xe4\x9a\x70\x55\xd4\x9a\x71\x56\x14\x9a\x72\x55\xf7\x9a\x73\x56\x16\x9a\x74\x55\xfe\x9a\x75\x55\xfd\x9a\x76\x56\x1b\x9a\x77\x55\xf9\x9a\x78\x56\x4e\x9a\x79\x56\x50\x9a\x7a\x71\xdf\x9a\x7b\x56\x34\x9a\x7c\x56\x36\x9a\x7d\x56\x32\x9a\x7e\x56\x38\x9a\x80\x56\x6b\x9a\x81\x56\x64\x9a\x82\x56\x2f\x9a\x83\x56\x6c\x9a\x84\x56\x6a\x9a\x85\x56\x86\x9a\x86\x56\x80\x9a\x87\x56\x8a\x9a\x88\x56\xa0\x9a\x89\x56\x94\x9a\x8a\x56\x8f\x9a\x8b\x56\xa5\x9a\x8c\x56\xae\x9a\x8d\x56\xb6\x9a\x8e\x56\xb4\x9a\x8f\x56\xc2\x9a\x90\x56\xbc\x9a\x91\x56\xc1\x9a\x92\x56\xc3\x9a\x93\x56\xc0\x9a\x94\x56\xc8\x9a\x95\x56\xce\x9a\x96\x56\xd1\x9a\x97\x56\xd3\x9a\x98\x56\xd7\x9a\x99\x56\xee\x9a\x9a\x56\xf9\x9a\x9b\x57\x00\x9a\x9c\x56\xff\x9a\x9d\x57\x04\x9a\x9e\x57\x09\x9a\x9f\x57\x08\x9a\xa0\x57\x0b\x9a\xa1\x57\x0d\x9a\xa2\x57\x13\x9a\xa3\x57\x18\x9a\xa4\x57\x16\x9a\xa5\x55\xc7\x9a\xa6\x57\x1c\x9a\xa7\x57\x26\x9a\xa8\x57\x37\x9a\xa9\x57\x38\x9a\xaa\x57\x4e\x9a\xab\x57\x3b\x9a\xac\x57\x40\x9a\xad\x57\x4f\x9a\xae\x57\x69\x9a\xaf\x57\xc0\x9a\xb0\x57\x88\x9a\xb1\x57\x61\x9a\xb2\x57\x7f\x9a\xb3\x57\x89\x9a\xb4\x57\x93\x9a\xb5\x57\xa0\x9a\xb6\x57\xb3\x9a\xb7\x57\xa4\x9a\xb8\x57\xaa\x9a\xb9\x57\xb0\x9a\xba\x57\xc3\x9a\xbb\x57\xc6\x9a\xbc\x57\xd4\x9a\xbd\x57\xd2\x9a\xbe\x57\xd3\x9a\xbf\x58\x0a\x9a\xc0\x57\xd6\x9a\xc1\x57\xe3\x9a\xc2\x58\x0b\x9a\xc3\x58\x19\x9a\xc4\x58\x1d\x9a\xc5\x58\x72\x9a\xc6\x58\x21\x9a\xc7\x58\x62\x9a\xc8\x58\x4b\x9a\xc9\x58\x70\x9a\xca\x6b\xc0\x9a\xcb\x58\x52\x9a\xcc\x58\x3d\x9a\xcd\x58\x79\x9a\xce\x58\x85\x9a\xcf\x58\xb9\x9a\xd0\x58\x9f\x9a\xd1\x58\xab\x9a\xd2\x58\xba\x9a\xd3\x58\xde\x9a\xd4\x58\xbb\x9a\xd5\x58\xb8\x9a\xd6\x58\xae\x9a\xd7\x58\xc5\x9a\xd8\x58\xd3\x9a\xd9\x58\xd1\x9a\xda\x58\xd7\x9a\xdb\x58\xd9\x9a\xdc\x58\xd8\x9a\xdd\x58\xe5\x9a\xde\x58\xdc\x9a\xdf\x58\xe4\x9a\xe0\x58\xdf\x9a\xe1\x58\xef\x9a\xe2\x58\xfa\x9a\xe3\x58\xf9\x9a\xe4\x58\xfb\x9a\xe5\x58\xfc\x9a\xe6\x58\xfd\x9a\xe7\x59\x02\x9a\xe8\x59\x0a\x9a\xe9\x59\x10\x9a\xea\x59\x1b\x9a\xeb\x68\xa6\x9a\xec\x59\x25\x9a\xed\x59\x2c\x9a\xee\x59\x2d\x9a\xef\x59\x32\x9a\xf0\x59\x38\x9a\xf1\x59\x3e\x9a\xf2\x7a\xd2\x9a\xf3\x59\x55\x9a\xf4\x59\x50\x9a\xf5\x59\x4e\x9a\xf6\x59\x5a\x9a\xf7\x59\x58\x9a\xf8\x59\x62\x9a\xf9\x59\x60\x9a\xfa\x59\x67\x9a\xfb\x59\x6c\x9a\xfc\x59\x69\x9b\x40\x59\x78\x9b\x41\x59\x81\x9b\x42\x59\x9d\x9b\x43\x4f\x5e\x9b\x44\x4f\xab\x9b\x45\x59\xa3\x9b\x46\x59\xb2\x9b\x47\x59\xc6\x9b\x48\x59\xe8\x9b\x49\x59\xdc\x9b\x4a\x59\x8d\x9b\x4b\x59\xd9\x9b\x4c\x59\xda\x9b\x4d\x5a\x25\x9b\x4e\x5a\x1f\x9b\x4f\x5a\x11\x9b\x50\x5a\x1c\x9b\x51\x5a\x09\x9b\x52\x5a\x1a\x9b\x53\x5a\x40\x9b\x54\x5a\x6c\x9b\x55\x5a\x49\x9b\x56\x5a\x35\x9b\x57\x5a\x36\x9b\x58\x5a\x62\x9b\x59\x5a\x6a\x9b\x5a\x5a\x9a\x9b\x5b\x5a\xbc\x9b\x5c\x5a\xbe\x9b\x5d\x5a\xcb\x9b\x5e\x5a\xc2\x9b\x5f\x5a\xbd\x9b\x60\x5a\xe3\x9b\x61\x5a\xd7\x9b\x62\x5a\xe6\x9b\x63\x5a\xe9\x9b\x64\x5a\xd6\x9b\x65\x5a\xfa\x9b\x66\x5a\xfb\x9b\x67\x5b\x0c\x9b\x68\x5b\x0b\x9b\x69\x5b\x16\x9b\x6a\x5b\x32\x9b\x6b\x5a\xd0\x9b\x6c\x5b\x2a\x9b\x6d\x5b\x36\x9b\x6e\x5b\x3e\x9b\x6f\x5b\x43\x9b\x70\x5b\x45\x9b\x71\x5b\x40\x9b\x72\x5b\x51\x9b\x73\x5b\x55\x9b\x74\x5b\x5a\x9b\x75\x5b\x5b\x9b\x76\x5b\x65\x9b\x77\x5b\x69\x9b\x78\x5b\x70\x9b\x79\x5b\x73\x9b\x7a\x5b\x75\x9b\x7b\x5b\x78\x9b\x7c\x65\x88\x9b\x7d\x5b\x7a\x9b\x7e\x5b\x80\x9b\x80\x5b\x83\x9b\x81\x5b\xa6\x9b\x82\x5b\xb8\x9b\x83\x5b\xc3\x9b\x84\x5b\xc7\x9b\x85\x5b\xc9\x9b\x86\x5b\xd4\x9b\x87\x5b\xd0\x9b\x88\x5b\xe4\x9b\x89\x5b\xe6\x9b\x8a\x5b\xe2\x9b\x8b\x5b\xde\x9b\x8c\x5b\xe5\x9b\x8d\x5b\xeb\x9b\x8e\x5b\xf0\x9b\x8f\x5b\xf6\x9b\x90\x5b\xf3\x9b\x91\x5c\x05\x9b\x92\x5c\x07\x9b\x93\x5c\x08\x9b\x94\x5c\x0d\x9b\x95\x5c\x13\x9b\x96\x5c\x20\x9b\x97\x5c\x22\x9b\x98\x5c\x28\x9b\x99\x5c\x38\x9b\x9a\x5c\x39\x9b\x9b\x5c\x41\x9b\x9c\x5c\x46\x9b\x9d\x5c\x4e\x9b\x9e\x5c\x53\x9b\x9f\x5c\x50\x9b\xa0\x5c\x4f\x9b\xa1\x5b\x71\x9b\xa2\x5c\x6c\x9b\xa3\x5c\x6e\x9b\xa4\x4e\x62\x9b\xa5\x5c\x76\x9b\xa6\x5c\x79\x9b\xa7\x5c\x8c\x9b\xa8\x5c\x91\x9b\xa9\x5c\x94\x9b\xaa\x59\x9b\x9b\xab\x5c\xab\x9b\xac\x5c\xbb\x9b\xad\x5c\xb6\x9b\xae\x5c\xbc\x9b\xaf\x5c\xb7\x9b\xb0\x5c\xc5\x9b\xb1\x5c\xbe\x9b\xb2\x5c\xc7\x9b\xb3\x5c\xd9\x9b\xb4\x5c\xe9\x9b\xb5\x5c\xfd\x9b\xb6\x5c\xfa\x9b\xb7\x5c\xed\x9b\xb8\x5d\x8c\x9b\xb9\x5c\xea\x9b\xba\x5d\x0b\x9b\xbb\x5d\x15\x9b\xbc\x5d\x17\x9b\xbd\x5d\x5c\x9b\xbe\x5d\x1f\x9b\xbf\x5d\x1b\x9b\xc0\x5d\x11\x9b\xc1\x5d\x14\x9b\xc2\x5d\x22\x9b\xc3\x5d\x1a\x9b\xc4\x5d\x19\x9b\xc5\x5d\x18\x9b\xc6\x5d\x4c\x9b\xc7\x5d\x52\x9b\xc8\x5d\x4e\x9b\xc9\x5d\x4b\x9b\xca\x5d\x6c\x9b\xcb\x5d\x73\x9b\xcc\x5d\x76\x9b\xcd\x5d\x87\x9b\xce\x5d\x84\x9b\xcf\x5d\x82\x9b\xd0\x5d\xa2\x9b\xd1\x5d\x9d\x9b\xd2\x5d\xac\x9b\xd3\x5d\xae\x9b\xd4\x5d\xbd\x9b\xd5\x5d\x90\x9b\xd6\x5d\xb7\x9b\xd7\x5d\xbc\x9b\xd8\x5d\xc9\x9b\xd9\x5d\xcd\x9b\xda\x5d\xd3\x9b\xdb\x5d\xd2\x9b\xdc\x5d\xd6\x9b\xdd\x5d\xdb\x9b\xde\x5d\xeb\x9b\xdf\x5d\xf2\x9b\xe0\x5d\xf5\x9b\xe1\x5e\x0b\x9b\xe2\x5e\x1a\x9b\xe3\x5e\x19\x9b\xe4\x5e\x11\x9b\xe5\x5e\x1b\x9b\xe6\x5e\x36\x9b\xe7\x5e\x37\x9b\xe8\x5e\x44\x9b\xe9\x5e\x43\x9b\xea\x5e\x40\x9b\xeb\x5e\x4e\x9b\xec\x5e\x57\x9b\xed\x5e\x54\x9b\xee\x5e\x5f\x9b\xef\x5e\x62\x9b\xf0\x5e\x64\x9b\xf1\x5e\x47\x9b\xf2\x5e\x75\x9b\xf3\x5e\x76\x9b\xf4\x5e\x7a\x9b\xf5\x9e\xbc\x9b\xf6\x5e\x7f\x9b\xf7\x5e\xa0\x9b\xf8\x5e\xc1\x9b\xf9\x5e\xc2\x9b\xfa\x5e\xc8\x9b\xfb\x5e\xd0\x9b\xfc\x5e\xcf\x9c\x40\x5e\xd6\x9c\x41\x5e\xe3\x9c\x42\x5e\xdd\x9c\x43\x5e\xda\x9c\x44\x5e\xdb\x9c\x45\x5e\xe2\x9c\x46\x5e\xe1\x9c\x47\x5e\xe8\x9c\x48\x5e\xe9\x9c\x49\x5e\xec\x9c\x4a\x5e\xf1\x9c\x4b\x5e\xf3\x9c\x4c\x5e\xf0\x9c\x4d\x5e\xf4\x9c\x4e\x5e\xf8\x9c\x4f\x5e\xfe\x9c\x50\x5f\x03\x9c\x51\x5f\x09\x9c\x52\x5f\x5d\x9c\x53\x5f\x5c\x9c\x54\x5f\x0b\x9c\x55\x5f\x11\x9c\x56\x5f\x16\x9c\x57\x5f\x29\x9c\x58\x5f\x2d\x9c\x59\x5f\x38\x9c\x5a\x5f\x41\x9c\x5b\x5f\x48\x9c\x5c\x5f\x4c\x9c\x5d\x5f\x4e\x9c\x5e\x5f\x2f\x9c\x5f\x5f\x51\x9c\x60\x5f\x56\x9c\x61\x5f\x57\x9c\x62\x5f\x59\x9c\x63\x5f\x61\x9c\x64\x5f\x6d\x9c\x65\x5f\x73\x9c\x66\x5f\x77\x9c\x67\x5f\x83\x9c\x68\x5f\x82\x9c\x69\x5f\x7f\x9c\x6a\x5f\x8a\x9c\x6b\x5f\x88\x9c\x6c\x5f\x91\x9c\x6d\x5f\x87\x9c\x6e\x5f\x9e\x9c\x6f\x5f\x99\x9c\x70\x5f\x98\x9c\x71\x5f\xa0\x9c\x72\x5f\xa8\x9c\x73\x5f\xad\x9c\x74\x5f\xbc\x9c\x75\x5f\xd6\x9c\x76\x5f\xfb\x9c\x77\x5f\xe4\x9c\x78\x5f\xf8\x9c\x79\x5f\xf1\x9c\x7a\x5f\xdd\x9c\x7b\x60\xb3\x9c\x7c\x5f\xff\x9c\x7d\x60\x21\x9c\x7e\x60\x60\x9c\x80\x60\x19\x9c\x81\x60\x10\x9c\x82\x60\x29\x9c\x83\x60\x0e\x9c\x84\x60\x31\x9c\x85\x60\x1b\x9c\x86\x60\x15\x9c\x87\x60\x2b\x9c\x88\x60\x26\x9c\x89\x60\x0f\x9c\x8a\x60\x3a\x9c\x8b\x60\x5a\x9c\x8c\x60\x41\x9c\x8d\x60\x6a\x9c\x8e\x60\x77\x9c\x8f\x60\x5f\x9c\x90\x60\x4a\x9c\x91\x60\x46\x9c\x92\x60\x4d\x9c\x93\x60\x63\x9c\x94\x60\x43\x9c\x95\x60\x64\x9c\x96\x60\x42\x9c\x97\x60\x6c\x9c\x98\x60\x6b\x9c\x99\x60\x59\x9c\x9a\x60\x81\x9c\x9b\x60\x8d\x9c\x9c\x60\xe7\x9c\x9d\x60\x83\x9c\x9e\x60\x9a\x9c\x9f\x60\x84\x9c\xa0\x60\x9b\x9c\xa1\x60\x96\x9c\xa2\x60\x97\x9c\xa3\x60\x92\x9c\xa4\x60\xa7\x9c\xa5\x60\x8b\x9c\xa6\x60\xe1\x9c\xa7\x60\xb8\x9c\xa8\x60\xe0\x9c\xa9\x60\xd3\x9c\xaa\x60\xb4\x9c\xab\x5f\xf0\x9c\xac\x60\xbd\x9c\xad\x60\xc6\x9c\xae\x60\xb5\x9c\xaf\x60\xd8\x9c\xb0\x61\x4d\x9c\xb1\x61\x15\x9c\xb2\x61\x06\x9c\xb3\x60\xf6\x9c\xb4\x60\xf7\x9c\xb5\x61\x00\x9c\xb6\x60\xf4\x9c\xb7\x60\xfa\x9c\xb8\x61\x03\x9c\xb9\x61\x21\x9c\xba\x60\xfb\x9c\xbb\x60\xf1\x9c\xbc\x61\x0d\x9c\xbd\x61\x0e\x9c\xbe\x61\x47\x9c\xbf\x61\x3e\x9c\xc0\x61\x28\x9c\xc1\x61\x27\x9c\xc2\x61\x4a\x9c\xc3\x61\x3f\x9c\xc4\x61\x3c\x9c\xc5\x61\x2c\x9c\xc6\x61\x34\x9c\xc7\x61\x3d\x9c\xc8\x61\x42\x9c\xc9\x61\x44\x9c\xca\x61\x73\x9c\xcb\x61\x77\x9c\xcc\x61\x58\x9c\xcd\x61\x59\x9c\xce\x61\x5a\x9c\xcf\x61\x6b\x9c\xd0\x61\x74\x9c\xd1\x61\x6f\x9c\xd2\x61\x65\x9c\xd3\x61\x71\x9c\xd4\x61\x5f\x9c\xd5\x61\x5d\x9c\xd6\x61\x53\x9c\xd7\x61\x75\x9c\xd8\x61\x99\x9c\xd9\x61\x96\x9c\xda\x61\x87\x9c\xdb\x61\xac\x9c\xdc\x61\x94\x9c\xdd\x61\x9a\x9c\xde\x61\x8a\x9c\xdf\x61\x91\x9c\xe0\x61\xab\x9c\xe1\x61\xae\x9c\xe2\x61\xcc\x9c\xe3\x61\xca\x9c\xe4\x61\xc9\x9c\xe5\x61\xf7\x9c\xe6\x61\xc8\x9c\xe7\x61\xc3\x9c\xe8\x61\xc6\x9c\xe9\x61\xba\x9c\xea\x61\xcb\x9c\xeb\x7f\x79\x9c\xec\x61\xcd\x9c\xed\x61\xe6\x9c\xee\x61\xe3\x9c\xef\x61\xf6\x9c\xf0\x61\xfa\x9c\xf1\x61\xf4\x9c\xf2\x61\xff\x9c\xf3\x61\xfd\x9c\xf4\x61\xfc\x9c\xf5\x61\xfe\x9c\xf6\x62\x00\x9c\xf7\x62\x08\x9c\xf8\x62\x09\x9c\xf9\x62\x0d\x9c\xfa\x62\x0c\x9c\xfb\x62\x14\x9c\xfc\x62\x1b\x9d\x40\x62\x1e\x9d\x41\x62\x21\x9d\x42\x62\x2a\x9d\x43\x62\x2e\x9d\x44\x62\x30\x9d\x45\x62\x32\x9d\x46\x62\x33\x9d\x47\x62\x41\x9d\x48\x62\x4e\x9d\x49\x62\x5e\x9d\x4a\x62\x63\x9d\x4b\x62\x5b\x9d\x4c\x62\x60\x9d\x4d\x62\x68\x9d\x4e\x62\x7c\x9d\x4f\x62\x82\x9d\x50\x62\x89\x9d\x51\x62\x7e\x9d\x52\x62\x92\x9d\x53\x62\x93\x9d\x54\x62\x96\x9d\x55\x62\xd4\x9d\x56\x62\x83\x9d\x57\x62\x94\x9d\x58\x62\xd7\x9d\x59\x62\xd1\x9d\x5a\x62\xbb\x9d\x5b\x62\xcf\x9d\x5c\x62\xff\x9d\x5d\x62\xc6\x9d\x5e\x64\xd4\x9d\x5f\x62\xc8\x9d\x60\x62\xdc\x9d\x61\x62\xcc\x9d\x62\x62\xca\x9d\x63\x62\xc2\x9d\x64\x62\xc7\x9d\x65\x62\x9b\x9d\x66\x62\xc9\x9d\x67\x63\x0c\x9d\x68\x62\xee\x9d\x69\x62\xf1\x9d\x6a\x63\x27\x9d\x6b\x63\x02\x9d\x6c\x63\x08\x9d\x6d\x62\xef\x9d\x6e\x62\xf5\x9d\x6f\x63\x50\x9d\x70\x63\x3e\x9d\x71\x63\x4d\x9d\x72\x64\x1c\x9d\x73\x63\x4f\x9d\x74\x63\x96\x9d\x75\x63\x8e\x9d\x76\x63\x80\x9d\x77\x63\xab\x9d\x78\x63\x76\x9d\x79\x63\xa3\x9d\x7a\x63\x8f\x9d\x7b\x63\x89\x9d\x7c\x63\x9f\x9d\x7d\x63\xb5\x9d\x7e\x63\x6b\x9d\x80\x63\x69\x9d\x81\x63\xbe\x9d\x82\x63\xe9\x9d\x83\x63\xc0\x9d\x84\x63\xc6\x9d\x85\x63\xe3\x9d\x86\x63\xc9\x9d\x87\x63\xd2\x9d\x88\x63\xf6\x9d\x89\x63\xc4\x9d\x8a\x64\x16\x9d\x8b\x64\x34\x9d\x8c\x64\x06\x9d\x8d\x64\x13\x9d\x8e\x64\x26\x9d\x8f\x64\x36\x9d\x90\x65\x1d\x9d\x91\x64\x17\x9d\x92\x64\x28\x9d\x93\x64\x0f\x9d\x94\x64\x67\x9d\x95\x64\x6f\x9d\x96\x64\x76\x9d\x97\x64\x4e\x9d\x98\x65\x2a\x9d\x99\x64\x95\x9d\x9a\x64\x93\x9d\x9b\x64\xa5\x9d\x9c\x64\xa9\x9d\x9d\x64\x88\x9d\x9e\x64\xbc\x9d\x9f\x64\xda\x9d\xa0\x64\xd2\x9d\xa1\x64\xc5\x9d\xa2\x64\xc7\x9d\xa3\x64\xbb\x9d\xa4\x64\xd8\x9d\xa5\x64\xc2\x9d\xa6\x64\xf1\x9d\xa7\x64\xe7\x9d\xa8\x82\x09\x9d\xa9\x64\xe0\x9d\xaa\x64\xe1\x9d\xab\x62\xac\x9d\xac\x64\xe3\x9d\xad\x64\xef\x9d\xae\x65\x2c\x9d\xaf\x64\xf6\x9d\xb0\x64\xf4\x9d\xb1\x64\xf2\x9d\xb2\x64\xfa\x9d\xb3\x65\x00\x9d\xb4\x64\xfd\x9d\xb5\x65\x18\x9d\xb6\x65\x1c\x9d\xb7\x65\x05\x9d\xb8\x65\x24\x9d\xb9\x65\x23\x9d\xba\x65\x2b\x9d\xbb\x65\x34\x9d\xbc\x65\x35\x9d\xbd\x65\x37\x9d\xbe\x65\x36\x9d\xbf\x65\x38\x9d\xc0\x75\x4b\x9d\xc1\x65\x48\x9d\xc2\x65\x56\x9d\xc3\x65\x55\x9d\xc4\x65\x4d\x9d\xc5\x65\x58\x9d\xc6\x65\x5e\x9d\xc7\x65\x5d\x9d\xc8\x65\x72\x9d\xc9\x65\x78\x9d\xca\x65\x82\x9d\xcb\x65\x83\x9d\xcc\x8b\x8a\x9d\xcd\x65\x9b\x9d\xce\x65\x9f\x9d\xcf\x65\xab\x9d\xd0\x65\xb7\x9d\xd1\x65\xc3\x9d\xd2\x65\xc6\x9d\xd3\x65\xc1\x9d\xd4\x65\xc4\x9d\xd5\x65\xcc\x9d\xd6\x65\xd2\x9d\xd7\x65\xdb\x9d\xd8\x65\xd9\x9d\xd9\x65\xe0\x9d\xda\x65\xe1\x9d\xdb\x65\xf1\x9d\xdc\x67\x72\x9d\xdd\x66\x0a\x9d\xde\x66\x03\x9d\xdf\x65\xfb\x9d\xe0\x67\x73\x9d\xe1\x66\x35\x9d\xe2\x66\x36\x9d\xe3\x66\x34\x9d\xe4\x66\x1c\x9d\xe5\x66\x4f\x9d\xe6\x66\x44\x9d\xe7\x66\x49\x9d\xe8\x66\x41\x9d\xe9\x66\x5e\x9d\xea\x66\x5d\x9d\xeb\x66\x64\x9d\xec\x66\x67\x9d\xed\x66\x68\x9d\xee\x66\x5f\x9d\xef\x66\x62\x9d\xf0\x66\x70\x9d\xf1\x66\x83\x9d\xf2\x66\x88\x9d\xf3\x66\x8e\x9d\xf4\x66\x89\x9d\xf5\x66\x84\x9d\xf6\x66\x98\x9d\xf7\x66\x9d\x9d\xf8\x66\xc1\x9d\xf9\x66\xb9\x9d\xfa\x66\xc9\x9d\xfb\x66\xbe\x9d\xfc\x66\xbc\x9e\x40\x66\xc4\x9e\x41\x66\xb8\x9e\x42\x66\xd6\x9e\x43\x66\xda\x9e\x44\x66\xe0\x9e\x45\x66\x3f\x9e\x46\x66\xe6\x9e\x47\x66\xe9\x9e\x48\x66\xf0\x9e\x49\x66\xf5\x9e\x4a\x66\xf7\x9e\x4b\x67\x0f\x9e\x4c\x67\x16\x9e\x4d\x67\x1e\x9e\x4e\x67\x26\x9e\x4f\x67\x27\x9e\x50\x97\x38\x9e\x51\x67\x2e\x9e\x52\x67\x3f\x9e\x53\x67\x36\x9e\x54\x67\x41\x9e\x55\x67\x38\x9e\x56\x67\x37\x9e\x57\x67\x46\x9e\x58\x67\x5e\x9e\x59\x67\x60\x9e\x5a\x67\x59\x9e\x5b\x67\x63\x9e\x5c\x67\x64\x9e\x5d\x67\x89\x9e\x5e\x67\x70\x9e\x5f\x67\xa9\x9e\x60\x67\x7c\x9e\x61\x67\x6a\x9e\x62\x67\x8c\x9e\x63\x67\x8b\x9e\x64\x67\xa6\x9e\x65\x67\xa1\x9e\x66\x67\x85\x9e\x67\x67\xb7\x9e\x68\x67\xef\x9e\x69\x67\xb4\x9e\x6a\x67\xec\x9e\x6b\x67\xb3\x9e\x6c\x67\xe9\x9e\x6d\x67\xb8\x9e\x6e\x67\xe4\x9e\x6f\x67\xde\x9e\x70\x67\xdd\x9e\x71\x67\xe2\x9e\x72\x67\xee\x9e\x73\x67\xb9\x9e\x74\x67\xce\x9e\x75\x67\xc6\x9e\x76\x67\xe7\x9e\x77\x6a\x9c\x9e\x78\x68\x1e\x9e\x79\x68\x46\x9e\x7a\x68\x29\x9e\x7b\x68\x40\x9e\x7c\x68\x4d\x9e\x7d\x68\x32\x9e\x7e\x68\x4e\x9e\x80\x68\xb3\x9e\x81\x68\x2b\x9e\x82\x68\x59\x9e\x83\x68\x63\x9e\x84\x68\x77\x9e\x85\x68\x7f\x9e\x86\x68\x9f\x9e\x87\x68\x8f\x9e\x88\x68\xad\x9e\x89\x68\x94\x9e\x8a\x68\x9d\x9e\x8b\x68\x9b\x9e\x8c\x68\x83\x9e\x8d\x6a\xae\x9e\x8e\x68\xb9\x9e\x8f\x68\x74\x9e\x90\x68\xb5\x9e\x91\x68\xa0\x9e\x92\x68\xba\x9e\x93\x69\x0f\x9e\x94\x68\x8d\x9e\x95\x68\x7e\x9e\x96\x69\x01\x9e\x97\x68\xca\x9e\x98\x69\x08\x9e\x99\x68\xd8\x9e\x9a\x69\x22\x9e\x9b\x69\x26\x9e\x9c\x68\xe1\x9e\x9d\x69\x0c\x9e\x9e\x68\xcd\x9e\x9f\x68\xd4\x9e\xa0\x68\xe7\x9e\xa1\x68\xd5\x9e\xa2\x69\x36\x9e\xa3\x69\x12\x9e\xa4\x69\x04\x9e\xa5\x68\xd7\x9e\xa6\x68\xe3\x9e\xa7\x69\x25\x9e\xa8\x68\xf9\x9e\xa9\x68\xe0\x9e\xaa\x68\xef\x9e\xab\x69\x28\x9e\xac\x69\x2a\x9e\xad\x69\x1a\x9e\xae\x69\x23\x9e\xaf\x69\x21\x9e\xb0\x68\xc6\x9e\xb1\x69\x79\x9e\xb2\x69\x77\x9e\xb3\x69\x5c\x9e\xb4\x69\x78\x9e\xb5\x69\x6b\x9e\xb6\x69\x54\x9e\xb7\x69\x7e\x9e\xb8\x69\x6e\x9e\xb9\x69\x39\x9e\xba\x69\x74\x9e\xbb\x69\x3d\x9e\xbc\x69\x59\x9e\xbd\x69\x30\x9e\xbe\x69\x61\x9e\xbf\x69\x5e\x9e\xc0\x69\x5d\x9e\xc1\x69\x81\x9e\xc2\x69\x6a\x9e\xc3\x69\xb2\x9e\xc4\x69\xae\x9e\xc5\x69\xd0\x9e\xc6\x69\xbf\x9e\xc7\x69\xc1\x9e\xc8\x69\xd3\x9e\xc9\x69\xbe\x9e\xca\x69\xce\x9e\xcb\x5b\xe8\x9e\xcc\x69\xca\x9e\xcd\x69\xdd\x9e\xce\x69\xbb\x9e\xcf\x69\xc3\x9e\xd0\x69\xa7\x9e\xd1\x6a\x2e\x9e\xd2\x69\x91\x9e\xd3\x69\xa0\x9e\xd4\x69\x9c\x9e\xd5\x69\x95\x9e\xd6\x69\xb4\x9e\xd7\x69\xde\x9e\xd8\x69\xe8\x9e\xd9\x6a\x02\x9e\xda\x6a\x1b\x9e\xdb\x69\xff\x9e\xdc\x6b\x0a\x9e\xdd\x69\xf9\x9e\xde\x69\xf2\x9e\xdf\x69\xe7\x9e\xe0\x6a\x05\x9e\xe1\x69\xb1\x9e\xe2\x6a\x1e\x9e\xe3\x69\xed\x9e\xe4\x6a\x14\x9e\xe5\x69\xeb\x9e\xe6\x6a\x0a\x9e\xe7\x6a\x12\x9e\xe8\x6a\xc1\x9e\xe9\x6a\x23\x9e\xea\x6a\x13\x9e\xeb\x6a\x44\x9e\xec\x6a\x0c\x9e\xed\x6a\x72\x9e\xee\x6a\x36\x9e\xef\x6a\x78\x9e\xf0\x6a\x47\x9e\xf1\x6a\x62\x9e\xf2\x6a\x59\x9e\xf3\x6a\x66\x9e\xf4\x6a\x48\x9e\xf5\x6a\x38\x9e\xf6\x6a\x22\x9e\xf7\x6a\x90\x9e\xf8\x6a\x8d\x9e\xf9\x6a\xa0\x9e\xfa\x6a\x84\x9e\xfb\x6a\xa2\x9e\xfc\x6a\xa3\x9f\x40\x6a\x97\x9f\x41\x86\x17\x9f\x42\x6a\xbb\x9f\x43\x6a\xc3\x9f\x44\x6a\xc2\x9f\x45\x6a\xb8\x9f\x46\x6a\xb3\x9f\x47\x6a\xac\x9f\x48\x6a\xde\x9f\x49\x6a\xd1\x9f\x4a\x6a\xdf\x9f\x4b\x6a\xaa\x9f\x4c\x6a\xda\x9f\x4d\x6a\xea\x9f\x4e\x6a\xfb\x9f\x4f\x6b\x05\x9f\x50\x86\x16\x9f\x51\x6a\xfa\x9f\x52\x6b\x12\x9f\x53\x6b\x16\x9f\x54\x9b\x31\x9f\x55\x6b\x1f\x9f\x56\x6b\x38\x9f\x57\x6b\x37\x9f\x58\x76\xdc\x9f\x59\x6b\x39\x9f\x5a\x98\xee\x9f\x5b\x6b\x47\x9f\x5c\x6b\x43\x9f\x5d\x6b\x49\x9f\x5e\x6b\x50\x9f\x5f\x6b\x59\x9f\x60\x6b\x54\x9f\x61\x6b\x5b\x9f\x62\x6b\x5f\x9f\x63\x6b\x61\x9f\x64\x6b\x78\x9f\x65\x6b\x79\x9f\x66\x6b\x7f\x9f\x67\x6b\x80\x9f\x68\x6b\x84\x9f\x69\x6b\x83\x9f\x6a\x6b\x8d\x9f\x6b\x6b\x98\x9f\x6c\x6b\x95\x9f\x6d\x6b\x9e\x9f\x6e\x6b\xa4\x9f\x6f\x6b\xaa\x9f\x70\x6b\xab\x9f\x71\x6b\xaf\x9f\x72\x6b\xb2\x9f\x73\x6b\xb1\x9f\x74\x6b\xb3\x9f\x75\x6b\xb7\x9f\x76\x6b\xbc\x9f\x77\x6b\xc6\x9f\x78\x6b\xcb\x9f\x79\x6b\xd3\x9f\x7a\x6b\xdf\x9f\x7b\x6b\xec\x9f\x7c\x6b\xeb\x9f\x7d\x6b\xf3\x9f\x7e\x6b\xef\x9f\x80\x9e\xbe\x9f\x81\x6c\x08\x9f\x82\x6c\x13\x9f\x83\x6c\x14\x9f\x84\x6c\x1b\x9f\x85\x6c\x24\x9f\x86\x6c\x23\x9f\x87\x6c\x5e\x9f\x88\x6c\x55\x9f\x89\x6c\x62\x9f\x8a\x6c\x6a\x9f\x8b\x6c\x82\x9f\x8c\x6c\x8d\x9f\x8d\x6c\x9a\x9f\x8e\x6c\x81\x9f\x8f\x6c\x9b\x9f\x90\x6c\x7e\x9f\x91\x6c\x68\x9f\x92\x6c\x73\x9f\x93\x6c\x92\x9f\x94\x6c\x90\x9f\x95\x6c\xc4\x9f\x96\x6c\xf1\x9f\x97\x6c\xd3\x9f\x98\x6c\xbd\x9f\x99\x6c\xd7\x9f\x9a\x6c\xc5\x9f\x9b\x6c\xdd\x9f\x9c\x6c\xae\x9f\x9d\x6c\xb1\x9f\x9e\x6c\xbe\x9f\x9f\x6c\xba\x9f\xa0\x6c\xdb\x9f\xa1\x6c\xef\x9f\xa2\x6c\xd9\x9f\xa3\x6c\xea\x9f\xa4\x6d\x1f\x9f\xa5\x88\x4d\x9f\xa6\x6d\x36\x9f\xa7\x6d\x2b\x9f\xa8\x6d\x3d\x9f\xa9\x6d\x38\x9f\xaa\x6d\x19\x9f\xab\x6d\x35\x9f\xac\x6d\x33\x9f\xad\x6d\x12\x9f\xae\x6d\x0c\x9f\xaf\x6d\x63\x9f\xb0\x6d\x93\x9f\xb1\x6d\x64\x9f\xb2\x6d\x5a\x9f\xb3\x6d\x79\x9f\xb4\x6d\x59\x9f\xb5\x6d\x8e\x9f\xb6\x6d\x95\x9f\xb7\x6f\xe4\x9f\xb8\x6d\x85\x9f\xb9\x6d\xf9\x9f\xba\x6e\x15\x9f\xbb\x6e\x0a\x9f\xbc\x6d\xb5\x9f\xbd\x6d\xc7\x9f\xbe\x6d\xe6\x9f\xbf\x6d\xb8\x9f\xc0\x6d\xc6\x9f\xc1\x6d\xec\x9f\xc2\x6d\xde\x9f\xc3\x6d\xcc\x9f\xc4\x6d\xe8\x9f\xc5\x6d\xd2\x9f\xc6\x6d\xc5\x9f\xc7\x6d\xfa\x9f\xc8\x6d\xd9\x9f\xc9\x6d\xe4\x9f\xca\x6d\xd5\x9f\xcb\x6d\xea\x9f\xcc\x6d\xee\x9f\xcd\x6e\x2d\x9f\xce\x6e\x6e\x9f\xcf\x6e\x2e\x9f\xd0\x6e\x19\x9f\xd1\x6e\x72\x9f\xd2\x6e\x5f\x9f\xd3\x6e\x3e\x9f\xd4\x6e\x23\x9f\xd5\x6e\x6b\x9f\xd6\x6e\x2b\x9f\xd7\x6e\x76\x9f\xd8\x6e\x4d\x9f\xd9\x6e\x1f\x9f\xda\x6e\x43\x9f\xdb\x6e\x3a\x9f\xdc\x6e\x4e\x9f\xdd\x6e\x24\x9f\xde\x6e\xff\x9f\xdf\x6e\x1d\x9f\xe0\x6e\x38\x9f\xe1\x6e\x82\x9f\xe2\x6e\xaa\x9f\xe3\x6e\x98\x9f\xe4\x6e\xc9\x9f\xe5\x6e\xb7\x9f\xe6\x6e\xd3\x9f\xe7\x6e\xbd\x9f\xe8\x6e\xaf\x9f\xe9\x6e\xc4\x9f\xea\x6e\xb2\x9f\xeb\x6e\xd4\x9f\xec\x6e\xd5\x9f\xed\x6e\x8f\x9f\xee\x6e\xa5\x9f\xef\x6e\xc2\x9f\xf0\x6e\x9f\x9f\xf1\x6f\x41\x9f\xf2\x6f\x11\x9f\xf3\x70\x4c\x9f\xf4\x6e\xec\x9f\xf5\x6e\xf8\x9f\xf6\x6e\xfe\x9f\xf7\x6f\x3f\x9f\xf8\x6e\xf2\x9f\xf9\x6f\x31\x9f\xfa\x6e\xef\x9f\xfb\x6f\x32\x9f\xfc\x6e\xcc\xe0\x40\x6f\x3e\xe0\x41\x6f\x13\xe0\x42\x6e\xf7\xe0\x43\x6f\x86\xe0\x44\x6f\x7a\xe0\x45\x6f\x78\xe0\x46\x6f\x81\xe0\x47\x6f\x80\xe0\x48\x6f\x6f\xe0\x49\x6f\x5b\xe0\x4a\x6f\xf3\xe0\x4b\x6f\x6d\xe0\x4c\x6f\x82\xe0\x4d\x6f\x7c\xe0\x4e\x6f\x58\xe0\x4f\x6f\x8e\xe0\x50\x6f\x91\xe0\x51\x6f\xc2\xe0\x52\x6f\x66\xe0\x53\x6f\xb3\xe0\x54\x6f\xa3\xe0\x55\x6f\xa1\xe0\x56\x6f\xa4\xe0\x57\x6f\xb9\xe0\x58\x6f\xc6\xe0\x59\x6f\xaa\xe0\x5a\x6f\xdf\xe0\x5b\x6f\xd5\xe0\x5c\x6f\xec\xe0\x5d\x6f\xd4\xe0\x5e\x6f\xd8\xe0\x5f\x6f\xf1\xe0\x60\x6f\xee\xe0\x61\x6f\xdb\xe0\x62\x70\x09\xe0\x63\x70\x0b\xe0\x64\x6f\xfa\xe0\x65\x70\x11\xe0\x66\x70\x01\xe0\x67\x70\x0f\xe0\x68\x6f\xfe\xe0\x69\x70\x1b\xe0\x6a\x70\x1a\xe0\x6b\x6f\x74\xe0\x6c\x70\x1d\xe0\x6d\x70\x18\xe0\x6e\x70\x1f\xe0\x6f\x70\x30\xe0\x70\x70\x3e\xe0\x71\x70\x32\xe0\x72\x70\x51\xe0\x73\x70\x63\xe0\x74\x70\x99\xe0\x75\x70\x92\xe0\x76\x70\xaf\xe0\x77\x70\xf1\xe0\x78\x70\xac\xe0\x79\x70\xb8\xe0\x7a\x70\xb3\xe0\x7b\x70\xae\xe0\x7c\x70\xdf\xe0\x7d\x70\xcb\xe0\x7e\x70\xdd\xe0\x80\x70\xd9\xe0\x81\x71\x09\xe0\x82\x70\xfd\xe0\x83\x71\x1c\xe0\x84\x71\x19\xe0\x85\x71\x65\xe0\x86\x71\x55\xe0\x87\x71\x88\xe0\x88\x71\x66\xe0\x89\x71\x62\xe0\x8a\x71\x4c\xe0\x8b\x71\x56\xe0\x8c\x71\x6c\xe0\x8d\x71\x8f\xe0\x8e\x71\xfb\xe0\x8f\x71\x84\xe0\x90\x71\x95\xe0\x91\x71\xa8\xe0\x92\x71\xac\xe0\x93\x71\xd7\xe0\x94\x71\xb9\xe0\x95\x71\xbe\xe0\x96\x71\xd2\xe0\x97\x71\xc9\xe0\x98\x71\xd4\xe0\x99\x71\xce\xe0\x9a\x71\xe0\xe0\x9b\x71\xec\xe0\x9c\x71\xe7\xe0\x9d\x71\xf5\xe0\x9e\x71\xfc\xe0\x9f\x71\xf9\xe0\xa0\x71\xff\xe0\xa1\x72\x0d\xe0\xa2\x72\x10\xe0\xa3\x72\x1b\xe0\xa4\x72\x28\xe0\xa5\x72\x2d\xe0\xa6\x72\x2c\xe0\xa7\x72\x30\xe0\xa8\x72\x32\xe0\xa9\x72\x3b\xe0\xaa\x72\x3c\xe0\xab\x72\x3f\xe0\xac\x72\x40\xe0\xad\x72\x46\xe0\xae\x72\x4b\xe0\xaf\x72\x58\xe0\xb0\x72\x74\xe0\xb1\x72\x7e\xe0\xb2\x72\x82\xe0\xb3\x72\x81\xe0\xb4\x72\x87\xe0\xb5\x72\x92\xe0\xb6\x72\x96\xe0\xb7\x72\xa2\xe0\xb8\x72\xa7\xe0\xb9\x72\xb9\xe0\xba\x72\xb2\xe0\xbb\x72\xc3\xe0\xbc\x72\xc6\xe0\xbd\x72\xc4\xe0\xbe\x72\xce\xe0\xbf\x72\xd2\xe0\xc0\x72\xe2\xe0\xc1\x72\xe0\xe0\xc2\x72\xe1\xe0\xc3\x72\xf9\xe0\xc4\x72\xf7\xe0\xc5\x50\x0f\xe0\xc6\x73\x17\xe0\xc7\x73\x0a\xe0\xc8\x73\x1c\xe0\xc9\x73\x16\xe0\xca\x73\x1d\xe0\xcb\x73\x34\xe0\xcc\x73\x2f\xe0\xcd\x73\x29\xe0\xce\x73\x25\xe0\xcf\x73\x3e\xe0\xd0\x73\x4e\xe0\xd1\x73\x4f\xe0\xd2\x9e\xd8\xe0\xd3\x73\x57\xe0\xd4\x73\x6a\xe0\xd5\x73\x68\xe0\xd6\x73\x70\xe0\xd7\x73\x78\xe0\xd8\x73\x75\xe0\xd9\x73\x7b\xe0\xda\x73\x7a\xe0\xdb\x73\xc8\xe0\xdc\x73\xb3\xe0\xdd\x73\xce\xe0\xde\x73\xbb\xe0\xdf\x73\xc0\xe0\xe0\x73\xe5\xe0\xe1\x73\xee\xe0\xe2\x73\xde\xe0\xe3\x74\xa2\xe0\xe4\x74\x05\xe0\xe5\x74\x6f\xe0\xe6\x74\x25\xe0\xe7\x73\xf8\xe0\xe8\x74\x32\xe0\xe9\x74\x3a\xe0\xea\x74\x55\xe0\xeb\x74\x3f\xe0\xec\x74\x5f\xe0\xed\x74\x59\xe0\xee\x74\x41\xe0\xef\x74\x5c\xe0\xf0\x74\x69\xe0\xf1\x74\x70\xe0\xf2\x74\x63\xe0\xf3\x74\x6a\xe0\xf4\x74\x76\xe0\xf5\x74\x7e\xe0\xf6\x74\x8b\xe0\xf7\x74\x9e\xe0\xf8\x74\xa7\xe0\xf9\x74\xca\xe0\xfa\x74\xcf\xe0\xfb\x74\xd4\xe0\xfc\x73\xf1\xe1\x40\x74\xe0\xe1\x41\x74\xe3\xe1\x42\x74\xe7\xe1\x43\x74\xe9\xe1\x44\x74\xee\xe1\x45\x74\xf2\xe1\x46\x74\xf0\xe1\x47\x74\xf1\xe1\x48\x74\xf8\xe1\x49\x74\xf7\xe1\x4a\x75\x04\xe1\x4b\x75\x03\xe1\x4c\x75\x05\xe1\x4d\x75\x0c\xe1\x4e\x75\x0e\xe1\x4f\x75\x0d\xe1\x50\x75\x15\xe1\x51\x75\x13\xe1\x52\x75\x1e\xe1\x53\x75\x26\xe1\x54\x75\x2c\xe1\x55\x75\x3c\xe1\x56\x75\x44\xe1\x57\x75\x4d\xe1\x58\x75\x4a\xe1\x59\x75\x49\xe1\x5a\x75\x5b\xe1\x5b\x75\x46\xe1\x5c\x75\x5a\xe1\x5d\x75\x69\xe1\x5e\x75\x64\xe1\x5f\x75\x67\xe1\x60\x75\x6b\xe1\x61\x75\x6d\xe1\x62\x75\x78\xe1\x63\x75\x76\xe1\x64\x75\x86\xe1\x65\x75\x87\xe1\x66\x75\x74\xe1\x67\x75\x8a\xe1\x68\x75\x89\xe1\x69\x75\x82\xe1\x6a\x75\x94\xe1\x6b\x75\x9a\xe1\x6c\x75\x9d\xe1\x6d\x75\xa5\xe1\x6e\x75\xa3\xe1\x6f\x75\xc2\xe1\x70\x75\xb3\xe1\x71\x75\xc3\xe1\x72\x75\xb5\xe1\x73\x75\xbd\xe1\x74\x75\xb8\xe1\x75\x75\xbc\xe1\x76\x75\xb1\xe1\x77\x75\xcd\xe1\x78\x75\xca\xe1\x79\x75\xd2\xe1\x7a\x75\xd9\xe1\x7b\x75\xe3\xe1\x7c\x75\xde\xe1\x7d\x75\xfe\xe1\x7e\x75\xff\xe1\x80\x75\xfc\xe1\x81\x76\x01\xe1\x82\x75\xf0\xe1\x83\x75\xfa\xe1\x84\x75\xf2\xe1\x85\x75\xf3\xe1\x86\x76\x0b\xe1\x87\x76\x0d\xe1\x88\x76\x09\xe1\x89\x76\x1f\xe1\x8a\x76\x27\xe1\x8b\x76\x20\xe1\x8c\x76\x21\xe1\x8d\x76\x22\xe1\x8e\x76\x24\xe1\x8f\x76\x34\xe1\x90\x76\x30\xe1\x91\x76\x3b\xe1\x92\x76\x47\xe1\x93\x76\x48\xe1\x94\x76\x46\xe1\x95\x76\x5c\xe1\x96\x76\x58\xe1\x97\x76\x61\xe1\x98\x76\x62\xe1\x99\x76\x68\xe1\x9a\x76\x69\xe1\x9b\x76\x6a\xe1\x9c\x76\x67\xe1\x9d\x76\x6c\xe1\x9e\x76\x70\xe1\x9f\x76\x72\xe1\xa0\x76\x76\xe1\xa1\x76\x78\xe1\xa2\x76\x7c\xe1\xa3\x76\x80\xe1\xa4\x76\x83\xe1\xa5\x76\x88\xe1\xa6\x76\x8b\xe1\xa7\x76\x8e\xe1\xa8\x76\x96\xe1\xa9\x76\x93\xe1\xaa\x76\x99\xe1\xab\x76\x9a\xe1\xac\x76\xb0\xe1\xad\x76\xb4\xe1\xae\x76\xb8\xe1\xaf\x76\xb9\xe1\xb0\x76\xba\xe1\xb1\x76\xc2\xe1\xb2\x76\xcd\xe1\xb3\x76\xd6\xe1\xb4\x76\xd2\xe1\xb5\x76\xde\xe1\xb6\x76\xe1\xe1\xb7\x76\xe5\xe1\xb8\x76\xe7\xe1\xb9\x76\xea\xe1\xba\x86\x2f\xe1\xbb\x76\xfb\xe1\xbc\x77\x08\xe1\xbd\x77\x07\xe1\xbe\x77\x04\xe1\xbf\x77\x29\xe1\xc0\x77\x24\xe1\xc1\x77\x1e\xe1\xc2\x77\x25\xe1\xc3\x77\x26\xe1\xc4\x77\x1b\xe1\xc5\x77\x37\xe1\xc6\x77\x38\xe1\xc7\x77\x47\xe1\xc8\x77\x5a\xe1\xc9\x77\x68\xe1\xca\x77\x6b\xe1\xcb\x77\x5b\xe1\xcc\x77\x65\xe1\xcd\x77\x7f\xe1\xce\x77\x7e\xe1\xcf\x77\x79\xe1\xd0\x77\x8e\xe1\xd1\x77\x8b\xe1\xd2\x77\x91\xe1\xd3\x77\xa0\xe1\xd4\x77\x9e\xe1\xd5\x77\xb0\xe1\xd6\x77\xb6\xe1\xd7\x77\xb9\xe1\xd8\x77\xbf\xe1\xd9\x77\xbc\xe1\xda\x77\xbd\xe1\xdb\x77\xbb\xe1\xdc\x77\xc7\xe1\xdd\x77\xcd\xe1\xde\x77\xd7\xe1\xdf\x77\xda\xe1\xe0\x77\xdc\xe1\xe1\x77\xe3\xe1\xe2\x77\xee\xe1\xe3\x77\xfc\xe1\xe4\x78\x0c\xe1\xe5\x78\x12\xe1\xe6\x79\x26\xe1\xe7\x78\x20\xe1\xe8\x79\x2a\xe1\xe9\x78\x45\xe1\xea\x78\x8e\xe1\xeb\x78\x74\xe1\xec\x78\x86\xe1\xed\x78\x7c\xe1\xee\x78\x9a\xe1\xef\x78\x8c\xe1\xf0\x78\xa3\xe1\xf1\x78\xb5\xe1\xf2\x78\xaa\xe1\xf3\x78\xaf\xe1\xf4\x78\xd1\xe1\xf5\x78\xc6\xe1\xf6\x78\xcb\xe1\xf7\x78\xd4\xe1\xf8\x78\xbe\xe1\xf9\x78\xbc\xe1\xfa\x78\xc5\xe1\xfb\x78\xca\xe1\xfc\x78\xec\xe2\x40\x78\xe7\xe2\x41\x78\xda\xe2\x42\x78\xfd\xe2\x43\x78\xf4\xe2\x44\x79\x07\xe2\x45\x79\x12\xe2\x46\x79\x11\xe2\x47\x79\x19\xe2\x48\x79\x2c\xe2\x49\x79\x2b\xe2\x4a\x79\x40\xe2\x4b\x79\x60\xe2\x4c\x79\x57\xe2\x4d\x79\x5f\xe2\x4e\x79\x5a\xe2\x4f\x79\x55\xe2\x50\x79\x53\xe2\x51\x79\x7a\xe2\x52\x79\x7f\xe2\x53\x79\x8a\xe2\x54\x79\x9d\xe2\x55\x79\xa7\xe2\x56\x9f\x4b\xe2\x57\x79\xaa\xe2\x58\x79\xae\xe2\x59\x79\xb3\xe2\x5a\x79\xb9\xe2\x5b\x79\xba\xe2\x5c\x79\xc9\xe2\x5d\x79\xd5\xe2\x5e\x79\xe7\xe2\x5f\x79\xec\xe2\x60\x79\xe1\xe2\x61\x79\xe3\xe2\x62\x7a\x08\xe2\x63\x7a\x0d\xe2\x64\x7a\x18\xe2\x65\x7a\x19\xe2\x66\x7a\x20\xe2\x67\x7a\x1f\xe2\x68\x79\x80\xe2\x69\x7a\x31\xe2\x6a\x7a\x3b\xe2\x6b\x7a\x3e\xe2\x6c\x7a\x37\xe2\x6d\x7a\x43\xe2\x6e\x7a\x57\xe2\x6f\x7a\x49\xe2\x70\x7a\x61\xe2\x71\x7a\x62\xe2\x72\x7a\x69\xe2\x73\x9f\x9d\xe2\x74\x7a\x70\xe2\x75\x7a\x79\xe2\x76\x7a\x7d\xe2\x77\x7a\x88\xe2\x78\x7a\x97\xe2\x79\x7a\x95\xe2\x7a\x7a\x98\xe2\x7b\x7a\x96\xe2\x7c\x7a\xa9\xe2\x7d\x7a\xc8\xe2\x7e\x7a\xb0\xe2\x80\x7a\xb6\xe2\x81\x7a\xc5\xe2\x82\x7a\xc4\xe2\x83\x7a\xbf\xe2\x84\x90\x83\xe2\x85\x7a\xc7\xe2\x86\x7a\xca\xe2\x87\x7a\xcd\xe2\x88\x7a\xcf\xe2\x89\x7a\xd5\xe2\x8a\x7a\xd3\xe2\x8b\x7a\xd9\xe2\x8c\x7a\xda\xe2\x8d\x7a\xdd\xe2\x8e\x7a\xe1\xe2\x8f\x7a\xe2\xe2\x90\x7a\xe6\xe2\x91\x7a\xed\xe2\x92\x7a\xf0\xe2\x93\x7b\x02\xe2\x94\x7b\x0f\xe2\x95\x7b\x0a\xe2\x96\x7b\x06\xe2\x97\x7b\x33\xe2\x98\x7b\x18\xe2\x99\x7b\x19\xe2\x9a\x7b\x1e\xe2\x9b\x7b\x35\xe2\x9c\x7b\x28\xe2\x9d\x7b\x36\xe2\x9e\x7b\x50\xe2\x9f\x7b\x7a\xe2\xa0\x7b\x04\xe2\xa1\x7b\x4d\xe2\xa2\x7b\x0b\xe2\xa3\x7b\x4c\xe2\xa4\x7b\x45\xe2\xa5\x7b\x75\xe2\xa6\x7b\x65\xe2\xa7\x7b\x74\xe2\xa8\x7b\x67\xe2\xa9\x7b\x70\xe2\xaa\x7b\x71\xe2\xab\x7b\x6c\xe2\xac\x7b\x6e\xe2\xad\x7b\x9d\xe2\xae\x7b\x98\xe2\xaf\x7b\x9f\xe2\xb0\x7b\x8d\xe2\xb1\x7b\x9c\xe2\xb2\x7b\x9a\xe2\xb3\x7b\x8b\xe2\xb4\x7b\x92\xe2\xb5\x7b\x8f\xe2\xb6\x7b\x5d\xe2\xb7\x7b\x99\xe2\xb8\x7b\xcb\xe2\xb9\x7b\xc1\xe2\xba\x7b\xcc\xe2\xbb\x7b\xcf\xe2\xbc\x7b\xb4\xe2\xbd\x7b\xc6\xe2\xbe\x7b\xdd\xe2\xbf\x7b\xe9\xe2\xc0\x7c\x11\xe2\xc1\x7c\x14\xe2\xc2\x7b\xe6\xe2\xc3\x7b\xe5\xe2\xc4\x7c\x60\xe2\xc5\x7c\x00\xe2\xc6\x7c\x07\xe2\xc7\x7c\x13\xe2\xc8\x7b\xf3\xe2\xc9\x7b\xf7\xe2\xca\x7c\x17\xe2\xcb\x7c\x0d\xe2\xcc\x7b\xf6\xe2\xcd\x7c\x23\xe2\xce\x7c\x27\xe2\xcf\x7c\x2a\xe2\xd0\x7c\x1f\xe2\xd1\x7c\x37\xe2\xd2\x7c\x2b\xe2\xd3\x7c\x3d\xe2\xd4\x7c\x4c\xe2\xd5\x7c\x43\xe2\xd6\x7c\x54\xe2\xd7\x7c\x4f\xe2\xd8\x7c\x40\xe2\xd9\x7c\x50\xe2\xda\x7c\x58\xe2\xdb\x7c\x5f\xe2\xdc\x7c\x64\xe2\xdd\x7c\x56\xe2\xde\x7c\x65\xe2\xdf\x7c\x6c\xe2\xe0\x7c\x75\xe2\xe1\x7c\x83\xe2\xe2\x7c\x90\xe2\xe3\x7c\xa4\xe2\xe4\x7c\xad\xe2\xe5\x7c\xa2\xe2\xe6\x7c\xab\xe2\xe7\x7c\xa1\xe2\xe8\x7c\xa8\xe2\xe9\x7c\xb3\xe2\xea\x7c\xb2\xe2\xeb\x7c\xb1\xe2\xec\x7c\xae\xe2\xed\x7c\xb9\xe2\xee\x7c\xbd\xe2\xef\x7c\xc0\xe2\xf0\x7c\xc5\xe2\xf1\x7c\xc2\xe2\xf2\x7c\xd8\xe2\xf3\x7c\xd2\xe2\xf4\x7c\xdc\xe2\xf5\x7c\xe2\xe2\xf6\x9b\x3b\xe2\xf7\x7c\xef\xe2\xf8\x7c\xf2\xe2\xf9\x7c\xf4\xe2\xfa\x7c\xf6\xe2\xfb\x7c\xfa\xe2\xfc\x7d\x06\xe3\x40\x7d\x02\xe3\x41\x7d\x1c\xe3\x42\x7d\x15\xe3\x43\x7d\x0a\xe3\x44\x7d\x45\xe3\x45\x7d\x4b\xe3\x46\x7d\x2e\xe3\x47\x7d\x32\xe3\x48\x7d\x3f\xe3\x49\x7d\x35\xe3\x4a\x7d\x46\xe3\x4b\x7d\x73\xe3\x4c\x7d\x56\xe3\x4d\x7d\x4e\xe3\x4e\x7d\x72\xe3\x4f\x7d\x68\xe3\x50\x7d\x6e\xe3\x51\x7d\x4f\xe3\x52\x7d\x63\xe3\x53\x7d\x93\xe3\x54\x7d\x89\xe3\x55\x7d\x5b\xe3\x56\x7d\x8f\xe3\x57\x7d\x7d\xe3\x58\x7d\x9b\xe3\x59\x7d\xba\xe3\x5a\x7d\xae\xe3\x5b\x7d\xa3\xe3\x5c\x7d\xb5\xe3\x5d\x7d\xc7\xe3\x5e\x7d\xbd\xe3\x5f\x7d\xab\xe3\x60\x7e\x3d\xe3\x61\x7d\xa2\xe3\x62\x7d\xaf\xe3\x63\x7d\xdc\xe3\x64\x7d\xb8\xe3\x65\x7d\x9f\xe3\x66\x7d\xb0\xe3\x67\x7d\xd8\xe3\x68\x7d\xdd\xe3\x69\x7d\xe4\xe3\x6a\x7d\xde\xe3\x6b\x7d\xfb\xe3\x6c\x7d\xf2\xe3\x6d\x7d\xe1\xe3\x6e\x7e\x05\xe3\x6f\x7e\x0a\xe3\x70\x7e\x23\xe3\x71\x7e\x21\xe3\x72\x7e\x12\xe3\x73\x7e\x31\xe3\x74\x7e\x1f\xe3\x75\x7e\x09\xe3\x76\x7e\x0b\xe3\x77\x7e\x22\xe3\x78\x7e\x46\xe3\x79\x7e\x66\xe3\x7a\x7e\x3b\xe3\x7b\x7e\x35\xe3\x7c\x7e\x39\xe3\x7d\x7e\x43\xe3\x7e\x7e\x37\xe3\x80\x7e\x32\xe3\x81\x7e\x3a\xe3\x82\x7e\x67\xe3\x83\x7e\x5d\xe3\x84\x7e\x56\xe3\x85\x7e\x5e\xe3\x86\x7e\x59\xe3\x87\x7e\x5a\xe3\x88\x7e\x79\xe3\x89\x7e\x6a\xe3\x8a\x7e\x69\xe3\x8b\x7e\x7c\xe3\x8c\x7e\x7b\xe3\x8d\x7e\x83\xe3\x8e\x7d\xd5\xe3\x8f\x7e\x7d\xe3\x90\x8f\xae\xe3\x91\x7e\x7f\xe3\x92\x7e\x88\xe3\x93\x7e\x89\xe3\x94\x7e\x8c\xe3\x95\x7e\x92\xe3\x96\x7e\x90\xe3\x97\x7e\x93\xe3\x98\x7e\x94\xe3\x99\x7e\x96\xe3\x9a\x7e\x8e\xe3\x9b\x7e\x9b\xe3\x9c\x7e\x9c\xe3\x9d\x7f\x38\xe3\x9e\x7f\x3a\xe3\x9f\x7f\x45\xe3\xa0\x7f\x4c\xe3\xa1\x7f\x4d\xe3\xa2\x7f\x4e\xe3\xa3\x7f\x50\xe3\xa4\x7f\x51\xe3\xa5\x7f\x55\xe3\xa6\x7f\x54\xe3\xa7\x7f\x58\xe3\xa8\x7f\x5f\xe3\xa9\x7f\x60\xe3\xaa\x7f\x68\xe3\xab\x7f\x69\xe3\xac\x7f\x67\xe3\xad\x7f\x78\xe3\xae\x7f\x82\xe3\xaf\x7f\x86\xe3\xb0\x7f\x83\xe3\xb1\x7f\x88\xe3\xb2\x7f\x87\xe3\xb3\x7f\x8c\xe3\xb4\x7f\x94\xe3\xb5\x7f\x9e\xe3\xb6\x7f\x9d\xe3\xb7\x7f\x9a\xe3\xb8\x7f\xa3\xe3\xb9\x7f\xaf\xe3\xba\x7f\xb2\xe3\xbb\x7f\xb9\xe3\xbc\x7f\xae\xe3\xbd\x7f\xb6\xe3\xbe\x7f\xb8\xe3\xbf\x8b\x71\xe3\xc0\x7f\xc5\xe3\xc1\x7f\xc6\xe3\xc2\x7f\xca\xe3\xc3\x7f\xd5\xe3\xc4\x7f\xd4\xe3\xc5\x7f\xe1\xe3\xc6\x7f\xe6\xe3\xc7\x7f\xe9\xe3\xc8\x7f\xf3\xe3\xc9\x7f\xf9\xe3\xca\x98\xdc\xe3\xcb\x80\x06\xe3\xcc\x80\x04\xe3\xcd\x80\x0b\xe3\xce\x80\x12\xe3\xcf\x80\x18\xe3\xd0\x80\x19\xe3\xd1\x80\x1c\xe3\xd2\x80\x21\xe3\xd3\x80\x28\xe3\xd4\x80\x3f\xe3\xd5\x80\x3b\xe3\xd6\x80\x4a\xe3\xd7\x80\x46\xe3\xd8\x80\x52\xe3\xd9\x80\x58\xe3\xda\x80\x5a\xe3\xdb\x80\x5f\xe3\xdc\x80\x62\xe3\xdd\x80\x68\xe3\xde\x80\x73\xe3\xdf\x80\x72\xe3\xe0\x80\x70\xe3\xe1\x80\x76\xe3\xe2\x80\x79\xe3\xe3\x80\x7d\xe3\xe4\x80\x7f\xe3\xe5\x80\x84\xe3\xe6\x80\x86\xe3\xe7\x80\x85\xe3\xe8\x80\x9b\xe3\xe9\x80\x93\xe3\xea\x80\x9a\xe3\xeb\x80\xad\xe3\xec\x51\x90\xe3\xed\x80\xac\xe3\xee\x80\xdb\xe3\xef\x80\xe5\xe3\xf0\x80\xd9\xe3\xf1\x80\xdd\xe3\xf2\x80\xc4\xe3\xf3\x80\xda\xe3\xf4\x80\xd6\xe3\xf5\x81\x09\xe3\xf6\x80\xef\xe3\xf7\x80\xf1\xe3\xf8\x81\x1b\xe3\xf9\x81\x29\xe3\xfa\x81\x23\xe3\xfb\x81\x2f\xe3\xfc\x81\x4b\xe4\x40\x96\x8b\xe4\x41\x81\x46\xe4\x42\x81\x3e\xe4\x43\x81\x53\xe4\x44\x81\x51\xe4\x45\x80\xfc\xe4\x46\x81\x71\xe4\x47\x81\x6e\xe4\x48\x81\x65\xe4\x49\x81\x66\xe4\x4a\x81\x74\xe4\x4b\x81\x83\xe4\x4c\x81\x88\xe4\x4d\x81\x8a\xe4\x4e\x81\x80\xe4\x4f\x81\x82\xe4\x50\x81\xa0\xe4\x51\x81\x95\xe4\x52\x81\xa4\xe4\x53\x81\xa3\xe4\x54\x81\x5f\xe4\x55\x81\x93\xe4\x56\x81\xa9\xe4\x57\x81\xb0\xe4\x58\x81\xb5\xe4\x59\x81\xbe\xe4\x5a\x81\xb8\xe4\x5b\x81\xbd\xe4\x5c\x81\xc0\xe4\x5d\x81\xc2\xe4\x5e\x81\xba\xe4\x5f\x81\xc9\xe4\x60\x81\xcd\xe4\x61\x81\xd1\xe4\x62\x81\xd9\xe4\x63\x81\xd8\xe4\x64\x81\xc8\xe4\x65\x81\xda\xe4\x66\x81\xdf\xe4\x67\x81\xe0\xe4\x68\x81\xe7\xe4\x69\x81\xfa\xe4\x6a\x81\xfb\xe4\x6b\x81\xfe\xe4\x6c\x82\x01\xe4\x6d\x82\x02\xe4\x6e\x82\x05\xe4\x6f\x82\x07\xe4\x70\x82\x0a\xe4\x71\x82\x0d\xe4\x72\x82\x10\xe4\x73\x82\x16\xe4\x74\x82\x29\xe4\x75\x82\x2b\xe4\x76\x82\x38\xe4\x77\x82\x33\xe4\x78\x82\x40\xe4\x79\x82\x59\xe4\x7a\x82\x58\xe4\x7b\x82\x5d\xe4\x7c\x82\x5a\xe4\x7d\x82\x5f\xe4\x7e\x82\x64\xe4\x80\x82\x62\xe4\x81\x82\x68\xe4\x82\x82\x6a\xe4\x83\x82\x6b\xe4\x84\x82\x2e\xe4\x85\x82\x71\xe4\x86\x82\x77\xe4\x87\x82\x78\xe4\x88\x82\x7e\xe4\x89\x82\x8d\xe4\x8a\x82\x92\xe4\x8b\x82\xab\xe4\x8c\x82\x9f\xe4\x8d\x82\xbb\xe4\x8e\x82\xac\xe4\x8f\x82\xe1\xe4\x90\x82\xe3\xe4\x91\x82\xdf\xe4\x92\x82\xd2\xe4\x93\x82\xf4\xe4\x94\x82\xf3\xe4\x95\x82\xfa\xe4\x96\x83\x93\xe4\x97\x83\x03\xe4\x98\x82\xfb\xe4\x99\x82\xf9\xe4\x9a\x82\xde\xe4\x9b\x83\x06\xe4\x9c\x82\xdc\xe4\x9d\x83\x09\xe4\x9e\x82\xd9\xe4\x9f\x83\x35\xe4\xa0\x83\x34\xe4\xa1\x83\x16\xe4\xa2\x83\x32\xe4\xa3\x83\x31\xe4\xa4\x83\x40\xe4\xa5\x83\x39\xe4\xa6\x83\x50\xe4\xa7\x83\x45\xe4\xa8\x83\x2f\xe4\xa9\x83\x2b\xe4\xaa\x83\x17\xe4\xab\x83\x18\xe4\xac\x83\x85\xe4\xad\x83\x9a\xe4\xae\x83\xaa\xe4\xaf\x83\x9f\xe4\xb0\x83\xa2\xe4\xb1\x83\x96\xe4\xb2\x83\x23\xe4\xb3\x83\x8e\xe4\xb4\x83\x87\xe4\xb5\x83\x8a\xe4\xb6\x83\x7c\xe4\xb7\x83\xb5\xe4\xb8\x83\x73\xe4\xb9\x83\x75\xe4\xba\x83\xa0\xe4\xbb\x83\x89\xe4\xbc\x83\xa8\xe4\xbd\x83\xf4\xe4\xbe\x84\x13\xe4\xbf\x83\xeb\xe4\xc0\x83\xce\xe4\xc1\x83\xfd\xe4\xc2\x84\x03\xe4\xc3\x83\xd8\xe4\xc4\x84\x0b\xe4\xc5\x83\xc1\xe4\xc6\x83\xf7\xe4\xc7\x84\x07\xe4\xc8\x83\xe0\xe4\xc9\x83\xf2\xe4\xca\x84\x0d\xe4\xcb\x84\x22\xe4\xcc\x84\x20\xe4\xcd\x83\xbd\xe4\xce\x84\x38\xe4\xcf\x85\x06\xe4\xd0\x83\xfb\xe4\xd1\x84\x6d\xe4\xd2\x84\x2a\xe4\xd3\x84\x3c\xe4\xd4\x85\x5a\xe4\xd5\x84\x84\xe4\xd6\x84\x77\xe4\xd7\x84\x6b\xe4\xd8\x84\xad\xe4\xd9\x84\x6e\xe4\xda\x84\x82\xe4\xdb\x84\x69\xe4\xdc\x84\x46\xe4\xdd\x84\x2c\xe4\xde\x84\x6f\xe4\xdf\x84\x79\xe4\xe0\x84\x35\xe4\xe1\x84\xca\xe4\xe2\x84\x62\xe4\xe3\x84\xb9\xe4\xe4\x84\xbf\xe4\xe5\x84\x9f\xe4\xe6\x84\xd9\xe4\xe7\x84\xcd\xe4\xe8\x84\xbb\xe4\xe9\x84\xda\xe4\xea\x84\xd0\xe4\xeb\x84\xc1\xe4\xec\x84\xc6\xe4\xed\x84\xd6\xe4\xee\x84\xa1\xe4\xef\x85\x21\xe4\xf0\x84\xff\xe4\xf1\x84\xf4\xe4\xf2\x85\x17\xe4\xf3\x85\x18\xe4\xf4\x85\x2c\xe4\xf5\x85\x1f\xe4\xf6\x85\x15\xe4\xf7\x85\x14\xe4\xf8\x84\xfc\xe4\xf9\x85\x40\xe4\xfa\x85\x63\xe4\xfb\x85\x58\xe4\xfc\x85\x48\xe5\x40\x85\x41\xe5\x41\x86\x02\xe5\x42\x85\x4b\xe5\x43\x85\x55\xe5\x44\x85\x80\xe5\x45\x85\xa4\xe5\x46\x85\x88\xe5\x47\x85\x91\xe5\x48\x85\x8a\xe5\x49\x85\xa8\xe5\x4a\x85\x6d\xe5\x4b\x85\x94\xe5\x4c\x85\x9b\xe5\x4d\x85\xea\xe5\x4e\x85\x87\xe5\x4f\x85\x9c\xe5\x50\x85\x77\xe5\x51\x85\x7e\xe5\x52\x85\x90\xe5\x53\x85\xc9\xe5\x54\x85\xba\xe5\x55\x85\xcf\xe5\x56\x85\xb9\xe5\x57\x85\xd0\xe5\x58\x85\xd5\xe5\x59\x85\xdd\xe5\x5a\x85\xe5\xe5\x5b\x85\xdc\xe5\x5c\x85\xf9\xe5\x5d\x86\x0a\xe5\x5e\x86\x13\xe5\x5f\x86\x0b\xe5\x60\x85\xfe\xe5\x61\x85\xfa\xe5\x62\x86\x06\xe5\x63\x86\x22\xe5\x64\x86\x1a\xe5\x65\x86\x30\xe5\x66\x86\x3f\xe5\x67\x86\x4d\xe5\x68\x4e\x55\xe5\x69\x86\x54\xe5\x6a\x86\x5f\xe5\x6b\x86\x67\xe5\x6c\x86\x71\xe5\x6d\x86\x93\xe5\x6e\x86\xa3\xe5\x6f\x86\xa9\xe5\x70\x86\xaa\xe5\x71\x86\x8b\xe5\x72\x86\x8c\xe5\x73\x86\xb6\xe5\x74\x86\xaf\xe5\x75\x86\xc4\xe5\x76\x86\xc6\xe5\x77\x86\xb0\xe5\x78\x86\xc9\xe5\x79\x88\x23\xe5\x7a\x86\xab\xe5\x7b\x86\xd4\xe5\x7c\x86\xde\xe5\x7d\x86\xe9\xe5\x7e\x86\xec\xe5\x80\x86\xdf\xe5\x81\x86\xdb\xe5\x82\x86\xef\xe5\x83\x87\x12\xe5\x84\x87\x06\xe5\x85\x87\x08\xe5\x86\x87\x00\xe5\x87\x87\x03\xe5\x88\x86\xfb\xe5\x89\x87\x11\xe5\x8a\x87\x09\xe5\x8b\x87\x0d\xe5\x8c\x86\xf9\xe5\x8d\x87\x0a\xe5\x8e\x87\x34\xe5\x8f\x87\x3f\xe5\x90\x87\x37\xe5\x91\x87\x3b\xe5\x92\x87\x25\xe5\x93\x87\x29\xe5\x94\x87\x1a\xe5\x95\x87\x60\xe5\x96\x87\x5f\xe5\x97\x87\x78\xe5\x98\x87\x4c\xe5\x99\x87\x4e\xe5\x9a\x87\x74\xe5\x9b\x87\x57\xe5\x9c\x87\x68\xe5\x9d\x87\x6e\xe5\x9e\x87\x59\xe5\x9f\x87\x53\xe5\xa0\x87\x63\xe5\xa1\x87\x6a\xe5\xa2\x88\x05\xe5\xa3\x87\xa2\xe5\xa4\x87\x9f\xe5\xa5\x87\x82\xe5\xa6\x87\xaf\xe5\xa7\x87\xcb\xe5\xa8\x87\xbd\xe5\xa9\x87\xc0\xe5\xaa\x87\xd0\xe5\xab\x96\xd6\xe5\xac\x87\xab\xe5\xad\x87\xc4\xe5\xae\x87\xb3\xe5\xaf\x87\xc7\xe5\xb0\x87\xc6\xe5\xb1\x87\xbb\xe5\xb2\x87\xef\xe5\xb3\x87\xf2\xe5\xb4\x87\xe0\xe5\xb5\x88\x0f\xe5\xb6\x88\x0d\xe5\xb7\x87\xfe\xe5\xb8\x87\xf6\xe5\xb9\x87\xf7\xe5\xba\x88\x0e\xe5\xbb\x87\xd2\xe5\xbc\x88\x11\xe5\xbd\x88\x16\xe5\xbe\x88\x15\xe5\xbf\x88\x22\xe5\xc0\x88\x21\xe5\xc1\x88\x31\xe5\xc2\x88\x36\xe5\xc3\x88\x39\xe5\xc4\x88\x27\xe5\xc5\x88\x3b\xe5\xc6\x88\x44\xe5\xc7\x88\x42\xe5\xc8\x88\x52\xe5\xc9\x88\x59\xe5\xca\x88\x5e\xe5\xcb\x88\x62\xe5\xcc\x88\x6b\xe5\xcd\x88\x81\xe5\xce\x88\x7e\xe5\xcf\x88\x9e\xe5\xd0\x88\x75\xe5\xd1\x88\x7d\xe5\xd2\x88\xb5\xe5\xd3\x88\x72\xe5\xd4\x88\x82\xe5\xd5\x88\x97\xe5\xd6\x88\x92\xe5\xd7\x88\xae\xe5\xd8\x88\x99\xe5\xd9\x88\xa2\xe5\xda\x88\x8d\xe5\xdb\x88\xa4\xe5\xdc\x88\xb0\xe5\xdd\x88\xbf\xe5\xde\x88\xb1\xe5\xdf\x88\xc3\xe5\xe0\x88\xc4\xe5\xe1\x88\xd4\xe5\xe2\x88\xd8\xe5\xe3\x88\xd9\xe5\xe4\x88\xdd\xe5\xe5\x88\xf9\xe5\xe6\x89\x02\xe5\xe7\x88\xfc\xe5\xe8\x88\xf4\xe5\xe9\x88\xe8\xe5\xea\x88\xf2\xe5\xeb\x89\x04\xe5\xec\x89\x0c\xe5\xed\x89\x0a\xe5\xee\x89\x13\xe5\xef\x89\x43\xe5\xf0\x89\x1e\xe5\xf1\x89\x25\xe5\xf2\x89\x2a\xe5\xf3\x89\x2b\xe5\xf4\x89\x41\xe5\xf5\x89\x44\xe5\xf6\x89\x3b\xe5\xf7\x89\x36\xe5\xf8\x89\x38\xe5\xf9\x89\x4c\xe5\xfa\x89\x1d\xe5\xfb\x89\x60\xe5\xfc\x89\x5e\xe6\x40\x89\x66\xe6\x41\x89\x64\xe6\x42\x89\x6d\xe6\x43\x89\x6a\xe6\x44\x89\x6f\xe6\x45\x89\x74\xe6\x46\x89\x77\xe6\x47\x89\x7e\xe6\x48\x89\x83\xe6\x49\x89\x88\xe6\x4a\x89\x8a\xe6\x4b\x89\x93\xe6\x4c\x89\x98\xe6\x4d\x89\xa1\xe6\x4e\x89\xa9\xe6\x4f\x89\xa6\xe6\x50\x89\xac\xe6\x51\x89\xaf\xe6\x52\x89\xb2\xe6\x53\x89\xba\xe6\x54\x89\xbd\xe6\x55\x89\xbf\xe6\x56\x89\xc0\xe6\x57\x89\xda\xe6\x58\x89\xdc\xe6\x59\x89\xdd\xe6\x5a\x89\xe7\xe6\x5b\x89\xf4\xe6\x5c\x89\xf8\xe6\x5d\x8a\x03\xe6\x5e\x8a\x16\xe6\x5f\x8a\x10\xe6\x60\x8a\x0c\xe6\x61\x8a\x1b\xe6\x62\x8a\x1d\xe6\x63\x8a\x25\xe6\x64\x8a\x36\xe6\x65\x8a\x41\xe6\x66\x8a\x5b\xe6\x67\x8a\x52\xe6\x68\x8a\x46\xe6\x69\x8a\x48\xe6\x6a\x8a\x7c\xe6\x6b\x8a\x6d\xe6\x6c\x8a\x6c\xe6\x6d\x8a\x62\xe6\x6e\x8a\x85\xe6\x6f\x8a\x82\xe6\x70\x8a\x84\xe6\x71\x8a\xa8\xe6\x72\x8a\xa1\xe6\x73\x8a\x91\xe6\x74\x8a\xa5\xe6\x75\x8a\xa6\xe6\x76\x8a\x9a\xe6\x77\x8a\xa3\xe6\x78\x8a\xc4\xe6\x79\x8a\xcd\xe6\x7a\x8a\xc2\xe6\x7b\x8a\xda\xe6\x7c\x8a\xeb\xe6\x7d\x8a\xf3\xe6\x7e\x8a\xe7\xe6\x80\x8a\xe4\xe6\x81\x8a\xf1\xe6\x82\x8b\x14\xe6\x83\x8a\xe0\xe6\x84\x8a\xe2\xe6\x85\x8a\xf7\xe6\x86\x8a\xde\xe6\x87\x8a\xdb\xe6\x88\x8b\x0c\xe6\x89\x8b\x07\xe6\x8a\x8b\x1a\xe6\x8b\x8a\xe1\xe6\x8c\x8b\x16\xe6\x8d\x8b\x10\xe6\x8e\x8b\x17\xe6\x8f\x8b\x20\xe6\x90\x8b\x33\xe6\x91\x97\xab\xe6\x92\x8b\x26\xe6\x93\x8b\x2b\xe6\x94\x8b\x3e\xe6\x95\x8b\x28\xe6\x96\x8b\x41\xe6\x97\x8b\x4c\xe6\x98\x8b\x4f\xe6\x99\x8b\x4e\xe6\x9a\x8b\x49\xe6\x9b\x8b\x56\xe6\x9c\x8b\x5b\xe6\x9d\x8b\x5a\xe6\x9e\x8b\x6b\xe6\x9f\x8b\x5f\xe6\xa0\x8b\x6c\xe6\xa1\x8b\x6f\xe6\xa2\x8b\x74\xe6\xa3\x8b\x7d\xe6\xa4\x8b\x80\xe6\xa5\x8b\x8c\xe6\xa6\x8b\x8e\xe6\xa7\x8b\x92\xe6\xa8\x8b\x93\xe6\xa9\x8b\x96\xe6\xaa\x8b\x99\xe6\xab\x8b\x9a\xe6\xac\x8c\x3a\xe6\xad\x8c\x41\xe6\xae\x8c\x3f\xe6\xaf\x8c\x48\xe6\xb0\x8c\x4c\xe6\xb1\x8c\x4e\xe6\xb2\x8c\x50\xe6\xb3\x8c\x55\xe6\xb4\x8c\x62\xe6\xb5\x8c\x6c\xe6\xb6\x8c\x78\xe6\xb7\x8c\x7a\xe6\xb8\x8c\x82\xe6\xb9\x8c\x89\xe6\xba\x8c\x85\xe6\xbb\x8c\x8a\xe6\xbc\x8c\x8d\xe6\xbd\x8c\x8e\xe6\xbe\x8c\x94\xe6\xbf\x8c\x7c\xe6\xc0\x8c\x98\xe6\xc1\x62\x1d\xe6\xc2\x8c\xad\xe6\xc3\x8c\xaa\xe6\xc4\x8c\xbd\xe6\xc5\x8c\xb2\xe6\xc6\x8c\xb3\xe6\xc7\x8c\xae\xe6\xc8\x8c\xb6\xe6\xc9\x8c\xc8\xe6\xca\x8c\xc1\xe6\xcb\x8c\xe4\xe6\xcc\x8c\xe3\xe6\xcd\x8c\xda\xe6\xce\x8c\xfd\xe6\xcf\x8c\xfa\xe6\xd0\x8c\xfb\xe6\xd1\x8d\x04\xe6\xd2\x8d\x05\xe6\xd3\x8d\x0a\xe6\xd4\x8d\x07\xe6\xd5\x8d\x0f\xe6\xd6\x8d\x0d\xe6\xd7\x8d\x10\xe6\xd8\x9f\x4e\xe6\xd9\x8d\x13\xe6\xda\x8c\xcd\xe6\xdb\x8d\x14\xe6\xdc\x8d\x16\xe6\xdd\x8d\x67\xe6\xde\x8d\x6d\xe6\xdf\x8d\x71\xe6\xe0\x8d\x73\xe6\xe1\x8d\x81\xe6\xe2\x8d\x99\xe6\xe3\x8d\xc2\xe6\xe4\x8d\xbe\xe6\xe5\x8d\xba\xe6\xe6\x8d\xcf\xe6\xe7\x8d\xda\xe6\xe8\x8d\xd6\xe6\xe9\x8d\xcc\xe6\xea\x8d\xdb\xe6\xeb\x8d\xcb\xe6\xec\x8d\xea\xe6\xed\x8d\xeb\xe6\xee\x8d\xdf\xe6\xef\x8d\xe3\xe6\xf0\x8d\xfc\xe6\xf1\x8e\x08\xe6\xf2\x8e\x09\xe6\xf3\x8d\xff\xe6\xf4\x8e\x1d\xe6\xf5\x8e\x1e\xe6\xf6\x8e\x10\xe6\xf7\x8e\x1f\xe6\xf8\x8e\x42\xe6\xf9\x8e\x35\xe6\xfa\x8e\x30\xe6\xfb\x8e\x34\xe6\xfc\x8e\x4a\xe7\x40\x8e\x47\xe7\x41\x8e\x49\xe7\x42\x8e\x4c\xe7\x43\x8e\x50\xe7\x44\x8e\x48\xe7\x45\x8e\x59\xe7\x46\x8e\x64\xe7\x47\x8e\x60\xe7\x48\x8e\x2a\xe7\x49\x8e\x63\xe7\x4a\x8e\x55\xe7\x4b\x8e\x76\xe7\x4c\x8e\x72\xe7\x4d\x8e\x7c\xe7\x4e\x8e\x81\xe7\x4f\x8e\x87\xe7\x50\x8e\x85\xe7\x51\x8e\x84\xe7\x52\x8e\x8b\xe7\x53\x8e\x8a\xe7\x54\x8e\x93\xe7\x55\x8e\x91\xe7\x56\x8e\x94\xe7\x57\x8e\x99\xe7\x58\x8e\xaa\xe7\x59\x8e\xa1\xe7\x5a\x8e\xac\xe7\x5b\x8e\xb0\xe7\x5c\x8e\xc6\xe7\x5d\x8e\xb1\xe7\x5e\x8e\xbe\xe7\x5f\x8e\xc5\xe7\x60\x8e\xc8\xe7\x61\x8e\xcb\xe7\x62\x8e\xdb\xe7\x63\x8e\xe3\xe7\x64\x8e\xfc\xe7\x65\x8e\xfb\xe7\x66\x8e\xeb\xe7\x67\x8e\xfe\xe7\x68\x8f\x0a\xe7\x69\x8f\x05\xe7\x6a\x8f\x15\xe7\x6b\x8f\x12\xe7\x6c\x8f\x19\xe7\x6d\x8f\x13\xe7\x6e\x8f\x1c\xe7\x6f\x8f\x1f\xe7\x70\x8f\x1b\xe7\x71\x8f\x0c\xe7\x72\x8f\x26\xe7\x73\x8f\x33\xe7\x74\x8f\x3b\xe7\x75\x8f\x39\xe7\x76\x8f\x45\xe7\x77\x8f\x42\xe7\x78\x8f\x3e\xe7\x79\x8f\x4c\xe7\x7a\x8f\x49\xe7\x7b\x8f\x46\xe7\x7c\x8f\x4e\xe7\x7d\x8f\x57\xe7\x7e\x8f\x5c\xe7\x80\x8f\x62\xe7\x81\x8f\x63\xe7\x82\x8f\x64\xe7\x83\x8f\x9c\xe7\x84\x8f\x9f\xe7\x85\x8f\xa3\xe7\x86\x8f\xad\xe7\x87\x8f\xaf\xe7\x88\x8f\xb7\xe7\x89\x8f\xda\xe7\x8a\x8f\xe5\xe7\x8b\x8f\xe2\xe7\x8c\x8f\xea\xe7\x8d\x8f\xef\xe7\x8e\x90\x87\xe7\x8f\x8f\xf4\xe7\x90\x90\x05\xe7\x91\x8f\xf9\xe7\x92\x8f\xfa\xe7\x93\x90\x11\xe7\x94\x90\x15\xe7\x95\x90\x21\xe7\x96\x90\x0d\xe7\x97\x90\x1e\xe7\x98\x90\x16\xe7\x99\x90\x0b\xe7\x9a\x90\x27\xe7\x9b\x90\x36\xe7\x9c\x90\x35\xe7\x9d\x90\x39\xe7\x9e\x8f\xf8\xe7\x9f\x90\x4f\xe7\xa0\x90\x50\xe7\xa1\x90\x51\xe7\xa2\x90\x52\xe7\xa3\x90\x0e\xe7\xa4\x90\x49\xe7\xa5\x90\x3e\xe7\xa6\x90\x56\xe7\xa7\x90\x58\xe7\xa8\x90\x5e\xe7\xa9\x90\x68\xe7\xaa\x90\x6f\xe7\xab\x90\x76\xe7\xac\x96\xa8\xe7\xad\x90\x72\xe7\xae\x90\x82\xe7\xaf\x90\x7d\xe7\xb0\x90\x81\xe7\xb1\x90\x80\xe7\xb2\x90\x8a\xe7\xb3\x90\x89\xe7\xb4\x90\x8f\xe7\xb5\x90\xa8\xe7\xb6\x90\xaf\xe7\xb7\x90\xb1\xe7\xb8\x90\xb5\xe7\xb9\x90\xe2\xe7\xba\x90\xe4\xe7\xbb\x62\x48\xe7\xbc\x90\xdb\xe7\xbd\x91\x02\xe7\xbe\x91\x12\xe7\xbf\x91\x19\xe7\xc0\x91\x32\xe7\xc1\x91\x30\xe7\xc2\x91\x4a\xe7\xc3\x91\x56\xe7\xc4\x91\x58\xe7\xc5\x91\x63\xe7\xc6\x91\x65\xe7\xc7\x91\x69\xe7\xc8\x91\x73\xe7\xc9\x91\x72\xe7\xca\x91\x8b\xe7\xcb\x91\x89\xe7\xcc\x91\x82\xe7\xcd\x91\xa2\xe7\xce\x91\xab\xe7\xcf\x91\xaf\xe7\xd0\x91\xaa\xe7\xd1\x91\xb5\xe7\xd2\x91\xb4\xe7\xd3\x91\xba\xe7\xd4\x91\xc0\xe7\xd5\x91\xc1\xe7\xd6\x91\xc9\xe7\xd7\x91\xcb\xe7\xd8\x91\xd0\xe7\xd9\x91\xd6\xe7\xda\x91\xdf\xe7\xdb\x91\xe1\xe7\xdc\x91\xdb\xe7\xdd\x91\xfc\xe7\xde\x91\xf5\xe7\xdf\x91\xf6\xe7\xe0\x92\x1e\xe7\xe1\x91\xff\xe7\xe2\x92\x14\xe7\xe3\x92\x2c\xe7\xe4\x92\x15\xe7\xe5\x92\x11\xe7\xe6\x92\x5e\xe7\xe7\x92\x57\xe7\xe8\x92\x45\xe7\xe9\x92\x49\xe7\xea\x92\x64\xe7\xeb\x92\x48\xe7\xec\x92\x95\xe7\xed\x92\x3f\xe7\xee\x92\x4b\xe7\xef\x92\x50\xe7\xf0\x92\x9c\xe7\xf1\x92\x96\xe7\xf2\x92\x93\xe7\xf3\x92\x9b\xe7\xf4\x92\x5a\xe7\xf5\x92\xcf\xe7\xf6\x92\xb9\xe7\xf7\x92\xb7\xe7\xf8\x92\xe9\xe7\xf9\x93\x0f\xe7\xfa\x92\xfa\xe7\xfb\x93\x44\xe7\xfc\x93\x2e\xe8\x40\x93\x19\xe8\x41\x93\x22\xe8\x42\x93\x1a\xe8\x43\x93\x23\xe8\x44\x93\x3a\xe8\x45\x93\x35\xe8\x46\x93\x3b\xe8\x47\x93\x5c\xe8\x48\x93\x60\xe8\x49\x93\x7c\xe8\x4a\x93\x6e\xe8\x4b\x93\x56\xe8\x4c\x93\xb0\xe8\x4d\x93\xac\xe8\x4e\x93\xad\xe8\x4f\x93\x94\xe8\x50\x93\xb9\xe8\x51\x93\xd6\xe8\x52\x93\xd7\xe8\x53\x93\xe8\xe8\x54\x93\xe5\xe8\x55\x93\xd8\xe8\x56\x93\xc3\xe8\x57\x93\xdd\xe8\x58\x93\xd0\xe8\x59\x93\xc8\xe8\x5a\x93\xe4\xe8\x5b\x94\x1a\xe8\x5c\x94\x14\xe8\x5d\x94\x13\xe8\x5e\x94\x03\xe8\x5f\x94\x07\xe8\x60\x94\x10\xe8\x61\x94\x36\xe8\x62\x94\x2b\xe8\x63\x94\x35\xe8\x64\x94\x21\xe8\x65\x94\x3a\xe8\x66\x94\x41\xe8\x67\x94\x52\xe8\x68\x94\x44\xe8\x69\x94\x5b\xe8\x6a\x94\x60\xe8\x6b\x94\x62\xe8\x6c\x94\x5e\xe8\x6d\x94\x6a\xe8\x6e\x92\x29\xe8\x6f\x94\x70\xe8\x70\x94\x75\xe8\x71\x94\x77\xe8\x72\x94\x7d\xe8\x73\x94\x5a\xe8\x74\x94\x7c\xe8\x75\x94\x7e\xe8\x76\x94\x81\xe8\x77\x94\x7f\xe8\x78\x95\x82\xe8\x79\x95\x87\xe8\x7a\x95\x8a\xe8\x7b\x95\x94\xe8\x7c\x95\x96\xe8\x7d\x95\x98\xe8\x7e\x95\x99\xe8\x80\x95\xa0\xe8\x81\x95\xa8\xe8\x82\x95\xa7\xe8\x83\x95\xad\xe8\x84\x95\xbc\xe8\x85\x95\xbb\xe8\x86\x95\xb9\xe8\x87\x95\xbe\xe8\x88\x95\xca\xe8\x89\x6f\xf6\xe8\x8a\x95\xc3\xe8\x8b\x95\xcd\xe8\x8c\x95\xcc\xe8\x8d\x95\xd5\xe8\x8e\x95\xd4\xe8\x8f\x95\xd6\xe8\x90\x95\xdc\xe8\x91\x95\xe1\xe8\x92\x95\xe5\xe8\x93\x95\xe2\xe8\x94\x96\x21\xe8\x95\x96\x28\xe8\x96\x96\x2e\xe8\x97\x96\x2f\xe8\x98\x96\x42\xe8\x99\x96\x4c\xe8\x9a\x96\x4f\xe8\x9b\x96\x4b\xe8\x9c\x96\x77\xe8\x9d\x96\x5c\xe8\x9e\x96\x5e\xe8\x9f\x96\x5d\xe8\xa0\x96\x5f\xe8\xa1\x96\x66\xe8\xa2\x96\x72\xe8\xa3\x96\x6c\xe8\xa4\x96\x8d\xe8\xa5\x96\x98\xe8\xa6\x96\x95\xe8\xa7\x96\x97\xe8\xa8\x96\xaa\xe8\xa9\x96\xa7\xe8\xaa\x96\xb1\xe8\xab\x96\xb2\xe8\xac\x96\xb0\xe8\xad\x96\xb4\xe8\xae\x96\xb6\xe8\xaf\x96\xb8\xe8\xb0\x96\xb9\xe8\xb1\x96\xce\xe8\xb2\x96\xcb\xe8\xb3\x96\xc9\xe8\xb4\x96\xcd\xe8\xb5\x89\x4d\xe8\xb6\x96\xdc\xe8\xb7\x97\x0d\xe8\xb8\x96\xd5\xe8\xb9\x96\xf9\xe8\xba\x97\x04\xe8\xbb\x97\x06\xe8\xbc\x97\x08\xe8\xbd\x97\x13\xe8\xbe\x97\x0e\xe8\xbf\x97\x11\xe8\xc0\x97\x0f\xe8\xc1\x97\x16\xe8\xc2\x97\x19\xe8\xc3\x97\x24\xe8\xc4\x97\x2a\xe8\xc5\x97\x30\xe8\xc6\x97\x39\xe8\xc7\x97\x3d\xe8\xc8\x97\x3e\xe8\xc9\x97\x44\xe8\xca\x97\x46\xe8\xcb\x97\x48\xe8\xcc\x97\x42\xe8\xcd\x97\x49\xe8\xce\x97\x5c\xe8\xcf\x97\x60\xe8\xd0\x97\x64\xe8\xd1\x97\x66\xe8\xd2\x97\x68\xe8\xd3\x52\xd2\xe8\xd4\x97\x6b\xe8\xd5\x97\x71\xe8\xd6\x97\x79\xe8\xd7\x97\x85\xe8\xd8\x97\x7c\xe8\xd9\x97\x81\xe8\xda\x97\x7a\xe8\xdb\x97\x86\xe8\xdc\x97\x8b\xe8\xdd\x97\x8f\xe8\xde\x97\x90\xe8\xdf\x97\x9c\xe8\xe0\x97\xa8\xe8\xe1\x97\xa6\xe8\xe2\x97\xa3\xe8\xe3\x97\xb3\xe8\xe4\x97\xb4\xe8\xe5\x97\xc3\xe8\xe6\x97\xc6\xe8\xe7\x97\xc8\xe8\xe8\x97\xcb\xe8\xe9\x97\xdc\xe8\xea\x97\xed\xe8\xeb\x9f\x4f\xe8\xec\x97\xf2\xe8\xed\x7a\xdf\xe8\xee\x97\xf6\xe8\xef\x97\xf5\xe8\xf0\x98\x0f\xe8\xf1\x98\x0c\xe8\xf2\x98\x38\xe8\xf3\x98\x24\xe8\xf4\x98\x21\xe8\xf5\x98\x37\xe8\xf6\x98\x3d\xe8\xf7\x98\x46\xe8\xf8\x98\x4f\xe8\xf9\x98\x4b\xe8\xfa\x98\x6b\xe8\xfb\x98\x6f\xe8\xfc\x98\x70\xe9\x40\x98\x71\xe9\x41\x98\x74\xe9\x42\x98\x73\xe9\x43\x98\xaa\xe9\x44\x98\xaf\xe9\x45\x98\xb1\xe9\x46\x98\xb6\xe9\x47\x98\xc4\xe9\x48\x98\xc3\xe9\x49\x98\xc6\xe9\x4a\x98\xe9\xe9\x4b\x98\xeb\xe9\x4c\x99\x03\xe9\x4d\x99\x09\xe9\x4e\x99\x12\xe9\x4f\x99\x14\xe9\x50\x99\x18\xe9\x51\x99\x21\xe9\x52\x99\x1d\xe9\x53\x99\x1e\xe9\x54\x99\x24\xe9\x55\x99\x20\xe9\x56\x99\x2c\xe9\x57\x99\x2e\xe9\x58\x99\x3d\xe9\x59\x99\x3e\xe9\x5a\x99\x42\xe9\x5b\x99\x49\xe9\x5c\x99\x45\xe9\x5d\x99\x50\xe9\x5e\x99\x4b\xe9\x5f\x99\x51\xe9\x60\x99\x52\xe9\x61\x99\x4c\xe9\x62\x99\x55\xe9\x63\x99\x97\xe9\x64\x99\x98\xe9\x65\x99\xa5\xe9\x66\x99\xad\xe9\x67\x99\xae\xe9\x68\x99\xbc\xe9\x69\x99\xdf\xe9\x6a\x99\xdb\xe9\x6b\x99\xdd\xe9\x6c\x99\xd8\xe9\x6d\x99\xd1\xe9\x6e\x99\xed\xe9\x6f\x99\xee\xe9\x70\x99\xf1\xe9\x71\x99\xf2\xe9\x72\x99\xfb\xe9\x73\x99\xf8\xe9\x74\x9a\x01\xe9\x75\x9a\x0f\xe9\x76\x9a\x05\xe9\x77\x99\xe2\xe9\x78\x9a\x19\xe9\x79\x9a\x2b\xe9\x7a\x9a\x37\xe9\x7b\x9a\x45\xe9\x7c\x9a\x42\xe9\x7d\x9a\x40\xe9\x7e\x9a\x43\xe9\x80\x9a\x3e\xe9\x81\x9a\x55\xe9\x82\x9a\x4d\xe9\x83\x9a\x5b\xe9\x84\x9a\x57\xe9\x85\x9a\x5f\xe9\x86\x9a\x62\xe9\x87\x9a\x65\xe9\x88\x9a\x64\xe9\x89\x9a\x69\xe9\x8a\x9a\x6b\xe9\x8b\x9a\x6a\xe9\x8c\x9a\xad\xe9\x8d\x9a\xb0\xe9\x8e\x9a\xbc\xe9\x8f\x9a\xc0\xe9\x90\x9a\xcf\xe9\x91\x9a\xd1\xe9\x92\x9a\xd3\xe9\x93\x9a\xd4\xe9\x94\x9a\xde\xe9\x95\x9a\xdf\xe9\x96\x9a\xe2\xe9\x97\x9a\xe3\xe9\x98\x9a\xe6\xe9\x99\x9a\xef\xe9\x9a\x9a\xeb\xe9\x9b\x9a\xee\xe9\x9c\x9a\xf4\xe9\x9d\x9a\xf1\xe9\x9e\x9a\xf7\xe9\x9f\x9a\xfb\xe9\xa0\x9b\x06\xe9\xa1\x9b\x18\xe9\xa2\x9b\x1a\xe9\xa3\x9b\x1f\xe9\xa4\x9b\x22\xe9\xa5\x9b\x23\xe9\xa6\x9b\x25\xe9\xa7\x9b\x27\xe9\xa8\x9b\x28\xe9\xa9\x9b\x29\xe9\xaa\x9b\x2a\xe9\xab\x9b\x2e\xe9\xac\x9b\x2f\xe9\xad\x9b\x32\xe9\xae\x9b\x44\xe9\xaf\x9b\x43\xe9\xb0\x9b\x4f\xe9\xb1\x9b\x4d\xe9\xb2\x9b\x4e\xe9\xb3\x9b\x51\xe9\xb4\x9b\x58\xe9\xb5\x9b\x74\xe9\xb6\x9b\x93\xe9\xb7\x9b\x83\xe9\xb8\x9b\x91\xe9\xb9\x9b\x96\xe9\xba\x9b\x97\xe9\xbb\x9b\x9f\xe9\xbc\x9b\xa0\xe9\xbd\x9b\xa8\xe9\xbe\x9b\xb4\xe9\xbf\x9b\xc0\xe9\xc0\x9b\xca\xe9\xc1\x9b\xb9\xe9\xc2\x9b\xc6\xe9\xc3\x9b\xcf\xe9\xc4\x9b\xd1\xe9\xc5\x9b\xd2\xe9\xc6\x9b\xe3\xe9\xc7\x9b\xe2\xe9\xc8\x9b\xe4\xe9\xc9\x9b\xd4\xe9\xca\x9b\xe1\xe9\xcb\x9c\x3a\xe9\xcc\x9b\xf2\xe9\xcd\x9b\xf1\xe9\xce\x9b\xf0\xe9\xcf\x9c\x15\xe9\xd0\x9c\x14\xe9\xd1\x9c\x09\xe9\xd2\x9c\x13\xe9\xd3\x9c\x0c\xe9\xd4\x9c\x06\xe9\xd5\x9c\x08\xe9\xd6\x9c\x12\xe9\xd7\x9c\x0a\xe9\xd8\x9c\x04\xe9\xd9\x9c\x2e\xe9\xda\x9c\x1b\xe9\xdb\x9c\x25\xe9\xdc\x9c\x24\xe9\xdd\x9c\x21\xe9\xde\x9c\x30\xe9\xdf\x9c\x47\xe9\xe0\x9c\x32\xe9\xe1\x9c\x46\xe9\xe2\x9c\x3e\xe9\xe3\x9c\x5a\xe9\xe4\x9c\x60\xe9\xe5\x9c\x67\xe9\xe6\x9c\x76\xe9\xe7\x9c\x78\xe9\xe8\x9c\xe7\xe9\xe9\x9c\xec\xe9\xea\x9c\xf0\xe9\xeb\x9d\x09\xe9\xec\x9d\x08\xe9\xed\x9c\xeb\xe9\xee\x9d\x03\xe9\xef\x9d\x06\xe9\xf0\x9d\x2a\xe9\xf1\x9d\x26\xe9\xf2\x9d\xaf\xe9\xf3\x9d\x23\xe9\xf4\x9d\x1f\xe9\xf5\x9d\x44\xe9\xf6\x9d\x15\xe9\xf7\x9d\x12\xe9\xf8\x9d\x41\xe9\xf9\x9d\x3f\xe9\xfa\x9d\x3e\xe9\xfb\x9d\x46\xe9\xfc\x9d\x48\xea\x40\x9d\x5d\xea\x41\x9d\x5e\xea\x42\x9d\x64\xea\x43\x9d\x51\xea\x44\x9d\x50\xea\x45\x9d\x59\xea\x46\x9d\x72\xea\x47\x9d\x89\xea\x48\x9d\x87\xea\x49\x9d\xab\xea\x4a\x9d\x6f\xea\x4b\x9d\x7a\xea\x4c\x9d\x9a\xea\x4d\x9d\xa4\xea\x4e\x9d\xa9\xea\x4f\x9d\xb2\xea\x50\x9d\xc4\xea\x51\x9d\xc1\xea\x52\x9d\xbb\xea\x53\x9d\xb8\xea\x54\x9d\xba\xea\x55\x9d\xc6\xea\x56\x9d\xcf\xea\x57\x9d\xc2\xea\x58\x9d\xd9\xea\x59\x9d\xd3\xea\x5a\x9d\xf8\xea\x5b\x9d\xe6\xea\x5c\x9d\xed\xea\x5d\x9d\xef\xea\x5e\x9d\xfd\xea\x5f\x9e\x1a\xea\x60\x9e\x1b\xea\x61\x9e\x1e\xea\x62\x9e\x75\xea\x63\x9e\x79\xea\x64\x9e\x7d\xea\x65\x9e\x81\xea\x66\x9e\x88\xea\x67\x9e\x8b\xea\x68\x9e\x8c\xea\x69\x9e\x92\xea\x6a\x9e\x95\xea\x6b\x9e\x91\xea\x6c\x9e\x9d\xea\x6d\x9e\xa5\xea\x6e\x9e\xa9\xea\x6f\x9e\xb8\xea\x70\x9e\xaa\xea\x71\x9e\xad\xea\x72\x97\x61\xea\x73\x9e\xcc\xea\x74\x9e\xce\xea\x75\x9e\xcf\xea\x76\x9e\xd0\xea\x77\x9e\xd4\xea\x78\x9e\xdc\xea\x79\x9e\xde\xea\x7a\x9e\xdd\xea\x7b\x9e\xe0\xea\x7c\x9e\xe5\xea\x7d\x9e\xe8\xea\x7e\x9e\xef\xea\x80\x9e\xf4\xea\x81\x9e\xf6\xea\x82\x9e\xf7\xea\x83\x9e\xf9\xea\x84\x9e\xfb\xea\x85\x9e\xfc\xea\x86\x9e\xfd\xea\x87\x9f\x07\xea\x88\x9f\x08\xea\x89\x76\xb7\xea\x8a\x9f\x15\xea\x8b\x9f\x21\xea\x8c\x9f\x2c\xea\x8d\x9f\x3e\xea\x8e\x9f\x4a\xea\x8f\x9f\x52\xea\x90\x9f\x54\xea\x91\x9f\x63\xea\x92\x9f\x5f\xea\x93\x9f\x60\xea\x94\x9f\x61\xea\x95\x9f\x66\xea\x96\x9f\x67\xea\x97\x9f\x6c\xea\x98\x9f\x6a\xea\x99\x9f\x77\xea\x9a\x9f\x72\xea\x9b\x9f\x76\xea\x9c\x9f\x95\xea\x9d\x9f\x9c\xea\x9e\x9f\xa0\xea\x9f\x58\x2f\xea\xa0\x69\xc7\xea\xa1\x90\x59\xea\xa2\x74\x64\xea\xa3\x51\xdc\xea\xa4\x71\x99\x03\x00\x4c\xc5\x51\x61\x7c\x6b\x00\x00")
+
+func shiftjisBinBytes() ([]byte, error) {
+	return bindataRead(
+		_shiftjisBin,
+		"shiftjis.bin",
+	)
+}
+
+func shiftjisBin() (*asset, error) {
+	bytes, err := shiftjisBinBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "digits.gif", size: 3318, mode: os.FileMode(416), modTime: time.Unix(1422515316, 0)}
+	info := bindataFileInfo{name: "shiftjis.bin", size: 27516, mode: os.FileMode(420), modTime: time.Unix(1792321138, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -96,6 +154,17 @@ func _asset(name string) ([]byte, error) {
 	return nil, fmt.Errorf("Asset %s not found", name)
 }
 
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := _asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
 // AssetInfo loads and returns the asset info for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -122,18 +191,22 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"digits.gif": digits_gif,
+	"RangeMessage.xml": rangemessageXml,
+	"digits.gif":       digitsGif,
+	"shiftjis.bin":     shiftjisBin,
 }
 
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"}
 // AssetDir("data/img") would return []string{"a.png", "b.png"}
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error
@@ -154,22 +227,24 @@ func AssetDir(name string) ([]string, error) {
 		return nil, fmt.Errorf("Asset %s not found", name)
 	}
 	rv := make([]string, 0, len(node.Children))
-	for name := range node.Children {
-		rv = append(rv, name)
+	for childName := range node.Children {
+		rv = append(rv, childName)
 	}
 	return rv, nil
 }
 
-type _bintree_t struct {
+type bintree struct {
 	Func     func() (*asset, error)
-	Children map[string]*_bintree_t
+	Children map[string]*bintree
 }
 
-var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
-	"digits.gif": &_bintree_t{digits_gif, map[string]*_bintree_t{}},
+var _bintree = &bintree{nil, map[string]*bintree{
+	"RangeMessage.xml": &bintree{rangemessageXml, map[string]*bintree{}},
+	"digits.gif":       &bintree{digitsGif, map[string]*bintree{}},
+	"shiftjis.bin":     &bintree{shiftjisBin, map[string]*bintree{}},
 }}
 
-// Restore an asset under the given directory
+// RestoreAsset restores an asset under the given directory
 func RestoreAsset(dir, name string) error {
 	data, err := _asset(name)
 	if err != nil {
@@ -179,7 +254,7 @@ func RestoreAsset(dir, name string) error {
 	if err != nil {
 		return err
 	}
-	err = os.MkdirAll(_filePath(dir, path.Dir(name)), os.FileMode(0755))
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
 	if err != nil {
 		return err
 	}
@@ -194,17 +269,18 @@ func RestoreAsset(dir, name string) error {
 	return nil
 }
 
-// Restore assets under the given directory recursively
+// RestoreAssets restores an asset under the given directory recursively
 func RestoreAssets(dir, name string) error {
 	children, err := AssetDir(name)
-	if err != nil { // File
+	// File
+	if err != nil {
 		return RestoreAsset(dir, name)
-	} else { // Dir
-		for _, child := range children {
-			err = RestoreAssets(dir, path.Join(name, child))
-			if err != nil {
-				return err
-			}
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
 		}
 	}
 	return nil
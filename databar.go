@@ -0,0 +1,390 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"image"
+	"image/draw"
+)
+
+// DataBarStyle selects the layout of a GS1 DataBar symbol encoding a GTIN. GS1
+// DataBar Limited, whose characters and check patterns are different, is not
+// available.
+type DataBarStyle int
+
+const (
+	// Single row of 33 modules, for omnidirectional scanning
+	DataBarOmnidirectional DataBarStyle = iota
+	// Single row of 13 modules, for linear scanners
+	DataBarTruncated
+	// Rows of 5 and 7 modules, for linear scanners
+	DataBarStacked
+	// Two rows of 33 modules, for omnidirectional scanning
+	DataBarStackedOmnidirectional
+)
+
+// Heights of the rows in modules
+const (
+	dataBarOmniHeight        = 33
+	dataBarTruncatedHeight   = 13
+	dataBarStackedTopHeight  = 5
+	dataBarStackedBaseHeight = 7
+)
+
+// combins returns the number of combinations of r elements out of n.
+func combins(n, r int) int {
+	minDenom, maxDenom := r, n-r
+	if n-r <= r {
+		minDenom, maxDenom = n-r, r
+	}
+	val, j := 1, 1
+	for i := n; i > maxDenom; i-- {
+		val *= i
+		if j <= minDenom {
+			val /= j
+			j++
+		}
+	}
+	for ; j <= minDenom; j++ {
+		val /= j
+	}
+	return val
+}
+
+// dataBarWidths returns the widths of the elements spreading n modules whose
+// combination has the value, none being wider than maxWidth. With needNarrow,
+// the combinations without a single module element are left out.
+func dataBarWidths(value, n, elements, maxWidth int, needNarrow bool) []int {
+	widths := make([]int, elements)
+	narrowMask := 0
+	bar := 0
+	for ; bar < elements-1; bar++ {
+		width, subVal := 1, 0
+		for narrowMask |= 1 << uint(bar); ; width, narrowMask = width+1, narrowMask&^(1<<uint(bar)) {
+			// All the combinations of the remaining elements
+			subVal = combins(n-width-1, elements-bar-2)
+			// Less the ones without a single module element
+			if needNarrow && narrowMask == 0 && n-width-(elements-bar-1) >= elements-bar-1 {
+				subVal -= combins(n-width-(elements-bar), elements-bar-2)
+			}
+			// Less the ones with an element wider than maxWidth
+			if elements-bar-1 > 1 {
+				lessVal := 0
+				for mxw := n - width - (elements - bar - 2); mxw > maxWidth; mxw-- {
+					lessVal += combins(n-width-mxw-1, elements-bar-3)
+				}
+				subVal -= lessVal * (elements - 1 - bar)
+			} else if n-width > maxWidth {
+				subVal--
+			}
+			value -= subVal
+			if value < 0 {
+				break
+			}
+		}
+		value += subVal
+		n -= width
+		widths[bar] = width
+	}
+	widths[bar] = n
+	return widths
+}
+
+// dataBarGroup is a range of character values sharing the number of modules of
+// the odd and even elements.
+type dataBarGroup struct {
+	base                    int
+	oddModules, evenModules int
+	oddWidest, evenWidest   int
+	// Number of combinations of the elements whose value varies faster
+	combinations int
+}
+
+// dataBarCharSet describes the characters of 8 elements of a symbology.
+type dataBarCharSet struct {
+	groups []dataBarGroup
+	// Whether the odd elements need a single module element, otherwise the even
+	oddNarrow bool
+	// Whether the value of the odd elements varies faster than the even ones
+	oddFaster bool
+}
+
+var (
+	// Outside characters of GS1 DataBar Omnidirectional, 16 modules wide
+	dataBarOutside = dataBarCharSet{
+		groups: []dataBarGroup{
+			{0, 12, 4, 8, 1, 1},
+			{161, 10, 6, 6, 3, 10},
+			{961, 8, 8, 4, 5, 34},
+			{2015, 6, 10, 3, 6, 70},
+			{2715, 4, 12, 1, 8, 126},
+		},
+	}
+	// Inside characters of GS1 DataBar Omnidirectional, 15 modules wide
+	dataBarInside = dataBarCharSet{
+		groups: []dataBarGroup{
+			{0, 5, 10, 2, 7, 4},
+			{336, 7, 8, 4, 5, 20},
+			{1036, 9, 6, 6, 3, 48},
+			{1516, 11, 4, 8, 1, 81},
+		},
+		oddNarrow: true,
+		oddFaster: true,
+	}
+	// Characters of GS1 DataBar Expanded, 17 modules wide
+	dataBarExpandedChars = dataBarCharSet{
+		groups: []dataBarGroup{
+			{0, 12, 5, 7, 2, 4},
+			{348, 10, 7, 5, 4, 20},
+			{1388, 8, 9, 4, 5, 52},
+			{2948, 6, 11, 3, 6, 104},
+			{3988, 4, 13, 1, 8, 204},
+		},
+		oddNarrow: true,
+	}
+)
+
+// widths returns the widths of the 8 elements of the character, odd and even
+// elements alternating.
+func (cs dataBarCharSet) widths(value int) []int {
+	g := cs.groups[0]
+	for _, group := range cs.groups {
+		if value >= group.base {
+			g = group
+		}
+	}
+	value -= g.base
+	vOdd, vEven := value/g.combinations, value%g.combinations
+	if cs.oddFaster {
+		vOdd, vEven = vEven, vOdd
+	}
+	odd := dataBarWidths(vOdd, g.oddModules, 4, g.oddWidest, cs.oddNarrow)
+	even := dataBarWidths(vEven, g.evenModules, 4, g.evenWidest, !cs.oddNarrow)
+	widths := make([]int, 8)
+	for i := 0; i < 4; i++ {
+		widths[2*i], widths[2*i+1] = odd[i], even[i]
+	}
+	return widths
+}
+
+// reversedWidths returns a reversed copy of widths.
+func reversedWidths(widths []int) []int {
+	r := make([]int, len(widths))
+	for i, w := range widths {
+		r[len(widths)-1-i] = w
+	}
+	return r
+}
+
+// appendModules appends the modules of the alternating elements, the first one
+// being dark or light.
+func appendModules(row []bool, widths []int, dark bool) []bool {
+	for _, w := range widths {
+		for i := 0; i < w; i++ {
+			row = append(row, dark)
+		}
+		dark = !dark
+	}
+	return row
+}
+
+// appendRows appends n copies of row.
+func appendRows(modules [][]bool, row []bool, n int) [][]bool {
+	for i := 0; i < n; i++ {
+		modules = append(modules, row)
+	}
+	return modules
+}
+
+// dataBarFinders are the finder patterns of GS1 DataBar Omnidirectional, which
+// encode the check value with the one on the other side.
+var dataBarFinders = [9][]int{
+	{3, 8, 2, 1, 1},
+	{3, 5, 5, 1, 1},
+	{3, 3, 7, 1, 1},
+	{3, 1, 9, 1, 1},
+	{2, 7, 4, 1, 1},
+	{2, 5, 6, 1, 1},
+	{2, 3, 8, 1, 1},
+	{1, 5, 7, 1, 1},
+	{1, 3, 9, 1, 1},
+}
+
+// dataBarChecksumWeights are the weights of the elements of the 4 characters, from
+// Table 5 of ISO/IEC 24724: successive powers of 3 modulo 79, reversed for the
+// left inside and right outside characters as they are printed reversed.
+var dataBarChecksumWeights = [4][8]int{
+	{1, 3, 9, 27, 2, 6, 18, 54},
+	{58, 72, 24, 8, 29, 36, 12, 4},
+	{74, 51, 17, 32, 37, 65, 48, 16},
+	{64, 34, 23, 69, 49, 68, 46, 59},
+}
+
+// DataBar is a GS1 DataBar Omnidirectional symbol or one of its truncated and
+// stacked variants, encoding a GTIN.
+type DataBar struct {
+	gtin  GTIN14
+	style DataBarStyle
+}
+
+// DataBarFromGTIN14 returns the GS1 DataBar Omnidirectional symbol of gtin.
+func DataBarFromGTIN14(gtin GTIN14) DataBar {
+	return DataBar{gtin: gtin}
+}
+
+// DataBarFromEAN13 returns the GS1 DataBar Omnidirectional symbol of the GTIN-13
+// of ean, with an indicator of 0.
+func DataBarFromEAN13(ean EAN13) DataBar {
+	return DataBar{gtin: GTIN14{code14: ean.code13}}
+}
+
+func (db DataBar) GTIN14() GTIN14 {
+	return db.gtin
+}
+
+// WithStyle returns a copy of the symbol with the style.
+func (db DataBar) WithStyle(style DataBarStyle) DataBar {
+	db.style = style
+	return db
+}
+
+// characters returns the widths of the 4 characters, from the left outside one to
+// the right inside one, in the order of their values.
+func (db DataBar) characters() [][]int {
+	// The check digit is not encoded
+	value := db.gtin.code14 / 10
+	left, right := value/4537077, value%4537077
+	values := []int{int(left / 1597), int(left % 1597), int(right / 1597), int(right % 1597)}
+	chars := make([][]int, 4)
+	for i, v := range values {
+		if i%2 == 0 {
+			chars[i] = dataBarOutside.widths(v)
+		} else {
+			chars[i] = dataBarInside.widths(v)
+		}
+	}
+	return chars
+}
+
+// dataBarChecksum returns the check value of the characters, modulo 79.
+func dataBarChecksum(chars [][]int) int {
+	sum := 0
+	for i, widths := range chars {
+		for k, w := range widths {
+			sum += w * dataBarChecksumWeights[i][k]
+		}
+	}
+	return sum % 79
+}
+
+// elements returns the widths of the 46 elements of the symbol in a single row,
+// starting with a space: the guard, an outside character, the left finder, two
+// inside characters, the right finder, an outside character and the guard.
+func (db DataBar) elements() []int {
+	chars := db.characters()
+	checksum := dataBarChecksum(chars)
+	// The check values of two identical finder patterns are skipped
+	if checksum >= 8 {
+		checksum++
+	}
+	if checksum >= 72 {
+		checksum++
+	}
+	elements := []int{1, 1}
+	elements = append(elements, chars[0]...)
+	elements = append(elements, dataBarFinders[checksum/9]...)
+	elements = append(elements, reversedWidths(chars[1])...)
+	elements = append(elements, chars[3]...)
+	elements = append(elements, reversedWidths(dataBarFinders[checksum%9])...)
+	elements = append(elements, reversedWidths(chars[2])...)
+	return append(elements, 1, 1)
+}
+
+// modules returns the rows of modules of the symbol.
+func (db DataBar) modules() [][]bool {
+	elements := db.elements()
+	switch db.style {
+	case DataBarTruncated:
+		return appendRows(nil, appendModules(nil, elements, false), dataBarTruncatedHeight)
+	case DataBarStacked, DataBarStackedOmnidirectional:
+	default:
+		return appendRows(nil, appendModules(nil, elements, false), dataBarOmniHeight)
+	}
+	// The left half on top and the right half below, each with its own guards
+	top := appendModules(nil, append(elements[:23:23], 1, 1), false)
+	bottom := appendModules(nil, append([]int{1, 1}, elements[23:]...), true)
+	width := len(top)
+	if db.style == DataBarStacked {
+		// The separator is the complement of the rows where they agree, and
+		// alternates where they differ
+		separator := make([]bool, width)
+		for x := 4; x < width-4; x++ {
+			if top[x] == bottom[x] {
+				separator[x] = !top[x]
+			} else {
+				separator[x] = !separator[x-1]
+			}
+		}
+		modules := appendRows(nil, top, dataBarStackedTopHeight)
+		modules = append(modules, separator)
+		return appendRows(modules, bottom, dataBarStackedBaseHeight)
+	}
+	middle := make([]bool, width)
+	for x := 5; x < width-4; x += 2 {
+		middle[x] = true
+	}
+	// The finder patterns start after the guard and the character on the left
+	modules := appendRows(nil, top, dataBarOmniHeight)
+	modules = append(modules, dataBarSeparator(top, [][2]int{{18, 33}}, false), middle)
+	modules = append(modules, dataBarSeparator(bottom, [][2]int{{17, 32}}, false))
+	return appendRows(modules, bottom, dataBarOmniHeight)
+}
+
+// dataBarSeparator returns the separator next to a row, which is the complement of
+// the row without the guards. Along the finder patterns, read in the direction of
+// the row, the light runs of the complement alternate with dark modules.
+func dataBarSeparator(row []bool, finders [][2]int, reversed bool) []bool {
+	separator := make([]bool, len(row))
+	for x := 4; x < len(row)-4; x++ {
+		separator[x] = !row[x]
+	}
+	for _, f := range finders {
+		if reversed {
+			for x := f[1] - 1; x >= f[0]; x-- {
+				if !row[x] && !row[x+1] && separator[x+1] {
+					separator[x] = false
+				}
+			}
+			continue
+		}
+		for x := f[0]; x < f[1]; x++ {
+			if !row[x] && !row[x-1] && separator[x-1] {
+				separator[x] = false
+			}
+		}
+	}
+	return separator
+}
+
+func (db DataBar) symbol() matrixSymbol {
+	return matrixSymbol{modules: db.modules()}
+}
+
+func (db DataBar) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := db.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
+
+func (db DataBar) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := db.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
@@ -0,0 +1,573 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+	"strconv"
+	"strings"
+)
+
+// Limits of the GS1 DataBar Expanded symbols
+const (
+	dbxMaxChars       = 22
+	dbxMinDataChars   = 3
+	dbxCharBits       = 12
+	dbxMaxSegments    = 22
+	dbxRowHeight      = 34
+	dbxCharModules    = 17
+	dbxFinderModules  = 15
+	dbxNoDate         = 38400
+	dbxMaxWeight3103  = 32767
+	dbxMaxWeight3203  = 22767
+	dbxMaxWeight      = 99999
+	dbxMaxShortWeight = 9999
+)
+
+var (
+	errDataBarTooLong          = errors.New("Data too long for a GS1 DataBar Expanded")
+	errInvalidDataBarSegments  = errors.New("Invalid GS1 DataBar Expanded segments per row")
+	errInvalidDataBarCharacter = errors.New("Character cannot be encoded in GS1 DataBar Expanded")
+)
+
+// Encodation modes of the general purpose data
+const (
+	dbxNumeric = iota
+	dbxAlpha
+	dbxISO
+	dbxModes
+)
+
+// dbxLatches gives the bits latching from one mode to another.
+var dbxLatches = [dbxModes][dbxModes]azCode{
+	dbxNumeric: {dbxAlpha: {0, 4}, dbxISO: {4, 9}},
+	dbxAlpha:   {dbxNumeric: {0, 3}, dbxISO: {4, 5}},
+	dbxISO:     {dbxNumeric: {0, 3}, dbxAlpha: {4, 5}},
+}
+
+// Codes of the general purpose data
+const (
+	dbxFNC1       = 15
+	dbxNumericFNC = 10
+	dbxDigitBase  = 5
+)
+
+// dbxAlphaSymbols and dbxISOSymbols are the characters other than digits and
+// letters, from the codes 58 and 232.
+const (
+	dbxAlphaSymbols = "*,-./"
+	dbxISOSymbols   = "!\"%&'()*+,-./:;<=>?_ "
+)
+
+// dbxCharCode returns the code of c in the alphanumeric or ISO/IEC 646 mode, or 0
+// bits when it cannot be encoded. FNC1 is the group separator.
+func dbxCharCode(c byte, mode int) azCode {
+	switch {
+	case c == gs1Separator:
+		return azCode{dbxFNC1, 5}
+	case c >= '0' && c <= '9':
+		return azCode{int(c-'0') + dbxDigitBase, 5}
+	case mode == dbxAlpha && c >= 'A' && c <= 'Z':
+		return azCode{int(c-'A') + 32, 6}
+	case mode == dbxAlpha && strings.IndexByte(dbxAlphaSymbols, c) >= 0:
+		return azCode{strings.IndexByte(dbxAlphaSymbols, c) + 58, 6}
+	case mode == dbxISO && c >= 'A' && c <= 'Z':
+		return azCode{int(c-'A') + 64, 7}
+	case mode == dbxISO && c >= 'a' && c <= 'z':
+		return azCode{int(c-'a') + 90, 7}
+	case mode == dbxISO && strings.IndexByte(dbxISOSymbols, c) >= 0:
+		return azCode{strings.IndexByte(dbxISOSymbols, c) + 232, 8}
+	}
+	return azCode{}
+}
+
+// dbxNumericValue returns the value of a digit or FNC1 in the numeric mode, or -1.
+func dbxNumericValue(c byte) int {
+	switch {
+	case c == gs1Separator:
+		return dbxNumericFNC
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	}
+	return -1
+}
+
+// encodeDataBarGeneral appends the shortest encoding of the general purpose data,
+// starting in the numeric mode, and returns the final mode. FNC1 in the other
+// modes latches back to the numeric mode.
+func encodeDataBarGeneral(w *bitWriter, data []byte) (int, error) {
+	n := len(data)
+	const unreachable = 1 << 30
+	type step struct {
+		from, mode, bits int
+	}
+	// arrive[i][m] is the length of the shortest encoding of data[:i] ending in
+	// mode m, and cost[i][m] the same after the latches at position i.
+	arrive := make([][dbxModes]int, n+1)
+	cost := make([][dbxModes]int, n+1)
+	steps := make([][dbxModes]step, n+1)
+	latchFrom := make([][dbxModes]int, n+1)
+	for i := range arrive {
+		for m := range arrive[i] {
+			arrive[i][m], cost[i][m] = unreachable, unreachable
+		}
+	}
+	arrive[0][dbxNumeric] = 0
+	relax := func(i, m, c int, s step) {
+		if c < arrive[i][m] {
+			arrive[i][m], steps[i][m] = c, s
+		}
+	}
+	for i := 0; i <= n; i++ {
+		for to := 0; to < dbxModes; to++ {
+			for from := 0; from < dbxModes; from++ {
+				if c := arrive[i][from] + dbxLatches[from][to].bits; c < cost[i][to] {
+					cost[i][to], latchFrom[i][to] = c, from
+				}
+			}
+		}
+		if i == n {
+			break
+		}
+		for m := 0; m < dbxModes; m++ {
+			c := cost[i][m]
+			if c >= unreachable {
+				continue
+			}
+			if m == dbxNumeric {
+				first := dbxNumericValue(data[i])
+				switch {
+				case i+1 < n && first >= 0 && dbxNumericValue(data[i+1]) >= 0 &&
+					(first != dbxNumericFNC || data[i+1] != gs1Separator):
+					relax(i+2, m, c+7, step{i, m, 7})
+				case i+1 == n && first >= 0 && first != dbxNumericFNC:
+					// A final digit is paired with FNC1
+					relax(i+1, m, c+7, step{i, m, 7})
+				}
+				continue
+			}
+			if code := dbxCharCode(data[i], m); code.bits > 0 {
+				next := m
+				if data[i] == gs1Separator {
+					next = dbxNumeric
+				}
+				relax(i+1, next, c+code.bits, step{i, m, code.bits})
+			}
+		}
+	}
+	best := 0
+	for m := range arrive[n] {
+		if arrive[n][m] < arrive[n][best] {
+			best = m
+		}
+	}
+	if arrive[n][best] >= unreachable {
+		return 0, errInvalidDataBarCharacter
+	}
+
+	// Walk back the shortest path, each step possibly preceded by a latch
+	var codes []azCode
+	for i, m := n, best; i > 0; {
+		s := steps[i][m]
+		chunk := data[s.from:i]
+		if s.mode == dbxNumeric {
+			second := dbxNumericFNC
+			if len(chunk) > 1 {
+				second = dbxNumericValue(chunk[1])
+			}
+			codes = append(codes, azCode{11*dbxNumericValue(chunk[0]) + second + 8, 7})
+		} else {
+			codes = append(codes, dbxCharCode(chunk[0], s.mode))
+		}
+		from := latchFrom[s.from][s.mode]
+		if from != s.mode {
+			codes = append(codes, dbxLatches[from][s.mode])
+		}
+		i, m = s.from, from
+	}
+	for j := len(codes) - 1; j >= 0; j-- {
+		w.write(uint64(codes[j].value), codes[j].bits)
+	}
+	return best, nil
+}
+
+// writeDataBarGTIN writes the 12 digits of the GTIN after the indicator, in groups
+// of 3 digits. The check digit is not encoded.
+func writeDataBarGTIN(w *bitWriter, gtin string) {
+	for i := 1; i < 13; i += 3 {
+		v, _ := strconv.Atoi(gtin[i : i+3])
+		w.write(uint64(v), 10)
+	}
+}
+
+// dbxDate returns the value of a YYMMDD date.
+func dbxDate(date string) (int, bool) {
+	v, err := strconv.Atoi(date)
+	if err != nil || len(date) != 6 {
+		return 0, false
+	}
+	yy, mm, dd := v/10000, v/100%100, v%100
+	if mm < 1 || mm > 12 || dd > 31 {
+		return 0, false
+	}
+	return yy*384 + (mm-1)*32 + dd, true
+}
+
+// encodeDataBarFixed returns the bits of the encodation methods compressing a GTIN
+// with an indicator of 9 followed by a weight, and maybe a date.
+func encodeDataBarFixed(elements []gs1Element) (bitWriter, bool) {
+	var w bitWriter
+	if len(elements) < 2 || len(elements) > 3 || elements[0].ai != "01" || elements[0].data[0] != '9' {
+		return w, false
+	}
+	ai, weight := elements[1].ai, elements[1].data
+	value, err := strconv.Atoi(weight)
+	if err != nil || len(ai) != 4 || (ai[:3] != "310" && ai[:3] != "320") {
+		return w, false
+	}
+	gtin := elements[0].data
+	// The linkage flag comes first
+	w.write(0, 1)
+	if len(elements) == 2 {
+		switch {
+		case ai == "3103" && value <= dbxMaxWeight3103:
+			w.write(4, 4)
+			writeDataBarGTIN(&w, gtin)
+			w.write(uint64(value), 15)
+			return w, true
+		case ai == "3202" && value <= dbxMaxShortWeight:
+			w.write(5, 4)
+			writeDataBarGTIN(&w, gtin)
+			w.write(uint64(value), 15)
+			return w, true
+		case ai == "3203" && value <= dbxMaxWeight3203:
+			w.write(5, 4)
+			writeDataBarGTIN(&w, gtin)
+			w.write(uint64(value+dbxMaxShortWeight+1), 15)
+			return w, true
+		}
+	}
+	if value > dbxMaxWeight {
+		return bitWriter{}, false
+	}
+	// The method selects the weight AI and the date AI, (11) when there is none
+	method, date := 56, dbxNoDate
+	if ai[:3] == "320" {
+		method++
+	}
+	if len(elements) == 3 {
+		var ok bool
+		if date, ok = dbxDate(elements[2].data); !ok {
+			return bitWriter{}, false
+		}
+		switch elements[2].ai {
+		case "11":
+		case "13":
+			method += 2
+		case "15":
+			method += 4
+		case "17":
+			method += 6
+		default:
+			return bitWriter{}, false
+		}
+	}
+	w.write(uint64(method), 7)
+	writeDataBarGTIN(&w, gtin)
+	w.write(uint64(int(ai[3]-'0')*(dbxMaxWeight+1)+value), 20)
+	w.write(uint64(date), 16)
+	return w, true
+}
+
+// dbxFinders are the finder patterns of GS1 DataBar Expanded, A to F, reversed in
+// the pairs of odd index.
+var dbxFinders = [6][]int{
+	{1, 8, 4, 1, 1},
+	{3, 6, 4, 1, 1},
+	{3, 4, 6, 1, 1},
+	{3, 2, 8, 1, 1},
+	{2, 6, 5, 1, 1},
+	{2, 2, 9, 1, 1},
+}
+
+// dbxSequences gives the finder patterns of the symbols, by number of pairs from 2.
+var dbxSequences = [][]int{
+	{0, 0},
+	{0, 1, 1},
+	{0, 2, 1, 3},
+	{0, 4, 1, 3, 2},
+	{0, 4, 1, 3, 3, 5},
+	{0, 4, 1, 3, 4, 5, 5},
+	{0, 0, 1, 1, 2, 2, 3, 3},
+	{0, 0, 1, 1, 2, 2, 3, 4, 4},
+	{0, 0, 1, 1, 2, 2, 3, 4, 5, 5},
+	{0, 0, 1, 1, 2, 3, 3, 4, 4, 5, 5},
+}
+
+// DataBarExpanded is a GS1 DataBar Expanded symbol, or an Expanded Stacked one.
+type DataBarExpanded struct {
+	elements GS1ElementString
+	// Encoded bits before the padding
+	bits []bool
+	// Position of the variable length field, or -1
+	lengthField int
+	// Whether the general purpose data ends in the numeric mode
+	numeric bool
+	// Segments per row of the stacked symbol, or 0 for a single row
+	segments int
+}
+
+// DataBarExpandedFromString encodes an element string in the human readable
+// notation, such as "(01)98898765432106(3202)012345(15)991231".
+func DataBarExpandedFromString(s string) (DataBarExpanded, error) {
+	es, err := GS1ElementStringFromString(s)
+	if err != nil {
+		return DataBarExpanded{}, err
+	}
+	return DataBarExpandedFromElementString(es)
+}
+
+// DataBarExpandedFromElementString encodes the element string in a single row
+// symbol. A GTIN first is compressed, along with a weight and a date after a GTIN
+// with an indicator of 9.
+func DataBarExpandedFromElementString(es GS1ElementString) (DataBarExpanded, error) {
+	if len(es.elements) == 0 {
+		return DataBarExpanded{}, errInvalidGS1
+	}
+	db := DataBarExpanded{elements: es, lengthField: -1}
+	if w, ok := encodeDataBarFixed(es.elements); ok {
+		db.bits = w.bits
+		return db, nil
+	}
+	elements := es.elements
+	var w bitWriter
+	if elements[0].ai == "01" {
+		w.write(1, 2)
+		db.lengthField = len(w.bits)
+		w.write(0, 2)
+		gtin := elements[0].data
+		w.write(uint64(gtin[0]-'0'), 4)
+		writeDataBarGTIN(&w, gtin)
+		elements = elements[1:]
+	} else {
+		w.write(0, 3)
+		db.lengthField = len(w.bits)
+		w.write(0, 2)
+	}
+	var data []byte
+	for i, e := range elements {
+		data = append(data, e.ai+e.data...)
+		if needsGS1Separator(elements, i) {
+			data = append(data, gs1Separator)
+		}
+	}
+	mode, err := encodeDataBarGeneral(&w, data)
+	if err != nil {
+		return DataBarExpanded{}, err
+	}
+	if len(w.bits) > (dbxMaxChars-1)*dbxCharBits {
+		return DataBarExpanded{}, errDataBarTooLong
+	}
+	db.bits, db.numeric = w.bits, mode == dbxNumeric
+	return db, nil
+}
+
+// ElementString returns the encoded element string.
+func (db DataBarExpanded) ElementString() GS1ElementString {
+	return db.elements
+}
+
+// WithSegmentsPerRow returns a copy stacked in rows of the given number of
+// segments, an even number from 2 to 22.
+func (db DataBarExpanded) WithSegmentsPerRow(segments int) (DataBarExpanded, error) {
+	if segments < 2 || segments > dbxMaxSegments || segments%2 != 0 {
+		return DataBarExpanded{}, errInvalidDataBarSegments
+	}
+	db.segments = segments
+	return db, nil
+}
+
+// values returns the values of the symbol characters, starting with the check
+// character.
+func (db DataBarExpanded) values() []int {
+	chars := (len(db.bits) + dbxCharBits - 1) / dbxCharBits
+	if chars < dbxMinDataChars {
+		chars = dbxMinDataChars
+	}
+	// The last row of a stacked symbol holds at least two characters
+	if db.segments > 0 && (chars+1)%db.segments == 1 {
+		chars++
+	}
+	var pad bitWriter
+	if db.numeric {
+		pad.write(uint64(dbxLatches[dbxNumeric][dbxAlpha].value), 4)
+	}
+	padBits := chars*dbxCharBits - len(db.bits)
+	for len(pad.bits) < padBits {
+		pad.write(uint64(dbxLatches[dbxAlpha][dbxISO].value), 5)
+	}
+	bits := append(append([]bool(nil), db.bits...), pad.bits[:padBits]...)
+	if db.lengthField >= 0 {
+		// Parity of the number of symbol characters, and whether there are more than 14
+		bits[db.lengthField] = (chars+1)%2 != 0
+		bits[db.lengthField+1] = chars+1 > 14
+	}
+
+	values := make([]int, chars+1)
+	for i := range bits {
+		values[1+i/dbxCharBits] <<= 1
+		if bits[i] {
+			values[1+i/dbxCharBits] |= 1
+		}
+	}
+	// The weights are successive powers of 3 modulo 211, in rows chosen by the
+	// finder pattern and side of each character
+	sequence := dbxSequences[(chars+2)/2-2]
+	checksum := 0
+	for i := 1; i <= chars; i++ {
+		pair := i / 2
+		row := 4*sequence[pair] + 2*(pair%2) + i%2 - 1
+		weight := 1
+		for j := 0; j < 8*row; j++ {
+			weight = weight * 3 % 211
+		}
+		for _, w := range dataBarExpandedChars.widths(values[i]) {
+			checksum += w * weight
+			weight = weight * 3 % 211
+		}
+	}
+	values[0] = 211*(chars+1-4) + checksum%211
+	return values
+}
+
+// pairs returns the elements of each pair: the left character, the finder
+// pattern and the reversed right character, when there is one.
+func (db DataBarExpanded) pairs() [][]int {
+	values := db.values()
+	sequence := dbxSequences[(len(values)+1)/2-2]
+	pairs := make([][]int, len(sequence))
+	for i, f := range sequence {
+		finder := dbxFinders[f]
+		if i%2 != 0 {
+			finder = reversedWidths(finder)
+		}
+		pair := append(dataBarExpandedChars.widths(values[2*i]), finder...)
+		if 2*i+1 < len(values) {
+			pair = append(pair, reversedWidths(dataBarExpandedChars.widths(values[2*i+1]))...)
+		}
+		pairs[i] = pair
+	}
+	return pairs
+}
+
+// dbxRow is a row of a symbol, with the positions of its finder patterns.
+type dbxRow struct {
+	modules  []bool
+	finders  [][2]int
+	reversed bool
+}
+
+// dataBarExpandedRow returns the row of the pairs between guards. The rows of
+// even index are left to right, starting with a space, and the other ones start
+// with a bar, being reversed when there is an even number of pairs per row so
+// that the pairs keep their colors. A last row that would not keep them is
+// shifted by one module instead.
+func dataBarExpandedRow(pairs [][]int, index, perRow int, last bool) dbxRow {
+	elements := []int{1, 1}
+	var finders [][2]int
+	x := 2
+	for _, pair := range pairs {
+		elements = append(elements, pair...)
+		finders = append(finders, [2]int{x + dbxCharModules, x + dbxCharModules + dbxFinderModules})
+		for _, w := range pair {
+			x += w
+		}
+	}
+	elements = append(elements, 1, 1)
+	width := x + 2
+	if index%2 == 0 {
+		return dbxRow{modules: appendModules(nil, elements, false), finders: finders}
+	}
+	if perRow%2 != 0 {
+		return dbxRow{modules: appendModules(nil, elements, true), finders: finders}
+	}
+	if last && (perRow-len(pairs))%2 != 0 {
+		elements[0] = 2
+		for i := range finders {
+			finders[i][0]++
+			finders[i][1]++
+		}
+		return dbxRow{modules: appendModules(nil, elements, false), finders: finders}
+	}
+	for i, f := range finders {
+		finders[i] = [2]int{width - f[1], width - f[0]}
+	}
+	return dbxRow{modules: appendModules(nil, reversedWidths(elements), true), finders: finders, reversed: true}
+}
+
+// modules returns the rows of modules of the symbol, the rows of a stacked symbol
+// being separated by the complement of each row and an alternating pattern.
+func (db DataBarExpanded) modules() [][]bool {
+	pairs := db.pairs()
+	if db.segments == 0 {
+		row := dataBarExpandedRow(pairs, 0, len(pairs), true)
+		return appendRows(nil, row.modules, dbxRowHeight)
+	}
+	perRow := db.segments / 2
+	var rows []dbxRow
+	for i := 0; i < len(pairs); i += perRow {
+		end := i + perRow
+		if end > len(pairs) {
+			end = len(pairs)
+		}
+		rows = append(rows, dataBarExpandedRow(pairs[i:end], len(rows), perRow, end == len(pairs)))
+	}
+	width := 0
+	for _, r := range rows {
+		if len(r.modules) > width {
+			width = len(r.modules)
+		}
+	}
+	pad := func(row []bool) []bool {
+		return append(row, make([]bool, width-len(row))...)
+	}
+	middle := make([]bool, width)
+	for x := 5; x < (2*dbxCharModules+dbxFinderModules)*perRow && x < width; x += 2 {
+		middle[x] = true
+	}
+	var modules [][]bool
+	for i, r := range rows {
+		if i > 0 {
+			modules = append(modules, pad(dataBarSeparator(rows[i-1].modules, rows[i-1].finders, rows[i-1].reversed)))
+			modules = append(modules, middle)
+			modules = append(modules, pad(dataBarSeparator(r.modules, r.finders, r.reversed)))
+		}
+		modules = appendRows(modules, pad(r.modules), dbxRowHeight)
+	}
+	return modules
+}
+
+func (db DataBarExpanded) symbol() matrixSymbol {
+	return matrixSymbol{modules: db.modules()}
+}
+
+func (db DataBarExpanded) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := db.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
+
+func (db DataBarExpanded) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := db.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
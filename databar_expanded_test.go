@@ -0,0 +1,89 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestDataBarExpandedMethods(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		bits int
+	}{
+		// Weight in kilograms and date
+		{"(01)98898765432106(3103)001750(11)991231", 84},
+		// Weight in pounds without date
+		{"(01)98898765432106(3202)001234", 60},
+		{"(01)98898765432106(3203)012345", 60},
+		// Weight in pounds too heavy for the short method
+		{"(01)98898765432106(3202)012345", 84},
+		// GTIN only
+		{"(01)00012345678905", 48},
+		// General purpose data
+		{"(10)12", 19},
+	} {
+		db, err := DataBarExpandedFromString(test.s)
+		if err != nil {
+			t.Errorf("Unexpected error %v for %s", err, test.s)
+			continue
+		}
+		if len(db.bits) != test.bits {
+			t.Errorf("Unexpected %d bits for %s", len(db.bits), test.s)
+		}
+	}
+}
+
+func TestDataBarExpandedValues(t *testing.T) {
+	db, _ := DataBarExpandedFromString("(01)00012345678905")
+	values := db.values()
+	if len(values) != 5 {
+		t.Fatalf("Unexpected number of characters %d", len(values))
+	}
+	// The characters hold the encoded bits, with the odd number of symbol
+	// characters in the variable length field
+	for i, bit := range db.bits {
+		v := values[1+i/dbxCharBits]>>uint(dbxCharBits-1-i%dbxCharBits)&1 != 0
+		if i == db.lengthField {
+			bit = true
+		}
+		if v != bit {
+			t.Fatalf("Unexpected bit %d of %x", i, values)
+		}
+	}
+	if values[0]/211 != 1 {
+		t.Errorf("Unexpected check character %d", values[0])
+	}
+}
+
+func TestDataBarExpandedSegments(t *testing.T) {
+	db, _ := DataBarExpandedFromString("(01)98898765432106(3202)012345(15)991231(10)ABC123")
+	for _, segments := range []int{0, 1, 3, 24} {
+		if _, err := db.WithSegmentsPerRow(segments); err == nil {
+			t.Errorf("Unexpected valid segments %d", segments)
+		}
+	}
+	stacked, _ := db.WithSegmentsPerRow(4)
+	pairs := (len(stacked.values()) + 1) / 2
+	rows := (pairs + 1) / 2
+	if h := len(stacked.modules()); h != rows*dbxRowHeight+(rows-1)*3 {
+		t.Errorf("Unexpected height %d", h)
+	}
+	// The last row has at least two characters
+	for segments := 2; segments <= dbxMaxSegments; segments += 2 {
+		stacked, _ = db.WithSegmentsPerRow(segments)
+		if len(stacked.values())%segments == 1 {
+			t.Errorf("Single character in the last row of %d segments", segments)
+		}
+	}
+}
+
+func TestDataBarExpandedInvalid(t *testing.T) {
+	long := "(8200)http://example.com/"
+	for len(long) < 100 {
+		long += "abcdefghij"
+	}
+	for _, s := range []string{"", long, "(10)ABCé"} {
+		if _, err := DataBarExpandedFromString(s); err == nil {
+			t.Errorf("Unexpected valid element string %q", s)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package barcode
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDataBarCharSets(t *testing.T) {
+	for _, test := range []struct {
+		cs      dataBarCharSet
+		values  int
+		modules int
+	}{
+		{dataBarOutside, 2841, 16},
+		{dataBarInside, 1597, 15},
+		{dataBarExpandedChars, 4192, 17},
+	} {
+		seen := make(map[string]bool)
+		for v := 0; v < test.values; v++ {
+			widths := test.cs.widths(v)
+			sum := 0
+			for _, w := range widths {
+				sum += w
+			}
+			if sum != test.modules {
+				t.Fatalf("Unexpected widths %v of %d", widths, v)
+			}
+			key := fmt.Sprint(widths)
+			if seen[key] {
+				t.Fatalf("Duplicate widths %v of %d", widths, v)
+			}
+			seen[key] = true
+		}
+	}
+}
+
+func TestDataBarCharWidths(t *testing.T) {
+	for _, test := range []struct {
+		cs       dataBarCharSet
+		value    int
+		expected []int
+	}{
+		{dataBarOutside, 0, []int{1, 1, 1, 1, 2, 1, 8, 1}},
+		{dataBarInside, 0, []int{1, 1, 1, 1, 1, 1, 2, 7}},
+		{dataBarExpandedChars, 0, []int{1, 1, 1, 1, 3, 1, 7, 2}},
+	} {
+		widths := test.cs.widths(test.value)
+		if !equalCodewords(widths, test.expected) {
+			t.Errorf("Unexpected widths %v", widths)
+		}
+	}
+}
+
+func TestDataBarModules(t *testing.T) {
+	gtin, _ := GTIN14FromString("00012345678905")
+	db := DataBarFromGTIN14(gtin)
+	if len(db.elements()) != 46 {
+		t.Errorf("Unexpected number of elements %d", len(db.elements()))
+	}
+	for _, test := range []struct {
+		style  DataBarStyle
+		width  int
+		height int
+	}{
+		{DataBarOmnidirectional, 96, 33},
+		{DataBarTruncated, 96, 13},
+		{DataBarStacked, 50, 13},
+		{DataBarStackedOmnidirectional, 50, 69},
+	} {
+		modules := db.WithStyle(test.style).modules()
+		if len(modules) != test.height {
+			t.Errorf("Unexpected height %d of style %d", len(modules), test.style)
+		}
+		for _, row := range modules {
+			if len(row) != test.width {
+				t.Errorf("Unexpected width %d of style %d", len(row), test.style)
+				break
+			}
+		}
+	}
+}
+
+func TestDataBarChecksum(t *testing.T) {
+	// Check values of ISO/IEC 24724
+	for _, test := range []struct {
+		gtin     string
+		checksum int
+	}{
+		{"00012345678905", 13},
+		{"20012345678909", 29},
+	} {
+		gtin, _ := GTIN14FromString(test.gtin)
+		if c := dataBarChecksum(DataBarFromGTIN14(gtin).characters()); c != test.checksum {
+			t.Errorf("Unexpected check value %d of %s", c, test.gtin)
+		}
+	}
+}
+
+func TestDataBarElements(t *testing.T) {
+	// The example of ISO/IEC 24724, whose check value 29 selects the finder
+	// patterns 3 and 3
+	gtin, _ := GTIN14FromString("20012345678909")
+	expected := []int{
+		1, 1, 1, 1, 3, 3, 1, 1, 5, 1,
+		3, 1, 9, 1, 1,
+		1, 2, 2, 1, 2, 1, 5, 1,
+		2, 5, 1, 2, 1, 1, 1, 2,
+		1, 1, 9, 1, 3,
+		3, 2, 1, 2, 1, 2, 3, 2,
+		1, 1,
+	}
+	if elements := DataBarFromGTIN14(gtin).elements(); !equalCodewords(elements, expected) {
+		t.Errorf("Unexpected elements %v", elements)
+	}
+}
+
+func TestDataBarStackedSeparator(t *testing.T) {
+	gtin, _ := GTIN14FromString("00012345678905")
+	modules := DataBarFromGTIN14(gtin).WithStyle(DataBarStacked).modules()
+	top, separator, bottom := modules[0], modules[5], modules[6]
+	for x := 0; x < len(separator); x++ {
+		if x < 4 || x >= len(separator)-4 {
+			if separator[x] {
+				t.Errorf("Unexpected dark module %d", x)
+			}
+			continue
+		}
+		if top[x] == bottom[x] && separator[x] == top[x] {
+			t.Errorf("Unexpected separator module %d", x)
+		}
+		if top[x] != bottom[x] && separator[x] == separator[x-1] {
+			t.Errorf("Unexpected separator module %d", x)
+		}
+	}
+}
+
+func TestDataBarFromEAN13(t *testing.T) {
+	ean, _ := EAN13FromString("4006381333931")
+	if db := DataBarFromEAN13(ean); db.GTIN14().Code14() != 4006381333931 {
+		t.Errorf("Unexpected GTIN %d", db.GTIN14().Code14())
+	}
+}
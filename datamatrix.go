@@ -0,0 +1,376 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+)
+
+// DataMatrixShape restricts the sizes of a Data Matrix symbol.
+type DataMatrixShape int
+
+const (
+	DataMatrixSquare DataMatrixShape = iota
+	DataMatrixRectangle
+	// The smallest of the square and rectangular sizes
+	DataMatrixAny
+)
+
+// dmSize describes a symbol size of ECC 200.
+type dmSize struct {
+	rows, cols int
+	// Size of each data region, without its finder and timing patterns
+	regionRows, regionCols int
+	dataCodewords          int
+	eccCodewords           int
+	blocks                 int
+}
+
+// dmSizes lists the sizes of ECC 200 by increasing capacity, square first.
+var dmSizes = []dmSize{
+	{10, 10, 8, 8, 3, 5, 1},
+	{12, 12, 10, 10, 5, 7, 1},
+	{14, 14, 12, 12, 8, 10, 1},
+	{16, 16, 14, 14, 12, 12, 1},
+	{18, 18, 16, 16, 18, 14, 1},
+	{20, 20, 18, 18, 22, 18, 1},
+	{22, 22, 20, 20, 30, 20, 1},
+	{24, 24, 22, 22, 36, 24, 1},
+	{26, 26, 24, 24, 44, 28, 1},
+	{32, 32, 14, 14, 62, 36, 1},
+	{36, 36, 16, 16, 86, 42, 1},
+	{40, 40, 18, 18, 114, 48, 1},
+	{44, 44, 20, 20, 144, 56, 1},
+	{48, 48, 22, 22, 174, 68, 1},
+	{52, 52, 24, 24, 204, 84, 2},
+	{64, 64, 14, 14, 280, 112, 2},
+	{72, 72, 16, 16, 368, 144, 4},
+	{80, 80, 18, 18, 456, 192, 4},
+	{88, 88, 20, 20, 576, 224, 4},
+	{96, 96, 22, 22, 696, 272, 4},
+	{104, 104, 24, 24, 816, 336, 6},
+	{120, 120, 18, 18, 1050, 408, 6},
+	{132, 132, 20, 20, 1304, 496, 8},
+	{144, 144, 22, 22, 1558, 620, 10},
+	{8, 18, 6, 16, 5, 7, 1},
+	{8, 32, 6, 14, 10, 11, 1},
+	{12, 26, 10, 24, 16, 14, 1},
+	{12, 36, 10, 16, 22, 18, 1},
+	{16, 36, 14, 16, 32, 24, 1},
+	{16, 48, 14, 22, 49, 28, 1},
+}
+
+func (size dmSize) square() bool {
+	return size.rows == size.cols
+}
+
+const dmDefaultQuietZone = 1
+
+var errDataMatrixTooLong = errors.New("Data too long for a Data Matrix")
+
+// selectDMSize returns the smallest size of the shape holding n data codewords.
+func selectDMSize(n int, shape DataMatrixShape) (dmSize, error) {
+	found := false
+	var best dmSize
+	for _, size := range dmSizes {
+		if size.dataCodewords < n || (shape == DataMatrixSquare && !size.square()) ||
+			(shape == DataMatrixRectangle && size.square()) {
+			continue
+		}
+		if !found || size.rows*size.cols < best.rows*best.cols {
+			best, found = size, true
+		}
+	}
+	if !found {
+		return dmSize{}, errDataMatrixTooLong
+	}
+	return best, nil
+}
+
+var dmField = newGaloisField(256, 0x12d)
+
+// dmInterleave adds the error correction codewords. With several blocks, each
+// block takes every blocks-th codeword.
+func dmInterleave(data []int, size dmSize) []int {
+	eccPerBlock := size.eccCodewords / size.blocks
+	result := make([]int, len(data)+size.eccCodewords)
+	copy(result, data)
+	for b := 0; b < size.blocks; b++ {
+		var block []int
+		for i := b; i < len(data); i += size.blocks {
+			block = append(block, data[i])
+		}
+		for j, c := range dmField.rsEncode(block, eccPerBlock, 1) {
+			result[len(data)+j*size.blocks+b] = c
+		}
+	}
+	return result
+}
+
+// dmPlacement places the codewords in the mapping matrix of nrow by ncol modules,
+// following the diagonal pattern of ECC 200.
+type dmPlacement struct {
+	nrow, ncol int
+	modules    [][]bool
+	placed     [][]bool
+	codewords  []int
+}
+
+// module places bit (1 for the most significant) of codeword chr at row, col,
+// wrapping around the edges.
+func (p *dmPlacement) module(row, col, chr, bit int) {
+	if row < 0 {
+		row += p.nrow
+		col += 4 - (p.nrow+4)%8
+	}
+	if col < 0 {
+		col += p.ncol
+		row += 4 - (p.ncol+4)%8
+	}
+	p.placed[row][col] = true
+	if chr < len(p.codewords) {
+		p.modules[row][col] = p.codewords[chr]>>uint(8-bit)&1 != 0
+	}
+}
+
+// utah places the 8 bits of a codeword in the standard shape ending at row, col.
+func (p *dmPlacement) utah(row, col, chr int) {
+	p.module(row-2, col-2, chr, 1)
+	p.module(row-2, col-1, chr, 2)
+	p.module(row-1, col-2, chr, 3)
+	p.module(row-1, col-1, chr, 4)
+	p.module(row-1, col, chr, 5)
+	p.module(row, col-2, chr, 6)
+	p.module(row, col-1, chr, 7)
+	p.module(row, col, chr, 8)
+}
+
+// corner places a codeword in one of the special shapes of the corners, given as
+// the row and column of each bit.
+func (p *dmPlacement) corner(chr int, positions [8][2]int) {
+	for i, pos := range positions {
+		p.module(pos[0], pos[1], chr, i+1)
+	}
+}
+
+func (p *dmPlacement) place() {
+	nrow, ncol := p.nrow, p.ncol
+	chr, row, col := 0, 4, 0
+	for row < nrow || col < ncol {
+		if row == nrow && col == 0 {
+			p.corner(chr, [8][2]int{{nrow - 1, 0}, {nrow - 1, 1}, {nrow - 1, 2}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 1}, {2, ncol - 1}, {3, ncol - 1}})
+			chr++
+		}
+		if row == nrow-2 && col == 0 && ncol%4 != 0 {
+			p.corner(chr, [8][2]int{{nrow - 3, 0}, {nrow - 2, 0}, {nrow - 1, 0}, {0, ncol - 4}, {0, ncol - 3}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 1}})
+			chr++
+		}
+		if row == nrow-2 && col == 0 && ncol%8 == 4 {
+			p.corner(chr, [8][2]int{{nrow - 3, 0}, {nrow - 2, 0}, {nrow - 1, 0}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 1}, {2, ncol - 1}, {3, ncol - 1}})
+			chr++
+		}
+		if row == nrow+4 && col == 2 && ncol%8 == 0 {
+			p.corner(chr, [8][2]int{{nrow - 1, 0}, {nrow - 1, ncol - 1}, {0, ncol - 3}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 3}, {1, ncol - 2}, {1, ncol - 1}})
+			chr++
+		}
+		// Sweep upward diagonally
+		for {
+			if row < nrow && col >= 0 && !p.placed[row][col] {
+				p.utah(row, col, chr)
+				chr++
+			}
+			row -= 2
+			col += 2
+			if row < 0 || col >= ncol {
+				break
+			}
+		}
+		row++
+		col += 3
+		// Sweep downward diagonally
+		for {
+			if row >= 0 && col < ncol && !p.placed[row][col] {
+				p.utah(row, col, chr)
+				chr++
+			}
+			row += 2
+			col -= 2
+			if row >= nrow || col < 0 {
+				break
+			}
+		}
+		row += 3
+		col++
+	}
+	// The unused corner of some sizes has a fixed pattern
+	if !p.placed[nrow-1][ncol-1] {
+		p.modules[nrow-1][ncol-1] = true
+		p.modules[nrow-2][ncol-2] = true
+	}
+}
+
+// dataMatrixModules lays out the codewords in the data regions, each surrounded by
+// its finder and timing patterns.
+func dataMatrixModules(codewords []int, size dmSize) [][]bool {
+	hRegions := size.cols / (size.regionCols + 2)
+	vRegions := size.rows / (size.regionRows + 2)
+	p := &dmPlacement{
+		nrow:      vRegions * size.regionRows,
+		ncol:      hRegions * size.regionCols,
+		codewords: codewords,
+	}
+	p.modules = make([][]bool, p.nrow)
+	p.placed = make([][]bool, p.nrow)
+	for i := range p.modules {
+		p.modules[i] = make([]bool, p.ncol)
+		p.placed[i] = make([]bool, p.ncol)
+	}
+	p.place()
+
+	m := newQRMatrix(size.cols, size.rows)
+	h, w := size.regionRows+2, size.cols/hRegions
+	for y := 0; y < size.rows; y++ {
+		for x := 0; x < size.cols; x++ {
+			ry, rx := y%h, x%w
+			switch {
+			case rx == 0 || ry == h-1:
+				m.modules[y][x] = true
+			case ry == 0:
+				m.modules[y][x] = rx%2 == 0
+			case rx == w-1:
+				m.modules[y][x] = ry%2 == 1
+			default:
+				m.modules[y][x] = p.modules[y/h*size.regionRows+ry-1][x/w*size.regionCols+rx-1]
+			}
+		}
+	}
+	return m.modules
+}
+
+// DataMatrix is a Data Matrix ECC 200 symbol.
+type DataMatrix struct {
+	modules   [][]bool
+	quietZone int
+}
+
+// DataMatrixFromString encodes Latin-1 text in the smallest symbol of the shape.
+func DataMatrixFromString(text string, shape DataMatrixShape) (DataMatrix, error) {
+	var data []int
+	for _, c := range text {
+		if c > 255 {
+			return DataMatrix{}, errInvalidDataMatrix
+		}
+		data = append(data, int(c))
+	}
+	return dataMatrixFromData(data, shape)
+}
+
+// DataMatrixFromGS1 encodes a GS1 DataMatrix, starting with FNC1 and with FNC1
+// after each element of variable length.
+func DataMatrixFromGS1(es GS1ElementString, shape DataMatrixShape) (DataMatrix, error) {
+	if len(es.elements) == 0 {
+		return DataMatrix{}, errInvalidGS1
+	}
+	data := []int{dmFNC1}
+	for i, e := range es.elements {
+		for _, c := range e.ai + e.data {
+			data = append(data, int(c))
+		}
+		if needsGS1Separator(es.elements, i) {
+			data = append(data, dmFNC1)
+		}
+	}
+	return dataMatrixFromData(data, shape)
+}
+
+// dataMatrixCodewords returns the data codewords of the smallest symbol holding
+// data, padded to its capacity, and its size.
+func dataMatrixCodewords(data []int, shape DataMatrixShape) ([]int, dmSize, error) {
+	codewords, mode, err := encodeDataMatrix(data)
+	if err != nil {
+		return nil, dmSize{}, err
+	}
+	// The final unlatch of C40, Text and X12 can be left out when the data fills
+	// the symbol, the one of EDIFACT when at most 2 codewords are left, which the
+	// decoder reads in ASCII
+	n := len(codewords)
+	if size, err := selectDMSize(n-1, shape); err == nil {
+		switch mode {
+		case dmC40, dmText, dmX12:
+			if size.dataCodewords == n-1 {
+				codewords = codewords[:n-1]
+			}
+		case dmEDIFACT:
+			if size.dataCodewords-(n-1) <= 2 {
+				codewords = codewords[:n-1]
+			}
+		}
+	}
+	size, err := selectDMSize(len(codewords), shape)
+	if err != nil {
+		return nil, dmSize{}, err
+	}
+	return padDataMatrix(codewords, size.dataCodewords), size, nil
+}
+
+func dataMatrixFromData(data []int, shape DataMatrixShape) (DataMatrix, error) {
+	codewords, size, err := dataMatrixCodewords(data, shape)
+	if err != nil {
+		return DataMatrix{}, err
+	}
+	codewords = dmInterleave(codewords, size)
+	return DataMatrix{
+		modules:   dataMatrixModules(codewords, size),
+		quietZone: dmDefaultQuietZone,
+	}, nil
+}
+
+// Rows returns the number of rows of modules, without the quiet zone.
+func (dm DataMatrix) Rows() int {
+	return len(dm.modules)
+}
+
+// Columns returns the number of columns of modules, without the quiet zone.
+func (dm DataMatrix) Columns() int {
+	return len(dm.modules[0])
+}
+
+// Module returns whether the module at column x and row y is dark.
+func (dm DataMatrix) Module(x, y int) bool {
+	return dm.modules[y][x]
+}
+
+// WithQuietZone returns a copy with a quiet zone of the given number of modules
+// on each side, 1 by default.
+func (dm DataMatrix) WithQuietZone(modules int) DataMatrix {
+	dm.quietZone = modules
+	return dm
+}
+
+func (dm DataMatrix) symbol() matrixSymbol {
+	return matrixSymbol{
+		modules:   dm.modules,
+		quietZone: dm.quietZone,
+	}
+}
+
+func (dm DataMatrix) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := dm.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
+
+func (dm DataMatrix) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := dm.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
@@ -0,0 +1,275 @@
+package barcode
+
+import (
+	"errors"
+)
+
+// Encodation modes of Data Matrix
+const (
+	dmASCII = iota
+	dmC40
+	dmText
+	dmX12
+	dmEDIFACT
+	dmBase256
+	dmModes
+)
+
+// Codewords with a special meaning
+const (
+	dmPad         = 129
+	dmLatchC40    = 230
+	dmLatchBase   = 231
+	dmFNC1Value   = 232
+	dmUpperShift  = 235
+	dmLatchX12    = 238
+	dmLatchText   = 239
+	dmLatchEDI    = 240
+	dmUnlatch     = 254
+	dmEDIUnlatch  = 31
+	dmASCIIDigits = 130
+)
+
+// dmFNC1 is passed to the encoder along with the Latin-1 characters.
+const dmFNC1 = 256
+
+var dmLatches = [dmModes]int{0, dmLatchC40, dmLatchText, dmLatchX12, dmLatchEDI, dmLatchBase}
+
+var errInvalidDataMatrix = errors.New("Character cannot be encoded in Data Matrix")
+
+// c40Values returns the values of c in the C40 or Text set, with the shifts.
+func c40Values(mode int, c int) []int {
+	if c == dmFNC1 {
+		return []int{1, 27}
+	}
+	if c >= 128 {
+		return append([]int{1, 30}, c40Values(mode, c-128)...)
+	}
+	switch {
+	case c == ' ':
+		return []int{3}
+	case c >= '0' && c <= '9':
+		return []int{c - '0' + 4}
+	case mode == dmC40 && c >= 'A' && c <= 'Z', mode == dmText && c >= 'a' && c <= 'z':
+		return []int{c&^0x20 - 'A' + 14}
+	case c < 32:
+		return []int{0, c}
+	case c >= 33 && c <= 47:
+		return []int{1, c - 33}
+	case c >= 58 && c <= 64:
+		return []int{1, c - 58 + 15}
+	case c >= 91 && c <= 95:
+		return []int{1, c - 91 + 22}
+	case mode == dmText && c == '`':
+		return []int{2, 0}
+	case mode == dmText && c >= 'A' && c <= 'Z':
+		return []int{2, c - 'A' + 1}
+	case mode == dmText && c >= '{':
+		return []int{2, c - '{' + 27}
+	}
+	// Lower case letters and the rest of C40
+	return []int{2, c - 96}
+}
+
+// x12Value returns the value of c in X12, or -1.
+func x12Value(c int) int {
+	switch {
+	case c == '\r':
+		return 0
+	case c == '*':
+		return 1
+	case c == '>':
+		return 2
+	case c == ' ':
+		return 3
+	case c >= '0' && c <= '9':
+		return c - '0' + 4
+	case c >= 'A' && c <= 'Z':
+		return c - 'A' + 14
+	}
+	return -1
+}
+
+// dmStep encodes the data at the start of data in the given mode, returning the
+// codewords and the number of characters consumed, or nil if it cannot be encoded.
+// C40, Text and X12 consume whole triplets of values, EDIFACT whole quadruplets.
+func dmStep(mode int, data []int) ([]int, int) {
+	switch mode {
+	case dmASCII:
+		c := data[0]
+		switch {
+		case len(data) >= 2 && isDigitChar(c) && isDigitChar(data[1]):
+			return []int{dmASCIIDigits + (c-'0')*10 + data[1] - '0'}, 2
+		case c == dmFNC1:
+			return []int{dmFNC1Value}, 1
+		case c >= 128:
+			return []int{dmUpperShift, c - 127}, 1
+		}
+		return []int{c + 1}, 1
+	case dmC40, dmText:
+		var values []int
+		k := 0
+		for ; k < len(data) && (k == 0 || len(values)%3 != 0); k++ {
+			values = append(values, c40Values(mode, data[k])...)
+		}
+		if len(values)%3 != 0 {
+			return nil, 0
+		}
+		return packTriplets(values), k
+	case dmX12:
+		if len(data) < 3 {
+			return nil, 0
+		}
+		values := make([]int, 3)
+		for k := range values {
+			if values[k] = x12Value(data[k]); values[k] < 0 {
+				return nil, 0
+			}
+		}
+		return packTriplets(values), 3
+	case dmEDIFACT:
+		if len(data) < 4 {
+			return nil, 0
+		}
+		v := 0
+		for _, c := range data[:4] {
+			if c < 32 || c > 94 {
+				return nil, 0
+			}
+			v = v<<6 | c&0x3f
+		}
+		return []int{v >> 16, v >> 8 & 0xff, v & 0xff}, 4
+	case dmBase256:
+		if data[0] == dmFNC1 {
+			return nil, 0
+		}
+		return []int{data[0]}, 1
+	}
+	return nil, 0
+}
+
+func packTriplets(values []int) []int {
+	var codewords []int
+	for i := 0; i+3 <= len(values); i += 3 {
+		v := 1600*values[i] + 40*values[i+1] + values[i+2] + 1
+		codewords = append(codewords, v>>8, v&0xff)
+	}
+	return codewords
+}
+
+// dmSwitchCost returns the number of codewords to switch between two modes.
+// Entering Base 256 includes its length field.
+func dmSwitchCost(from, to int) int {
+	cost := 0
+	if from != to {
+		if from != dmASCII && from != dmBase256 {
+			cost++
+		}
+		if to != dmASCII {
+			cost++
+		}
+		if to == dmBase256 {
+			cost++
+		}
+	}
+	return cost
+}
+
+// dmExit returns the codewords ending a mode at the end of the data.
+func dmExit(mode int) []int {
+	switch mode {
+	case dmC40, dmText, dmX12:
+		return []int{dmUnlatch}
+	case dmEDIFACT:
+		return []int{dmEDIUnlatch << 2}
+	}
+	return nil
+}
+
+// encodeDataMatrix returns the codewords encoding data with the fewest codewords,
+// choosing the mode of each step by dynamic programming, and the mode ending the
+// data. A Base 256 run ends in ASCII, its length field marking its end.
+func encodeDataMatrix(data []int) ([]int, int, error) {
+	n := len(data)
+	const infinity = 1 << 30
+	cost := make([][dmModes]int, n+1)
+	next := make([][dmModes]int, n+1)
+	for m := 0; m < dmModes; m++ {
+		cost[n][m] = len(dmExit(m))
+	}
+	for i := n - 1; i >= 0; i-- {
+		for m := 0; m < dmModes; m++ {
+			cost[i][m] = infinity
+			for t := 0; t < dmModes; t++ {
+				codewords, k := dmStep(t, data[i:])
+				if codewords == nil {
+					continue
+				}
+				c := dmSwitchCost(m, t) + len(codewords) + cost[i+k][t]
+				if c < cost[i][m] {
+					cost[i][m], next[i][m] = c, t
+				}
+			}
+		}
+		if cost[i][dmASCII] >= infinity {
+			return nil, 0, errInvalidDataMatrix
+		}
+	}
+	var codewords []int
+	mode := dmASCII
+	for i := 0; i < n; {
+		t := next[i][mode]
+		if t != mode {
+			codewords = append(codewords, dmExit(mode)...)
+			if t != dmASCII {
+				codewords = append(codewords, dmLatches[t])
+			}
+			mode = t
+		}
+		if mode == dmBase256 {
+			// The length field precedes the data, all randomized
+			end := i
+			for end < n && next[end][dmBase256] == dmBase256 {
+				end++
+			}
+			length := end - i
+			field := []int{length}
+			if length > 249 {
+				field = []int{249 + length/250, length % 250}
+			}
+			for _, c := range append(field, data[i:end]...) {
+				codewords = append(codewords, randomize255(c, len(codewords)+1))
+			}
+			i = end
+			mode = dmASCII
+			continue
+		}
+		step, k := dmStep(mode, data[i:])
+		codewords = append(codewords, step...)
+		i += k
+	}
+	return append(codewords, dmExit(mode)...), mode, nil
+}
+
+// randomize255 applies the 255-state randomizing of Base 256 to the codeword at
+// the 1-based position.
+func randomize255(c, position int) int {
+	return (c + 149*position%255 + 1) % 256
+}
+
+// padDataMatrix fills the capacity with the pad codeword, randomized with the
+// 253-state algorithm after the first one.
+func padDataMatrix(codewords []int, capacity int) []int {
+	n := len(codewords)
+	for i := n; i < capacity; i++ {
+		pad := dmPad
+		if i > n {
+			pad = dmPad + 149*(i+1)%253 + 1
+			if pad > 254 {
+				pad -= 254
+			}
+		}
+		codewords = append(codewords, pad)
+	}
+	return codewords
+}
@@ -0,0 +1,246 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestEncodeDataMatrix(t *testing.T) {
+	data := []struct {
+		text     string
+		expected []int
+	}{
+		{"123456", []int{142, 164, 186}},
+		{"AIMAIMAIM", []int{230, 91, 11, 91, 11, 91, 11, 254}},
+		{"a1", []int{98, 50}},
+		{"é", []int{235, 106}},
+		{"ABC>ABC123>AB", []int{66, 238, 96, 67, 89, 233, 32, 56, 14, 192, 254}},
+	}
+	for _, d := range data {
+		var input []int
+		for _, c := range d.text {
+			input = append(input, int(c))
+		}
+		codewords, _, err := encodeDataMatrix(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(codewords) != len(d.expected) {
+			t.Fatalf("Unexpected codewords of %q: %v", d.text, codewords)
+		}
+		for i := range d.expected {
+			if codewords[i] != d.expected[i] {
+				t.Fatalf("Unexpected codewords of %q: %v", d.text, codewords)
+			}
+		}
+	}
+}
+
+func TestEncodeDataMatrixBase256(t *testing.T) {
+	input := []int{0xab, 0xe4, 0xf6, 0xfc, 0xe9, 0xbb}
+	codewords, _, err := encodeDataMatrix(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Latch, length and 6 bytes, randomized by position
+	if len(codewords) != 8 || codewords[0] != dmLatchBase {
+		t.Fatalf("Unexpected codewords %v", codewords)
+	}
+	if codewords[1] != randomize255(6, 2) || codewords[2] != randomize255(0xab, 3) {
+		t.Errorf("Unexpected codewords %v", codewords)
+	}
+}
+
+func TestPadDataMatrix(t *testing.T) {
+	codewords := padDataMatrix([]int{142, 164}, 5)
+	expected := []int{142, 164, 129, 220, 115}
+	for i := range expected {
+		if codewords[i] != expected[i] {
+			t.Fatalf("Unexpected codewords %v", codewords)
+		}
+	}
+	if codewords := padDataMatrix([]int{66}, 3); codewords[2] != 70 {
+		t.Errorf("Unexpected codewords %v", codewords)
+	}
+}
+
+// derandomize255 reverts randomize255 on the codeword at index i.
+func derandomize255(codewords []int, i int) int {
+	return (codewords[i] - 149*(i+1)%255 - 1 + 256) % 256
+}
+
+// decodeDataMatrix decodes data codewords following the rules of the decoder, among
+// which the return to ASCII when at most 2 codewords are left in EDIFACT.
+func decodeDataMatrix(codewords []int) (string, error) {
+	var text []rune
+	mode, shift, upper := dmASCII, 0, rune(0)
+	emit := func(c rune) {
+		text = append(text, c+upper)
+		upper = 0
+	}
+	for i := 0; i < len(codewords); {
+		c := codewords[i]
+		switch mode {
+		case dmASCII:
+			i++
+			switch {
+			case c == dmPad:
+				return string(text), nil
+			case c <= 128:
+				emit(rune(c - 1))
+			case c < dmLatchC40:
+				for _, d := range fmt.Sprintf("%02d", c-dmASCIIDigits) {
+					emit(d)
+				}
+			case c == dmLatchBase:
+				length := derandomize255(codewords, i)
+				i++
+				switch {
+				case length == 0:
+					length = len(codewords) - i
+				case length > 249:
+					length = 250*(length-249) + derandomize255(codewords, i)
+					i++
+				}
+				for end := i + length; i < end; i++ {
+					emit(rune(derandomize255(codewords, i)))
+				}
+			case c == dmUpperShift:
+				upper = 128
+			case c == dmLatchC40:
+				mode = dmC40
+			case c == dmLatchText:
+				mode = dmText
+			case c == dmLatchX12:
+				mode = dmX12
+			case c == dmLatchEDI:
+				mode = dmEDIFACT
+			default:
+				return "", errors.New("Unexpected ASCII codeword")
+			}
+		case dmC40, dmText, dmX12:
+			if c == dmUnlatch {
+				mode = dmASCII
+				i++
+				continue
+			}
+			if i+1 == len(codewords) {
+				// A single codeword left is read in ASCII
+				mode = dmASCII
+				continue
+			}
+			v := c<<8 | codewords[i+1] - 1
+			i += 2
+			for _, value := range []int{v / 1600, v / 40 % 40, v % 40} {
+				if mode == dmX12 {
+					emit(rune("\r*> 0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"[value]))
+					continue
+				}
+				switch shift {
+				case 0:
+					switch {
+					case value < 3:
+						shift = value + 1
+					case value == 3:
+						emit(' ')
+					case value < 14:
+						emit(rune('0' + value - 4))
+					case mode == dmC40:
+						emit(rune('A' + value - 14))
+					default:
+						emit(rune('a' + value - 14))
+					}
+					continue
+				case 1:
+					emit(rune(value))
+				case 2:
+					switch {
+					case value < 27:
+						emit(rune("!\"#$%&'()*+,-./:;<=>?@[\\]^_"[value]))
+					case value == 30:
+						upper = 128
+					default:
+						return "", errors.New("Unexpected C40 shift 2 value")
+					}
+				case 3:
+					switch {
+					case mode == dmC40:
+						emit(rune('`' + value))
+					case value == 0:
+						emit('`')
+					case value <= 26:
+						emit(rune('A' + value - 1))
+					default:
+						emit(rune('{' + value - 27))
+					}
+				}
+				shift = 0
+			}
+		case dmEDIFACT:
+			if len(codewords)-i <= 2 {
+				mode = dmASCII
+				continue
+			}
+			v := c<<16 | codewords[i+1]<<8 | codewords[i+2]
+			start := i
+			i += 3
+			for k := 0; k < 4; k++ {
+				value := v >> uint(18-6*k) & 0x3f
+				if value == dmEDIUnlatch {
+					// ASCII resumes at the codeword following the unlatch
+					mode = dmASCII
+					i = start + (6*k+13)/8
+					break
+				}
+				if value < 32 {
+					value |= 64
+				}
+				emit(rune(value))
+			}
+		}
+	}
+	return string(text), nil
+}
+
+func TestDataMatrixDecode(t *testing.T) {
+	data := []struct {
+		text  string
+		shape DataMatrixShape
+	}{
+		{"123456", DataMatrixSquare},
+		{"Hello, World!", DataMatrixSquare},
+		{"AIMAIMAIM", DataMatrixSquare},
+		{"ABC>ABC123>AB", DataMatrixSquare},
+		{"a1\u00e9", DataMatrixSquare},
+		{"\u00c0\u00c0\u00c0\u00c0\u00c0\u00c0\u00bb", DataMatrixSquare},
+		// EDIFACT ending with 2 codewords left, the unlatch left out
+		{"C&8D-3EDB^=B", DataMatrixSquare},
+		// EDIFACT ending with more codewords left, the unlatch kept
+		{"C&8D-3EDB^=B", DataMatrixRectangle},
+		{"C&8D-3EDB^=BC&8D", DataMatrixSquare},
+	}
+	for _, d := range data {
+		var input []int
+		for _, c := range d.text {
+			input = append(input, int(c))
+		}
+		codewords, _, err := dataMatrixCodewords(input, d.shape)
+		if err != nil {
+			t.Fatal(err)
+		}
+		text, err := decodeDataMatrix(codewords)
+		if err != nil || text != d.text {
+			t.Errorf("Unexpected text %q decoded from %v for %q: %v", text, codewords, d.text, err)
+		}
+	}
+	var input []int
+	for _, c := range "C&8D-3EDB^=B" {
+		input = append(input, int(c))
+	}
+	codewords, size, _ := dataMatrixCodewords(input, DataMatrixSquare)
+	expected := []int{240, 14, 110, 4, 183, 49, 68, 9, 239, 66, dmPad}
+	if size.rows != 16 || !equalCodewords(codewords[:len(expected)], expected) {
+		t.Errorf("Unexpected codewords %v in %dx%d", codewords, size.rows, size.cols)
+	}
+}
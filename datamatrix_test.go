@@ -0,0 +1,101 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestDataMatrixECC(t *testing.T) {
+	size, _ := selectDMSize(3, DataMatrixSquare)
+	codewords := dmInterleave([]int{142, 164, 186}, size)
+	expected := []int{142, 164, 186, 114, 25, 5, 88, 102}
+	for i := range expected {
+		if codewords[i] != expected[i] {
+			t.Fatalf("Unexpected codewords %v", codewords)
+		}
+	}
+}
+
+func TestSelectDMSize(t *testing.T) {
+	data := []struct {
+		n          int
+		shape      DataMatrixShape
+		rows, cols int
+	}{
+		{3, DataMatrixSquare, 10, 10},
+		{5, DataMatrixRectangle, 8, 18},
+		{5, DataMatrixAny, 12, 12},
+		{10, DataMatrixRectangle, 8, 32},
+		{20, DataMatrixAny, 20, 20},
+		{49, DataMatrixRectangle, 16, 48},
+		{1558, DataMatrixSquare, 144, 144},
+	}
+	for _, d := range data {
+		size, err := selectDMSize(d.n, d.shape)
+		if err != nil || size.rows != d.rows || size.cols != d.cols {
+			t.Errorf("Unexpected size of %d: %dx%d %v", d.n, size.rows, size.cols, err)
+		}
+	}
+	if _, err := selectDMSize(50, DataMatrixRectangle); err == nil {
+		t.Errorf("Unexpected size of 50 rectangular codewords")
+	}
+}
+
+func TestDataMatrixPlacement(t *testing.T) {
+	// Each module of the mapping matrix holds a bit, except the fixed corner
+	for _, size := range dmSizes {
+		hRegions := size.cols / (size.regionCols + 2)
+		vRegions := size.rows / (size.regionRows + 2)
+		p := &dmPlacement{nrow: vRegions * size.regionRows, ncol: hRegions * size.regionCols}
+		p.modules = make([][]bool, p.nrow)
+		p.placed = make([][]bool, p.nrow)
+		for i := range p.modules {
+			p.modules[i] = make([]bool, p.ncol)
+			p.placed[i] = make([]bool, p.ncol)
+		}
+		p.codewords = make([]int, size.dataCodewords+size.eccCodewords)
+		p.place()
+		free := 0
+		for _, row := range p.placed {
+			for _, placed := range row {
+				if !placed {
+					free++
+				}
+			}
+		}
+		if 8*len(p.codewords)+free != p.nrow*p.ncol || free > 4 {
+			t.Errorf("Unexpected placement in %dx%d: %d free modules", size.rows, size.cols, free)
+		}
+	}
+}
+
+func TestDataMatrix(t *testing.T) {
+	dm, err := DataMatrixFromString("Hello, World!", DataMatrixSquare)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Solid finder on the left and bottom, timing pattern on the top and right
+	n := dm.Rows()
+	for i := 0; i < n; i++ {
+		if !dm.Module(0, i) || !dm.Module(i, n-1) {
+			t.Fatalf("Unexpected finder at %d", i)
+		}
+		if dm.Module(i, 0) != (i%2 == 0) || dm.Module(n-1, i) != (i%2 == 1) {
+			t.Fatalf("Unexpected timing pattern at %d", i)
+		}
+	}
+	es, _ := GS1ElementStringFromString("(01)09501101530003(17)250101(10)ABC123(21)XYZ")
+	gs1, err := DataMatrixFromGS1(es, DataMatrixRectangle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gs1.Rows() >= gs1.Columns() {
+		t.Errorf("Unexpected size %dx%d", gs1.Rows(), gs1.Columns())
+	}
+	// The last Base 256 byte randomizes to 254, which is not an unlatch to leave out
+	if dm, _ := DataMatrixFromString("ÀÀÀÀÀÀ»", DataMatrixSquare); dm.Rows() != 16 {
+		t.Errorf("Unexpected size %dx%d", dm.Rows(), dm.Columns())
+	}
+	if _, err := DataMatrixFromString("Ā", DataMatrixSquare); err == nil {
+		t.Errorf("Unexpected valid text")
+	}
+}
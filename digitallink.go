@@ -0,0 +1,465 @@
+package barcode
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/bits"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DigitalLink is a GS1 Digital Link URI identifying a trade item by its GTIN,
+// with optional qualifiers and data attributes.
+type DigitalLink struct {
+	domain   string
+	elements []gs1Element
+}
+
+// DefaultDigitalLinkDomain is the GS1 global resolver.
+const DefaultDigitalLinkDomain = "https://id.gs1.org"
+
+// gs1Qualifiers lists the AIs that go in the path after the GTIN, in the order
+// required in the path.
+var gs1Qualifiers = []string{"22", "10", "21"}
+
+// gs1PrimaryKeys lists the AIs that can identify the item of a Digital Link.
+var gs1PrimaryKeys = map[string]bool{
+	"00": true, "01": true, "253": true, "255": true, "401": true, "402": true, "414": true,
+	"417": true, "8003": true, "8004": true, "8006": true, "8010": true, "8013": true,
+	"8017": true, "8018": true,
+}
+
+var (
+	errInvalidDigitalLink = errors.New("Invalid GS1 Digital Link URI")
+	errNoDigitalLinkGTIN  = errors.New("GS1 Digital Link does not identify a GTIN")
+)
+
+func DigitalLinkFromGTIN14(gtin GTIN14) DigitalLink {
+	return DigitalLink{
+		domain:   DefaultDigitalLinkDomain,
+		elements: []gs1Element{{"01", gtin.String()}},
+	}
+}
+
+func DigitalLinkFromEAN13(ean EAN13) DigitalLink {
+	gtin, _ := GTIN14FromEAN13(0, ean)
+	return DigitalLinkFromGTIN14(gtin)
+}
+
+// WithDomain returns a copy using a custom resolver, such as "https://example.com/dl".
+func (dl DigitalLink) WithDomain(domain string) DigitalLink {
+	dl.domain = strings.TrimSuffix(domain, "/")
+	return dl
+}
+
+// WithElement returns a copy with the data of ai set, replacing any previous value.
+func (dl DigitalLink) WithElement(ai, data string) (DigitalLink, error) {
+	if gs1PrimaryKeys[ai] {
+		return dl, errInvalidDigitalLink
+	}
+	if err := validateGS1Element(ai, data); err != nil {
+		return dl, err
+	}
+	elements := make([]gs1Element, 0, len(dl.elements)+1)
+	for _, e := range dl.elements {
+		if e.ai != ai {
+			elements = append(elements, e)
+		}
+	}
+	dl.elements = append(elements, gs1Element{ai, data})
+	return dl, nil
+}
+
+// WithBatch returns a copy with the batch or lot number, AI (10).
+func (dl DigitalLink) WithBatch(batch string) (DigitalLink, error) {
+	return dl.WithElement("10", batch)
+}
+
+// WithSerial returns a copy with the serial number, AI (21).
+func (dl DigitalLink) WithSerial(serial string) (DigitalLink, error) {
+	return dl.WithElement("21", serial)
+}
+
+// WithExpiry returns a copy with the expiration date, AI (17).
+func (dl DigitalLink) WithExpiry(date time.Time) (DigitalLink, error) {
+	return dl.WithElement("17", date.Format("060102"))
+}
+
+// Domain returns the resolver, without trailing slash.
+func (dl DigitalLink) Domain() string {
+	return dl.domain
+}
+
+// GTIN returns the GTIN identifying the trade item.
+func (dl DigitalLink) GTIN() (GTIN14, error) {
+	return dl.ElementString().GTIN()
+}
+
+// ElementString returns the primary key followed by the qualifiers and the data attributes.
+func (dl DigitalLink) ElementString() GS1ElementString {
+	var path, query []gs1Element
+	for _, e := range dl.elements {
+		if gs1PrimaryKeys[e.ai] {
+			path = append(path, e)
+		}
+	}
+	for _, q := range gs1Qualifiers {
+		for _, e := range dl.elements {
+			if e.ai == q {
+				path = append(path, e)
+			}
+		}
+	}
+	for _, e := range dl.elements {
+		if !gs1PrimaryKeys[e.ai] && !isGS1Qualifier(e.ai) {
+			query = append(query, e)
+		}
+	}
+	return GS1ElementString{append(path, query...)}
+}
+
+func isGS1Qualifier(ai string) bool {
+	for _, q := range gs1Qualifiers {
+		if q == ai {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the uncompressed URI, with the qualifiers in the path and the
+// other data attributes in the query.
+func (dl DigitalLink) String() string {
+	var b strings.Builder
+	b.WriteString(dl.domain)
+	var query []string
+	for _, e := range dl.ElementString().elements {
+		if gs1PrimaryKeys[e.ai] || isGS1Qualifier(e.ai) {
+			b.WriteString("/" + e.ai + "/" + url.PathEscape(e.data))
+		} else {
+			query = append(query, e.ai+"="+url.QueryEscape(e.data))
+		}
+	}
+	if len(query) > 0 {
+		b.WriteString("?" + strings.Join(query, "&"))
+	}
+	return b.String()
+}
+
+// Compressed returns the URI with the element string packed in binary into a
+// single base64 path segment, following the GS1 Digital Link compression scheme.
+func (dl DigitalLink) Compressed() string {
+	var w bitWriter
+	for _, e := range dl.ElementString().elements {
+		compressGS1Element(&w, e)
+	}
+	return dl.domain + "/" + w.base64()
+}
+
+// DigitalLinkFromURI parses an uncompressed or compressed Digital Link URI. The
+// domain is everything before the primary key, or before the compressed segment.
+func DigitalLinkFromURI(uri string) (DigitalLink, error) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return DigitalLink{}, errInvalidDigitalLink
+	}
+	segments := strings.Split(strings.Trim(u.EscapedPath(), "/"), "/")
+	start := -1
+	for i := 0; i+1 < len(segments); i++ {
+		if gs1PrimaryKeys[segments[i]] {
+			start = i
+			break
+		}
+	}
+	var elements []gs1Element
+	if start < 0 {
+		// The last segment holds the compressed element string, which must start
+		// with a primary key like an uncompressed path. A last segment following
+		// an AI is the value of an uncompressed path without primary key.
+		start = len(segments) - 1
+		if start > 0 {
+			if _, ok := gs1AIs[segments[start-1]]; ok {
+				return DigitalLink{}, errInvalidDigitalLink
+			}
+		}
+		if elements, err = decompressGS1(segments[start]); err != nil {
+			return DigitalLink{}, err
+		}
+		if len(elements) == 0 || !gs1PrimaryKeys[elements[0].ai] {
+			return DigitalLink{}, errInvalidDigitalLink
+		}
+	} else {
+		if (len(segments)-start)%2 != 0 {
+			return DigitalLink{}, errInvalidDigitalLink
+		}
+		// Only the qualifiers follow the primary key, each once and in their order
+		q := 0
+		for i := start + 2; i < len(segments); i += 2 {
+			for q < len(gs1Qualifiers) && gs1Qualifiers[q] != segments[i] {
+				q++
+			}
+			if q == len(gs1Qualifiers) {
+				return DigitalLink{}, errInvalidDigitalLink
+			}
+			q++
+		}
+		for i := start; i < len(segments); i += 2 {
+			data, err := url.PathUnescape(segments[i+1])
+			if err != nil {
+				return DigitalLink{}, errInvalidDigitalLink
+			}
+			elements = append(elements, gs1Element{segments[i], data})
+		}
+	}
+	query, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return DigitalLink{}, errInvalidDigitalLink
+	}
+	// Other query parameters are not GS1 data and are ignored
+	var ais []string
+	for ai := range query {
+		if _, ok := gs1AIs[ai]; ok {
+			ais = append(ais, ai)
+		}
+	}
+	sort.Strings(ais)
+	for _, ai := range ais {
+		elements = append(elements, gs1Element{ai, query.Get(ai)})
+	}
+	for i, e := range elements {
+		if err := validateGS1Element(e.ai, e.data); err != nil {
+			return DigitalLink{}, err
+		}
+		if gs1PrimaryKeys[e.ai] != (i == 0) {
+			return DigitalLink{}, errInvalidDigitalLink
+		}
+	}
+	if len(elements) == 0 || elements[0].ai != "01" {
+		return DigitalLink{}, errNoDigitalLinkGTIN
+	}
+	prefix, _ := url.PathUnescape(strings.Join(segments[:start], "/"))
+	u.RawPath, u.Path, u.RawQuery, u.Fragment = "", "/"+prefix, "", ""
+	dl := DigitalLink{
+		domain:   strings.TrimSuffix(u.String(), "/"),
+		elements: elements,
+	}
+	// Sort the data attributes as they would appear in the element string
+	dl.elements = dl.ElementString().elements
+	return dl, nil
+}
+
+// The encodings of the components of variable length
+const (
+	dlNumeric = iota
+	dlLowerHex
+	dlUpperHex
+	dlBase64
+	dlASCII
+)
+
+const dlBase64Alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+var dlAlphabets = [...]string{
+	dlNumeric:  "0123456789",
+	dlLowerHex: "0123456789abcdef",
+	dlUpperHex: "0123456789ABCDEF",
+	dlBase64:   dlBase64Alphabet,
+}
+
+var dlCharBits = [...]int{0, 4, 4, 6, 7}
+
+// numericBits returns the number of bits needed for any number of n digits.
+func numericBits(n int) int {
+	return int(math.Ceil(float64(n) * math.Log2(10)))
+}
+
+type bitWriter struct {
+	bits []bool
+}
+
+func (w *bitWriter) write(value uint64, n int) {
+	for i := n - 1; i >= 0; i-- {
+		w.bits = append(w.bits, value>>uint(i)&1 == 1)
+	}
+}
+
+func (w *bitWriter) writeNumber(digits string) {
+	n, _ := new(big.Int).SetString("0"+digits, 10)
+	for i := numericBits(len(digits)) - 1; i >= 0; i-- {
+		w.bits = append(w.bits, n.Bit(i) == 1)
+	}
+}
+
+// base64 packs the bits, padded with zeros, 6 by 6 in the URL safe alphabet.
+func (w *bitWriter) base64() string {
+	var b strings.Builder
+	for i := 0; i < len(w.bits); i += 6 {
+		v := 0
+		for j := i; j < i+6; j++ {
+			v <<= 1
+			if j < len(w.bits) && w.bits[j] {
+				v |= 1
+			}
+		}
+		b.WriteByte(dlBase64Alphabet[v])
+	}
+	return b.String()
+}
+
+type bitReader struct {
+	bits []bool
+}
+
+func (r *bitReader) read(n int) (uint64, bool) {
+	if n > len(r.bits) {
+		return 0, false
+	}
+	var v uint64
+	for _, b := range r.bits[:n] {
+		v <<= 1
+		if b {
+			v |= 1
+		}
+	}
+	r.bits = r.bits[n:]
+	return v, true
+}
+
+func (r *bitReader) readNumber(digits int) (string, bool) {
+	n := numericBits(digits)
+	if n > len(r.bits) {
+		return "", false
+	}
+	v := new(big.Int)
+	for _, b := range r.bits[:n] {
+		v.Lsh(v, 1)
+		if b {
+			v.SetBit(v, 0, 1)
+		}
+	}
+	r.bits = r.bits[n:]
+	s := v.String()
+	if len(s) > digits {
+		return "", false
+	}
+	return strings.Repeat("0", digits-len(s)) + s, true
+}
+
+// dlEncoding returns the most compact encoding of a non numeric component.
+func dlEncoding(data string) int {
+	for _, e := range []int{dlNumeric, dlLowerHex, dlUpperHex, dlBase64} {
+		if strings.Trim(data, dlAlphabets[e]) == "" {
+			return e
+		}
+	}
+	return dlASCII
+}
+
+// compressGS1Element writes the AI as hexadecimal digits, followed by each
+// component. Numeric components are written as binary numbers, preceded by
+// their length when variable. Other components are preceded by a 3 bit
+// encoding and, when variable, their length.
+func compressGS1Element(w *bitWriter, e gs1Element) {
+	for _, d := range e.ai {
+		w.write(uint64(d-'0'), 4)
+	}
+	data := e.data
+	for _, c := range parseGS1Format(gs1AIs[e.ai].format) {
+		part := data
+		if c.fixed {
+			part, data = data[:c.length], data[c.length:]
+		}
+		numeric := c.charset == gs1CharacterSets['N']
+		encoding := dlNumeric
+		if !numeric {
+			encoding = dlEncoding(part)
+			w.write(uint64(encoding), 3)
+		}
+		if !c.fixed {
+			w.write(uint64(len(part)), bits.Len(uint(c.length)))
+		}
+		if encoding == dlNumeric {
+			w.writeNumber(part)
+			continue
+		}
+		for _, ch := range part {
+			v := uint64(ch)
+			if encoding != dlASCII {
+				v = uint64(strings.IndexRune(dlAlphabets[encoding], ch))
+			}
+			w.write(v, dlCharBits[encoding])
+		}
+	}
+}
+
+// decompressGS1 reads the elements written by compressGS1Element.
+func decompressGS1(segment string) ([]gs1Element, error) {
+	var r bitReader
+	for _, ch := range segment {
+		v := strings.IndexRune(dlBase64Alphabet, ch)
+		if v < 0 {
+			return nil, errInvalidDigitalLink
+		}
+		for i := 5; i >= 0; i-- {
+			r.bits = append(r.bits, v>>uint(i)&1 == 1)
+		}
+	}
+	var elements []gs1Element
+	// The padding is shorter than an AI
+	for len(r.bits) >= 8 {
+		ai := ""
+		for len(ai) < 4 {
+			d, _ := r.read(4)
+			if d > 9 {
+				return nil, errInvalidDigitalLink
+			}
+			ai += string(rune('0' + d))
+			if _, ok := gs1AIs[ai]; ok && len(ai) >= 2 {
+				break
+			}
+		}
+		if _, ok := gs1AIs[ai]; !ok {
+			return nil, errUnknownGS1AI
+		}
+		var data strings.Builder
+		for _, c := range parseGS1Format(gs1AIs[ai].format) {
+			numeric := c.charset == gs1CharacterSets['N']
+			encoding := uint64(dlNumeric)
+			ok := true
+			if !numeric {
+				encoding, ok = r.read(3)
+			}
+			length := uint64(c.length)
+			if ok && !c.fixed {
+				length, ok = r.read(bits.Len(uint(c.length)))
+			}
+			if !ok || encoding > dlASCII || length > uint64(c.length) {
+				return nil, errInvalidDigitalLink
+			}
+			if encoding == dlNumeric {
+				part, ok := r.readNumber(int(length))
+				if !ok {
+					return nil, errInvalidDigitalLink
+				}
+				data.WriteString(part)
+				continue
+			}
+			for i := uint64(0); i < length; i++ {
+				v, ok := r.read(dlCharBits[encoding])
+				if !ok {
+					return nil, errInvalidDigitalLink
+				}
+				if encoding == dlASCII {
+					data.WriteByte(byte(v))
+				} else if int(v) < len(dlAlphabets[encoding]) {
+					data.WriteByte(dlAlphabets[encoding][v])
+				}
+			}
+		}
+		elements = append(elements, gs1Element{ai, data.String()})
+	}
+	return elements, nil
+}
@@ -0,0 +1,128 @@
+package barcode
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDigitalLink(t *testing.T) {
+	ean, _ := EAN13FromCode13(5901234123457)
+	dl, err := DigitalLinkFromEAN13(ean).WithBatch("ABC/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dl, err = dl.WithExpiry(time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	if dl, err = dl.WithSerial("12345"); err != nil {
+		t.Fatal(err)
+	}
+	expected := "https://id.gs1.org/01/05901234123457/10/ABC%2F1/21/12345?17=250131"
+	if dl.String() != expected {
+		t.Errorf("Unexpected URI %q v.s. %q", expected, dl.String())
+	}
+	if es := dl.ElementString().String(); es != "(01)05901234123457(10)ABC/1(21)12345(17)250131" {
+		t.Errorf("Unexpected element string %q", es)
+	}
+	custom := dl.WithDomain("https://example.com/dl/")
+	if custom.String() != "https://example.com/dl/01/05901234123457/10/ABC%2F1/21/12345?17=250131" {
+		t.Errorf("Unexpected URI %q", custom.String())
+	}
+	if _, err := dl.WithSerial("not valid"); err == nil {
+		t.Errorf("Unexpected valid serial")
+	}
+	if _, err := dl.WithElement("01", "05901234123457"); err == nil {
+		t.Errorf("Unexpected second primary key")
+	}
+}
+
+func TestDigitalLinkFromURI(t *testing.T) {
+	data := []struct {
+		uri, domain, elements string
+	}{
+		{"https://id.gs1.org/01/05901234123457", "https://id.gs1.org", "(01)05901234123457"},
+		{"https://example.com/dl/01/05901234123457/21/X%2F1?3103=001250&17=250131&utm=x",
+			"https://example.com/dl", "(01)05901234123457(21)X/1(17)250131(3103)001250"},
+		{"https://id.gs1.org/01/05901234123457/22/2A/10/ABC/21/12345",
+			"https://id.gs1.org", "(01)05901234123457(22)2A(10)ABC(21)12345"},
+	}
+	for _, d := range data {
+		dl, err := DigitalLinkFromURI(d.uri)
+		if err != nil {
+			t.Fatalf("%q: %v", d.uri, err)
+		}
+		if dl.Domain() != d.domain {
+			t.Errorf("Unexpected domain %q v.s. %q", d.domain, dl.Domain())
+		}
+		if es := dl.ElementString().String(); es != d.elements {
+			t.Errorf("Unexpected element string %q v.s. %q", d.elements, es)
+		}
+	}
+	for _, uri := range []string{
+		"05901234123457",
+		"https://id.gs1.org/01/05901234123458",
+		"https://id.gs1.org/01/05901234123457/10",
+		"https://id.gs1.org/00/106141411234567897",
+		"https://id.gs1.org/not-compressed!",
+		// No primary key, the path is not compressed either
+		"https://id.gs1.org/10/ABC123",
+		"https://id.gs1.org/02/09780345418913",
+		"https://example.com/products/shoes",
+		"https://id.gs1.org/ARHK",
+		// The qualifiers out of order, repeated or followed by a data attribute
+		"https://id.gs1.org/01/05901234123457/21/12345/10/ABC",
+		"https://id.gs1.org/01/05901234123457/10/ABC/22/2A",
+		"https://id.gs1.org/01/05901234123457/10/ABC/10/DEF",
+		"https://id.gs1.org/01/05901234123457/17/250131",
+	} {
+		if _, err := DigitalLinkFromURI(uri); err == nil {
+			t.Errorf("Unexpected valid URI %q", uri)
+		}
+	}
+}
+
+func TestDigitalLinkCompressionExample(t *testing.T) {
+	// Example of the GS1 Digital Link compression standard
+	gtin, _ := GTIN14FromString("09780345418913")
+	dl := DigitalLinkFromGTIN14(gtin)
+	if compressed := dl.Compressed(); compressed != "https://id.gs1.org/ARHKVAdpQg" {
+		t.Errorf("Unexpected compressed URI %q", compressed)
+	}
+	parsed, err := DigitalLinkFromURI("https://id.gs1.org/ARHKVAdpQg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.String() != "https://id.gs1.org/01/09780345418913" {
+		t.Errorf("Unexpected URI %q", parsed.String())
+	}
+	// A compressed element string must start with a primary key
+	var w bitWriter
+	compressGS1Element(&w, gs1Element{"10", "ABC123"})
+	if _, err := DigitalLinkFromURI("https://id.gs1.org/" + w.base64()); err != errInvalidDigitalLink {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestDigitalLinkCompression(t *testing.T) {
+	gtin, _ := GTIN14FromString("05901234123457")
+	dl := DigitalLinkFromGTIN14(gtin).WithDomain("https://example.com")
+	dl, _ = dl.WithBatch("ABC123")
+	dl, _ = dl.WithSerial("ab-CD_9")
+	dl, _ = dl.WithElement("3103", "001250")
+	dl, _ = dl.WithElement("421", "528ab")
+	dl, _ = dl.WithElement("99", "x/y")
+	compressed := dl.Compressed()
+	if len(compressed) >= len(dl.String()) {
+		t.Errorf("Compressed URI %q is not shorter than %q", compressed, dl.String())
+	}
+	parsed, err := DigitalLinkFromURI(compressed)
+	if err != nil {
+		t.Fatalf("%q: %v", compressed, err)
+	}
+	if parsed.String() != dl.String() {
+		t.Errorf("Unexpected round trip %q v.s. %q", dl.String(), parsed.String())
+	}
+	if g, err := parsed.GTIN(); err != nil || g != gtin {
+		t.Errorf("Unexpected GTIN %v: %v", g, err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 type EAN13 struct {
 	code13 uint64
+	addon  Addon
 }
 
 func (ean EAN13) Code13() uint64 {
@@ -29,21 +30,36 @@ func (ean EAN13) String() string {
 	return fmt.Sprintf("%013d", ean.code13)
 }
 
+// Addon returns the supplemental code printed on the right of the symbol.
+func (ean EAN13) Addon() Addon {
+	return ean.addon
+}
+
+// WithAddon returns a copy of the code carrying the supplemental code addon.
+func (ean EAN13) WithAddon(addon Addon) EAN13 {
+	ean.addon = addon
+	return ean
+}
+
+func (ean EAN13) style() code13Style {
+	return code13Style{addon: ean.addon}
+}
+
 func (ean EAN13) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
-	r, err := newBitmapRenderer(img, bound, padding)
+	r, err := newBitmapRenderer(img, bound, padding, ean.style().layout())
 	if err != nil {
 		return err
 	}
-	renderCode13(ean.code13, r)
+	renderCode13(ean.code13, ean.style(), r)
 	return nil
 }
 
 func (ean EAN13) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
-	r, err := newPdfRenderer(canvas, bound, padding)
+	r, err := newPdfRenderer(canvas, bound, padding, ean.style().layout())
 	if err != nil {
 		return err
 	}
-	renderCode13(ean.code13, r)
+	renderCode13(ean.code13, ean.style(), r)
 	return nil
 }
 
@@ -0,0 +1,103 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"fmt"
+	"image"
+	"image/draw"
+	"strconv"
+)
+
+type EAN8 struct {
+	code8 uint64
+}
+
+func (ean EAN8) Code8() uint64 {
+	return ean.code8
+}
+
+func (ean EAN8) Code7() uint64 {
+	return ean.code8 / 10
+}
+
+func (ean EAN8) Checksum() uint8 {
+	return uint8(ean.code8 % 10)
+}
+
+func (ean EAN8) String() string {
+	return fmt.Sprintf("%08d", ean.code8)
+}
+
+func (ean EAN8) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	r, err := newBitmapRenderer(img, bound, padding, eanLayout{width: code8LogicalWidth})
+	if err != nil {
+		return err
+	}
+	renderCode8(ean.code8, r)
+	return nil
+}
+
+func (ean EAN8) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	r, err := newPdfRenderer(canvas, bound, padding, eanLayout{width: code8LogicalWidth})
+	if err != nil {
+		return err
+	}
+	renderCode8(ean.code8, r)
+	return nil
+}
+
+func EAN8FromCode7(code7 uint64) (EAN8, error) {
+	if code7 > 9999999 {
+		return EAN8{}, errInvalidEAN
+	}
+	checksum := computeEANChecksum(code7)
+	return EAN8{
+		code8: code7*10 + checksum,
+	}, nil
+}
+
+func EAN8FromCode8(code8 uint64) (EAN8, error) {
+	if code8 > 99999999 {
+		return EAN8{}, errInvalidEAN
+	}
+	checksum := computeEANChecksum(code8 / 10)
+	if code8%10 != checksum {
+		return EAN8{}, errInvalidEANChecksum
+	}
+	return EAN8{
+		code8: code8,
+	}, nil
+}
+
+func EAN8FromString7(code string) (EAN8, error) {
+	if len(code) != 7 || !allDigits(code) {
+		return EAN8{}, errInvalidEAN
+	}
+	code7, err := strconv.ParseUint(code, 10, 64)
+	if err != nil {
+		return EAN8{}, err
+	}
+	return EAN8FromCode7(code7)
+}
+
+func EAN8FromString8(code string) (EAN8, error) {
+	if len(code) != 8 || !allDigits(code) {
+		return EAN8{}, errInvalidEAN
+	}
+	code8, err := strconv.ParseUint(code, 10, 64)
+	if err != nil {
+		return EAN8{}, err
+	}
+	return EAN8FromCode8(code8)
+}
+
+func EAN8FromString(code string) (EAN8, error) {
+	switch len(code) {
+	case 7:
+		return EAN8FromString7(code)
+	case 8:
+		return EAN8FromString8(code)
+	default:
+		return EAN8{}, errInvalidEAN
+	}
+}
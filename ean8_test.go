@@ -0,0 +1,65 @@
+package barcode
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestEAN8(t *testing.T) {
+	type data struct {
+		code7    uint64
+		checksum uint8
+	}
+	var testdata = []data{
+		{9638507, 4},
+		{5512345, 7},
+		{4006381, 2},
+		{123456, 5},
+	}
+	for _, d := range testdata {
+		ean, err := EAN8FromCode7(d.code7)
+		if err != nil {
+			t.Errorf("Invalid ean %d", d.code7)
+		}
+		if d.checksum != ean.Checksum() {
+			t.Errorf("Unexpected checksum %d v.s. %d", d.checksum, ean.Checksum())
+		}
+		ean, err = EAN8FromCode8(d.code7*10 + uint64(d.checksum))
+		if err != nil {
+			t.Errorf("Invalid ean %d", d.code7)
+		}
+		if d.checksum != ean.Checksum() {
+			t.Errorf("Unexpected checksum %d v.s. %d", d.checksum, ean.Checksum())
+		}
+		ean, err = EAN8FromString(fmt.Sprintf("%07d", d.code7))
+		if err != nil {
+			t.Errorf("Invalid ean %d", d.code7)
+		}
+		if d.checksum != ean.Checksum() {
+			t.Errorf("Unexpected checksum %d v.s. %d", d.checksum, ean.Checksum())
+		}
+		ean, err = EAN8FromString(fmt.Sprintf("%07d%d", d.code7, d.checksum))
+		if err != nil {
+			t.Errorf("Invalid ean %d", d.code7)
+		}
+		if d.checksum != ean.Checksum() {
+			t.Errorf("Unexpected checksum %d v.s. %d", d.checksum, ean.Checksum())
+		}
+	}
+}
+
+func TestInvalidEAN8(t *testing.T) {
+	var invalidString = []string{
+		"123456789", // too long
+		"123456a",   // not all digits
+		"96385070",  // invalid checksum
+	}
+	for _, c := range invalidString {
+		if _, err := EAN8FromString(c); err == nil {
+			t.Errorf("Unexpected valid code %s", c)
+		}
+	}
+	if _, err := EAN8FromCode7(10000000); err == nil {
+		t.Errorf("Unexpected valid code %d", 10000000)
+	}
+}
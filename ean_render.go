@@ -3,6 +3,7 @@ package barcode
 import (
 	"errors"
 	"image"
+	"unicode/utf8"
 )
 
 // b2s converts string to stripes
@@ -54,25 +55,113 @@ var dispatchTable = [10][6]int{
 	{0, 1, 1, 0, 1, 0},
 }
 
+// upceDispatchTable gives the parity of the 6 digits of a UPC-E code, indexed by
+// the number system and the check digit.
+var upceDispatchTable = [2][10][6]int{
+	{
+		{1, 1, 1, 0, 0, 0},
+		{1, 1, 0, 1, 0, 0},
+		{1, 1, 0, 0, 1, 0},
+		{1, 1, 0, 0, 0, 1},
+		{1, 0, 1, 1, 0, 0},
+		{1, 0, 0, 1, 1, 0},
+		{1, 0, 0, 0, 1, 1},
+		{1, 0, 1, 0, 1, 0},
+		{1, 0, 1, 0, 0, 1},
+		{1, 0, 0, 1, 0, 1},
+	},
+	{
+		{0, 0, 0, 1, 1, 1},
+		{0, 0, 1, 0, 1, 1},
+		{0, 0, 1, 1, 0, 1},
+		{0, 0, 1, 1, 1, 0},
+		{0, 1, 0, 0, 1, 1},
+		{0, 1, 1, 0, 0, 1},
+		{0, 1, 1, 1, 0, 0},
+		{0, 1, 0, 1, 0, 1},
+		{0, 1, 0, 1, 1, 0},
+		{0, 1, 1, 0, 1, 0},
+	},
+}
+
+// ean2DispatchTable gives the parity of the 2 digits of an EAN-2 add-on, indexed by
+// the value modulo 4.
+var ean2DispatchTable = [4][2]int{
+	{0, 0},
+	{0, 1},
+	{1, 0},
+	{1, 1},
+}
+
+// ean5DispatchTable gives the parity of the 5 digits of an EAN-5 add-on, indexed by
+// the add-on checksum.
+var ean5DispatchTable = [10][5]int{
+	{1, 1, 0, 0, 0},
+	{1, 0, 1, 0, 0},
+	{1, 0, 0, 1, 0},
+	{1, 0, 0, 0, 1},
+	{0, 1, 1, 0, 0},
+	{0, 0, 1, 1, 0},
+	{0, 0, 0, 1, 1},
+	{0, 1, 0, 1, 0},
+	{0, 1, 0, 0, 1},
+	{0, 0, 1, 0, 1},
+}
+
 var (
-	startMarker  = b2s("101")
-	endMarker    = startMarker
-	centerMarker = b2s("01010")
+	startMarker    = b2s("101")
+	endMarker      = startMarker
+	centerMarker   = b2s("01010")
+	upceEndMarker  = b2s("010101")
+	addonMarker    = b2s("1011")
+	addonSeparator = b2s("01")
+)
+
+const (
+	digitBarSize       int = 7
+	startMarkerSize        = 3
+	endMarkerSize          = 3
+	centerMarkerSize       = 5
+	upceEndMarkerSize      = 6
+	addonMarkerSize        = 4
+	addonSeparatorSize     = 2
+	// Space between the end marker of the main symbol and the add-on
+	addonGap = 9
+	// Quiet zone on the right of the add-on
+	addonMargin = 5
 )
 
+// Logical widths of the symbols, including the space reserved for the digits
+// printed outside the guards and the margins.
 const (
-	digitBarSize     int = 7
-	startMarkerSize      = 3
-	endMarkerSize        = 3
-	centerMarkerSize     = 5
+	code13LogicalWidth = 13*digitBarSize + startMarkerSize + endMarkerSize + centerMarkerSize + digitBarSize
+	code8LogicalWidth  = 8*digitBarSize + startMarkerSize + endMarkerSize + centerMarkerSize + 2*digitBarSize
+	upceLogicalWidth   = 8*digitBarSize + startMarkerSize + upceEndMarkerSize
 )
 
+// eanLayout describes the logical size of a symbol.
+type eanLayout struct {
+	// Width in modules, including the digits printed outside of the guards and the margins
+	width int
+	// Reserve a line above the bars for a caption, e.g. the ISBN
+	caption bool
+	// Height in modules of a two dimensional symbol, whose modules are square.
+	// Zero for the one dimensional symbols, which span the whole height.
+	height int
+}
+
 // eanCoordinateConverter maps a logical coordinate to the coordinate in target system.
 type eanCoordinateConverter struct {
 	bound    image.Rectangle
 	fontDim  image.Rectangle
 	scale    int
 	fontSize int
+	// Smaller font for the digits printed outside of the guards, e.g. in UPC-A
+	smallFontDim  image.Rectangle
+	smallFontSize int
+	// Height reserved above the bars for the caption
+	top     int
+	measure fontMeasurer
 }
 
 var errAreaTooSmall = errors.New("Bound area too small")
@@ -80,29 +169,42 @@ var errFontTooBig = errors.New("Font is too big to fit in the small barcode")
 
 type fontMeasurer func(width int) (fontSize, fontWidth, fontHeight int)
 
-func newEanCoordinateConverter(outerBound image.Rectangle, fm fontMeasurer) (*eanCoordinateConverter, error) {
-	const rightMargin = digitBarSize
-	const logicalWidth = 13*digitBarSize + startMarkerSize + endMarkerSize + centerMarkerSize + rightMargin
-	scale := outerBound.Dx() / logicalWidth
+func newEanCoordinateConverter(outerBound image.Rectangle, layout eanLayout, fm fontMeasurer) (*eanCoordinateConverter, error) {
+	if layout.height > 0 {
+		return newMatrixCoordinateConverter(outerBound, layout)
+	}
+	scale := outerBound.Dx() / layout.width
 	if scale <= 0 {
 		return nil, errAreaTooSmall
 	}
-	xMargin := (outerBound.Dx() % logicalWidth) / 2
+	xMargin := (outerBound.Dx() % layout.width) / 2
 	fSize, fWidth, fHeight := fm(digitBarSize * scale)
 	if digitBarSize*scale < fWidth {
 		return nil, errFontTooBig
 	}
-	if outerBound.Dy() < fHeight*2 {
+	top := 0
+	if layout.caption {
+		top = fHeight
+	}
+	if outerBound.Dy() < fHeight*2+top {
 		return nil, errAreaTooSmall
 	}
+	sSize, sWidth, sHeight := fm(digitBarSize * scale * 3 / 4)
+	if sSize <= 0 {
+		sSize, sWidth, sHeight = fSize, fWidth, fHeight
+	}
 	return &eanCoordinateConverter{
 		bound: image.Rectangle{
 			Min: outerBound.Min.Add(image.Pt(xMargin, 0)),
 			Max: outerBound.Max.Sub(image.Pt(xMargin, 0)),
 		},
-		fontDim:  image.Rect(0, 0, fWidth, fHeight),
-		scale:    scale,
-		fontSize: fSize,
+		fontDim:       image.Rect(0, 0, fWidth, fHeight),
+		scale:         scale,
+		fontSize:      fSize,
+		smallFontDim:  image.Rect(0, 0, sWidth, sHeight),
+		smallFontSize: sSize,
+		top:           top,
+		measure:       fm,
 	}, nil
 }
 
@@ -113,18 +215,91 @@ func (c *eanCoordinateConverter) translateBar(x0, x1 int, long bool) image.Recta
 		h += c.fontDim.Dy() / 2
 	}
 	return image.Rectangle{
-		Min: c.bound.Min.Add(image.Pt(x0*c.scale, 0)),
+		Min: c.bound.Min.Add(image.Pt(x0*c.scale, c.top)),
 		Max: c.bound.Min.Add(image.Pt(x1*c.scale, h)),
 	}
 }
 
 func (c *eanCoordinateConverter) translateFont(x int) (rect image.Rectangle, fontSize int) {
+	return c.translateFontCell(x, c.fontDim), c.fontSize
+}
+
+func (c *eanCoordinateConverter) translateSmallFont(x int) (rect image.Rectangle, fontSize int) {
+	return c.translateFontCell(x, c.smallFontDim), c.smallFontSize
+}
+
+// translateFontCell centers a glyph of dimension dim in the digit cell starting at x,
+// aligned to the bottom of the bound.
+func (c *eanCoordinateConverter) translateFontCell(x int, dim image.Rectangle) image.Rectangle {
+	return c.translateFontCellAt(x, c.bound.Dy()-dim.Dy(), dim)
+}
+
+func (c *eanCoordinateConverter) translateFontCellAt(x, y int, dim image.Rectangle) image.Rectangle {
 	fontCellWidth := c.scale * digitBarSize
-	fontXOffset := (fontCellWidth - c.fontDim.Dx()) / 2
-	fontYOffset := c.bound.Dy() - c.fontDim.Dy()
-	topLeft := c.bound.Min.Add(image.Pt(x*c.scale+fontXOffset, fontYOffset))
-	bottomRight := topLeft.Add(image.Pt(c.fontDim.Dx(), c.fontDim.Dy()))
-	return image.Rectangle{Min: topLeft, Max: bottomRight}, c.fontSize
+	fontXOffset := (fontCellWidth - dim.Dx()) / 2
+	topLeft := c.bound.Min.Add(image.Pt(x*c.scale+fontXOffset, y))
+	bottomRight := topLeft.Add(image.Pt(dim.Dx(), dim.Dy()))
+	return image.Rectangle{Min: topLeft, Max: bottomRight}
+}
+
+// translateAddonBar translates a bar of the add-on symbol, which leaves room for the
+// digits above it and ends at the same height as the guard bars of the main symbol.
+func (c *eanCoordinateConverter) translateAddonBar(x0, x1 int) image.Rectangle {
+	return image.Rectangle{
+		Min: c.bound.Min.Add(image.Pt(x0*c.scale, c.top+c.fontDim.Dy())),
+		Max: c.bound.Min.Add(image.Pt(x1*c.scale, c.bound.Dy()-c.fontDim.Dy()/2)),
+	}
+}
+
+// translateAddonFont translates a digit printed above the add-on bars.
+func (c *eanCoordinateConverter) translateAddonFont(x int) (rect image.Rectangle, fontSize int) {
+	return c.translateFontCellAt(x, c.top, c.fontDim), c.fontSize
+}
+
+// translateCaption centers a line of n characters above the bars between x0 and x1.
+// The font is shrunk if the line does not fit.
+func (c *eanCoordinateConverter) translateCaption(x0, x1 int, n int) (rect image.Rectangle, fontSize int) {
+	rect, fontSize = c.fitLine(x0, x1, n)
+	return rect.Add(image.Pt(0, c.top-rect.Dy())), fontSize
+}
+
+// translateText centers a line of n characters below the bars between x0 and x1.
+// The font is shrunk if the line does not fit.
+func (c *eanCoordinateConverter) translateText(x0, x1 int, n int) (rect image.Rectangle, fontSize int) {
+	rect, fontSize = c.fitLine(x0, x1, n)
+	return rect.Add(image.Pt(0, c.bound.Dy()-rect.Dy())), fontSize
+}
+
+// fitLine measures the font for a line of n characters between x0 and x1 and returns
+// the line centered horizontally at the top of the bound.
+func (c *eanCoordinateConverter) fitLine(x0, x1 int, n int) (rect image.Rectangle, fontSize int) {
+	cellWidth := digitBarSize * c.scale
+	if n > 0 && (x1-x0)*c.scale/n < cellWidth {
+		cellWidth = (x1 - x0) * c.scale / n
+	}
+	fSize, fWidth, fHeight := c.measure(cellWidth)
+	width := fWidth * n
+	topLeft := c.bound.Min.Add(image.Pt(x0*c.scale+((x1-x0)*c.scale-width)/2, 0))
+	return image.Rectangle{Min: topLeft, Max: topLeft.Add(image.Pt(width, fHeight))}, fSize
+}
+
+// translateBearers translates the horizontal bearer bars of the given thickness in
+// logical units, on the top and bottom of the bar area between x0 and x1.
+func (c *eanCoordinateConverter) translateBearers(x0, x1 int, thickness int) (top, bottom image.Rectangle) {
+	area := c.translateBar(x0, x1, false)
+	top, bottom = area, area
+	top.Max.Y = top.Min.Y + thickness*c.scale
+	bottom.Min.Y = bottom.Max.Y - thickness*c.scale
+	return
+}
+
+// translateInsetBar translates a bar between x0 and x1, shortened on both ends by
+// inset logical units to leave room for the bearer bars.
+func (c *eanCoordinateConverter) translateInsetBar(x0, x1 int, inset int) image.Rectangle {
+	rect := c.translateBar(x0, x1, false)
+	rect.Min.Y += inset * c.scale
+	rect.Max.Y -= inset * c.scale
+	return rect
 }
 
 type eanRenderer interface {
@@ -134,6 +309,8 @@ type eanRenderer interface {
 	DrawBar(rect image.Rectangle)
 	// Draw a digit at x, y as top left corner
 	DrawDigit(digit int, rect image.Rectangle, fontSize int)
+	// Draw a line of text at x, y as top left corner
+	DrawText(text string, rect image.Rectangle, fontSize int)
 	// End of rendering the barcode. Clean up can be done in this function
 	End()
 }
@@ -149,19 +326,74 @@ func drawDigit(cx int, digit int, r eanRenderer, c *eanCoordinateConverter) {
 	r.DrawDigit(digit, rect, fontSize)
 }
 
-func renderCode13(code13 uint64, r eanRenderer) {
+func drawAddonStripe(cx int, s []int, r eanRenderer, c *eanCoordinateConverter) {
+	for i := 0; i+1 < len(s); i += 2 {
+		r.DrawBar(c.translateAddonBar(cx+s[i], cx+s[i+1]))
+	}
+}
+
+func drawAddonDigit(cx int, digit int, r eanRenderer, c *eanCoordinateConverter) {
+	rect, fontSize := c.translateAddonFont(cx)
+	r.DrawDigit(digit, rect, fontSize)
+}
+
+// drawCaption draws text centered above the bars between x0 and x1.
+func drawCaption(x0, x1 int, text string, r eanRenderer, c *eanCoordinateConverter) {
+	if text == "" {
+		return
+	}
+	rect, fontSize := c.translateCaption(x0, x1, utf8.RuneCountInString(text))
+	r.DrawText(text, rect, fontSize)
+}
+
+func drawSmallDigit(cx int, digit int, r eanRenderer, c *eanCoordinateConverter) {
+	rect, fontSize := c.translateSmallFont(cx)
+	r.DrawDigit(digit, rect, fontSize)
+}
+
+// splitDigits fills digits with the decimal digits of code, most significant first.
+func splitDigits(code uint64, digits []int) {
+	for i := len(digits) - 1; i >= 0; i-- {
+		digits[i] = int(code % 10)
+		code /= 10
+	}
+}
+
+// code13Style holds the variations of the 13 digit layout.
+type code13Style struct {
+	// Render with the UPC-A conventions: the leading zero is omitted, the number
+	// system digit and the check digit are printed outside of the guards in smaller
+	// type and their bars are extended like the guard bars.
+	upca bool
+	// Supplemental code printed on the right
+	addon Addon
+	// Text printed above the bars, e.g. "ISBN 978-..."
+	caption string
+}
+
+func (style code13Style) layout() eanLayout {
+	return eanLayout{
+		width:   code13LogicalWidth + style.addon.logicalWidth(),
+		caption: style.caption != "",
+	}
+}
+
+// renderCode13 renders a 13 digit code in the given style.
+func renderCode13(code13 uint64, style code13Style, r eanRenderer) {
 	c := r.Start()
+	upca := style.upca
 
 	var digits [13]int
-	for i, c := 12, code13; i >= 0; i-- {
-		digits[i] = int(c % 10)
-		c = c / 10
-	}
+	splitDigits(code13, digits[:])
 
 	cx := 0
 	first := digits[0]
 	// Draw first digit
-	drawDigit(cx, first, r, c)
+	if upca {
+		drawSmallDigit(cx, digits[1], r, c)
+	} else {
+		drawDigit(cx, first, r, c)
+	}
 	cx += digitBarSize
 	// Draw start marker
 	drawStripe(cx, startMarker, true, r, c)
@@ -169,8 +401,12 @@ func renderCode13(code13 uint64, r eanRenderer) {
 	// Draw fist 6 digits
 	for i := 1; i <= 6; i++ {
 		stripe := barTable[digits[i]][dispatchTable[first][i-1]]
-		drawStripe(cx, stripe, false, r, c)
-		drawDigit(cx, digits[i], r, c)
+		if upca && i == 1 {
+			drawStripe(cx, stripe, true, r, c)
+		} else {
+			drawStripe(cx, stripe, false, r, c)
+			drawDigit(cx, digits[i], r, c)
+		}
 		cx += digitBarSize
 	}
 	// Draw center marker
@@ -178,6 +414,49 @@ func renderCode13(code13 uint64, r eanRenderer) {
 	cx += centerMarkerSize
 	// Draw last 6 digits
 	for i := 7; i <= 12; i++ {
+		if upca && i == 12 {
+			drawStripe(cx, barTable[digits[i]][2], true, r, c)
+		} else {
+			drawStripe(cx, barTable[digits[i]][2], false, r, c)
+			drawDigit(cx, digits[i], r, c)
+		}
+		cx += digitBarSize
+	}
+	// Draw end marker
+	drawStripe(cx, endMarker, true, r, c)
+	cx += endMarkerSize
+	// Draw caption over the guards
+	drawCaption(digitBarSize, cx, style.caption, r, c)
+	if upca {
+		// Draw check digit
+		drawSmallDigit(cx, digits[12], r, c)
+	}
+	renderAddon(cx+addonGap, style.addon, r, c)
+	r.End()
+}
+
+func renderCode8(code8 uint64, r eanRenderer) {
+	c := r.Start()
+
+	var digits [8]int
+	splitDigits(code8, digits[:])
+
+	// Leave the left margin empty, there is no digit outside of the guards
+	cx := digitBarSize
+	// Draw start marker
+	drawStripe(cx, startMarker, true, r, c)
+	cx += startMarkerSize
+	// Draw first 4 digits
+	for i := 0; i < 4; i++ {
+		drawStripe(cx, barTable[digits[i]][0], false, r, c)
+		drawDigit(cx, digits[i], r, c)
+		cx += digitBarSize
+	}
+	// Draw center marker
+	drawStripe(cx, centerMarker, true, r, c)
+	cx += centerMarkerSize
+	// Draw last 4 digits
+	for i := 4; i < 8; i++ {
 		drawStripe(cx, barTable[digits[i]][2], false, r, c)
 		drawDigit(cx, digits[i], r, c)
 		cx += digitBarSize
@@ -187,3 +466,57 @@ func renderCode13(code13 uint64, r eanRenderer) {
 	cx += endMarkerSize
 	r.End()
 }
+
+func renderUPCE(code8 uint64, addon Addon, r eanRenderer) {
+	c := r.Start()
+
+	var digits [8]int
+	splitDigits(code8, digits[:])
+
+	ns, check := digits[0], digits[7]
+	cx := 0
+	// Draw number system digit
+	drawSmallDigit(cx, ns, r, c)
+	cx += digitBarSize
+	// Draw start marker
+	drawStripe(cx, startMarker, true, r, c)
+	cx += startMarkerSize
+	// Draw 6 digits
+	for i := 1; i <= 6; i++ {
+		stripe := barTable[digits[i]][upceDispatchTable[ns][check][i-1]]
+		drawStripe(cx, stripe, false, r, c)
+		drawDigit(cx, digits[i], r, c)
+		cx += digitBarSize
+	}
+	// Draw end marker
+	drawStripe(cx, upceEndMarker, true, r, c)
+	cx += upceEndMarkerSize
+	// Draw check digit
+	drawSmallDigit(cx, check, r, c)
+	renderAddon(cx+addonGap, addon, r, c)
+	r.End()
+}
+
+// renderAddon renders the add-on symbol starting at cx. Nothing is drawn if there is no add-on.
+func renderAddon(cx int, addon Addon, r eanRenderer, c *eanCoordinateConverter) {
+	if addon.size == 0 {
+		return
+	}
+	digits := make([]int, addon.size)
+	splitDigits(addon.code, digits)
+	parity := addon.parity()
+
+	// Draw start marker
+	drawAddonStripe(cx, addonMarker, r, c)
+	cx += addonMarkerSize
+	for i, d := range digits {
+		if i > 0 {
+			// Draw separator
+			drawAddonStripe(cx, addonSeparator, r, c)
+			cx += addonSeparatorSize
+		}
+		drawAddonStripe(cx, barTable[d][parity[i]], r, c)
+		drawAddonDigit(cx, d, r, c)
+		cx += digitBarSize
+	}
+}
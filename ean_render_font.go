@@ -0,0 +1,111 @@
+package barcode
+
+// bitmapGlyphs holds the glyphs of the printable ASCII characters other than the
+// digits, which come from digits.gif. Each glyph is 5 dots wide and 9 dots high,
+// one byte per row with the most significant of the 5 bits on the left. The glyphs
+// are placed in the same 7x10 cell as the digits, leaving a 1 dot border on the
+// left and top. The last row is only used by descenders.
+var bitmapGlyphs = map[rune][9]uint8{
+	' ':  {0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	'!':  {0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x00, 0x04, 0x00},
+	'"':  {0x0a, 0x0a, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	'#':  {0x00, 0x0a, 0x1f, 0x0a, 0x0a, 0x1f, 0x0a, 0x00, 0x00},
+	'$':  {0x04, 0x0f, 0x14, 0x0e, 0x05, 0x05, 0x1e, 0x04, 0x00},
+	'%':  {0x19, 0x19, 0x02, 0x04, 0x08, 0x13, 0x13, 0x00, 0x00},
+	'&':  {0x0c, 0x12, 0x12, 0x0c, 0x15, 0x12, 0x12, 0x0d, 0x00},
+	'\'': {0x04, 0x04, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	'(':  {0x02, 0x04, 0x08, 0x08, 0x08, 0x08, 0x04, 0x02, 0x00},
+	')':  {0x08, 0x04, 0x02, 0x02, 0x02, 0x02, 0x04, 0x08, 0x00},
+	'*':  {0x00, 0x00, 0x15, 0x0e, 0x1f, 0x0e, 0x15, 0x00, 0x00},
+	'+':  {0x00, 0x00, 0x04, 0x04, 0x1f, 0x04, 0x04, 0x00, 0x00},
+	',':  {0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x04, 0x08},
+	'-':  {0x00, 0x00, 0x00, 0x00, 0x0e, 0x00, 0x00, 0x00, 0x00},
+	'.':  {0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0c, 0x0c, 0x00},
+	'/':  {0x01, 0x01, 0x02, 0x04, 0x04, 0x08, 0x10, 0x10, 0x00},
+	':':  {0x00, 0x0c, 0x0c, 0x00, 0x00, 0x0c, 0x0c, 0x00, 0x00},
+	';':  {0x00, 0x0c, 0x0c, 0x00, 0x00, 0x0c, 0x0c, 0x08, 0x00},
+	'<':  {0x02, 0x04, 0x08, 0x10, 0x08, 0x04, 0x02, 0x00, 0x00},
+	'=':  {0x00, 0x00, 0x1f, 0x00, 0x00, 0x1f, 0x00, 0x00, 0x00},
+	'>':  {0x08, 0x04, 0x02, 0x01, 0x02, 0x04, 0x08, 0x00, 0x00},
+	'?':  {0x0e, 0x11, 0x01, 0x02, 0x04, 0x04, 0x00, 0x04, 0x00},
+	'@':  {0x0e, 0x11, 0x17, 0x15, 0x15, 0x16, 0x10, 0x0f, 0x00},
+	'A':  {0x0e, 0x11, 0x11, 0x11, 0x1f, 0x11, 0x11, 0x11, 0x00},
+	'B':  {0x1e, 0x11, 0x11, 0x1e, 0x11, 0x11, 0x11, 0x1e, 0x00},
+	'C':  {0x0e, 0x11, 0x10, 0x10, 0x10, 0x10, 0x11, 0x0e, 0x00},
+	'D':  {0x1e, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x1e, 0x00},
+	'E':  {0x1f, 0x10, 0x10, 0x1e, 0x10, 0x10, 0x10, 0x1f, 0x00},
+	'F':  {0x1f, 0x10, 0x10, 0x1e, 0x10, 0x10, 0x10, 0x10, 0x00},
+	'G':  {0x0e, 0x11, 0x10, 0x10, 0x17, 0x11, 0x11, 0x0f, 0x00},
+	'H':  {0x11, 0x11, 0x11, 0x1f, 0x11, 0x11, 0x11, 0x11, 0x00},
+	'I':  {0x0e, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x0e, 0x00},
+	'J':  {0x07, 0x02, 0x02, 0x02, 0x02, 0x02, 0x12, 0x0c, 0x00},
+	'K':  {0x11, 0x12, 0x14, 0x18, 0x14, 0x12, 0x11, 0x11, 0x00},
+	'L':  {0x10, 0x10, 0x10, 0x10, 0x10, 0x10, 0x10, 0x1f, 0x00},
+	'M':  {0x11, 0x1b, 0x15, 0x15, 0x11, 0x11, 0x11, 0x11, 0x00},
+	'N':  {0x11, 0x19, 0x19, 0x15, 0x15, 0x13, 0x13, 0x11, 0x00},
+	'O':  {0x0e, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x0e, 0x00},
+	'P':  {0x1e, 0x11, 0x11, 0x1e, 0x10, 0x10, 0x10, 0x10, 0x00},
+	'Q':  {0x0e, 0x11, 0x11, 0x11, 0x11, 0x15, 0x12, 0x0d, 0x00},
+	'R':  {0x1e, 0x11, 0x11, 0x1e, 0x14, 0x12, 0x11, 0x11, 0x00},
+	'S':  {0x0e, 0x11, 0x10, 0x0e, 0x01, 0x01, 0x11, 0x0e, 0x00},
+	'T':  {0x1f, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x00},
+	'U':  {0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x0e, 0x00},
+	'V':  {0x11, 0x11, 0x11, 0x11, 0x0a, 0x0a, 0x04, 0x04, 0x00},
+	'W':  {0x11, 0x11, 0x11, 0x15, 0x15, 0x15, 0x1b, 0x11, 0x00},
+	'X':  {0x11, 0x11, 0x0a, 0x04, 0x04, 0x0a, 0x11, 0x11, 0x00},
+	'Y':  {0x11, 0x11, 0x0a, 0x04, 0x04, 0x04, 0x04, 0x04, 0x00},
+	'Z':  {0x1f, 0x01, 0x02, 0x04, 0x08, 0x10, 0x10, 0x1f, 0x00},
+	'[':  {0x0e, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x0e, 0x00},
+	'\\': {0x10, 0x10, 0x08, 0x04, 0x04, 0x02, 0x01, 0x01, 0x00},
+	']':  {0x0e, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x0e, 0x00},
+	'^':  {0x04, 0x0a, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	'_':  {0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1f, 0x00},
+	'`':  {0x08, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	'a':  {0x00, 0x00, 0x0e, 0x01, 0x0f, 0x11, 0x11, 0x0f, 0x00},
+	'b':  {0x10, 0x10, 0x1e, 0x11, 0x11, 0x11, 0x11, 0x1e, 0x00},
+	'c':  {0x00, 0x00, 0x0e, 0x11, 0x10, 0x10, 0x11, 0x0e, 0x00},
+	'd':  {0x01, 0x01, 0x0f, 0x11, 0x11, 0x11, 0x11, 0x0f, 0x00},
+	'e':  {0x00, 0x00, 0x0e, 0x11, 0x1f, 0x10, 0x11, 0x0e, 0x00},
+	'f':  {0x06, 0x08, 0x08, 0x1e, 0x08, 0x08, 0x08, 0x08, 0x00},
+	'g':  {0x00, 0x00, 0x0f, 0x11, 0x11, 0x11, 0x0f, 0x01, 0x0e},
+	'h':  {0x10, 0x10, 0x1e, 0x11, 0x11, 0x11, 0x11, 0x11, 0x00},
+	'i':  {0x04, 0x00, 0x0c, 0x04, 0x04, 0x04, 0x04, 0x0e, 0x00},
+	'j':  {0x02, 0x00, 0x06, 0x02, 0x02, 0x02, 0x02, 0x12, 0x0c},
+	'k':  {0x10, 0x10, 0x12, 0x14, 0x18, 0x14, 0x12, 0x11, 0x00},
+	'l':  {0x0c, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x0e, 0x00},
+	'm':  {0x00, 0x00, 0x1a, 0x15, 0x15, 0x15, 0x15, 0x15, 0x00},
+	'n':  {0x00, 0x00, 0x1e, 0x11, 0x11, 0x11, 0x11, 0x11, 0x00},
+	'o':  {0x00, 0x00, 0x0e, 0x11, 0x11, 0x11, 0x11, 0x0e, 0x00},
+	'p':  {0x00, 0x00, 0x1e, 0x11, 0x11, 0x11, 0x1e, 0x10, 0x10},
+	'q':  {0x00, 0x00, 0x0f, 0x11, 0x11, 0x11, 0x0f, 0x01, 0x01},
+	'r':  {0x00, 0x00, 0x16, 0x19, 0x10, 0x10, 0x10, 0x10, 0x00},
+	's':  {0x00, 0x00, 0x0f, 0x10, 0x0e, 0x01, 0x01, 0x1e, 0x00},
+	't':  {0x08, 0x08, 0x1e, 0x08, 0x08, 0x08, 0x09, 0x06, 0x00},
+	'u':  {0x00, 0x00, 0x11, 0x11, 0x11, 0x11, 0x13, 0x0d, 0x00},
+	'v':  {0x00, 0x00, 0x11, 0x11, 0x11, 0x0a, 0x0a, 0x04, 0x00},
+	'w':  {0x00, 0x00, 0x11, 0x11, 0x15, 0x15, 0x15, 0x0a, 0x00},
+	'x':  {0x00, 0x00, 0x11, 0x0a, 0x04, 0x04, 0x0a, 0x11, 0x00},
+	'y':  {0x00, 0x00, 0x11, 0x11, 0x11, 0x11, 0x0f, 0x01, 0x0e},
+	'z':  {0x00, 0x00, 0x1f, 0x02, 0x04, 0x08, 0x10, 0x1f, 0x00},
+	'{':  {0x03, 0x04, 0x04, 0x08, 0x04, 0x04, 0x04, 0x03, 0x00},
+	'|':  {0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x00},
+	'}':  {0x18, 0x04, 0x04, 0x02, 0x04, 0x04, 0x04, 0x18, 0x00},
+	'~':  {0x00, 0x00, 0x00, 0x09, 0x16, 0x00, 0x00, 0x00, 0x00},
+}
+
+// latin1Substitutes gives the ASCII character drawn for each Latin-1 character from
+// U+00C0, the letters without their accents.
+const latin1Substitutes = "AAAAAAACEEEEIIII" + "DNOOOOOxOUUUUYPs" + "aaaaaaaceeeeiiii" + "dnooooo/ouuuuypy"
+
+// bitmapSubstitute returns the character drawn in place of c, which has no glyph:
+// the base letter of an accented Latin-1 letter, a space for a no-break space, or
+// a question mark.
+func bitmapSubstitute(c rune) rune {
+	switch {
+	case c >= 0xc0 && c <= 0xff:
+		return rune(latin1Substitutes[c-0xc0])
+	case c == 0xa0:
+		return ' '
+	}
+	return '?'
+}
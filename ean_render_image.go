@@ -45,13 +45,13 @@ type bitmapRenderer struct {
 	converter *eanCoordinateConverter
 }
 
-func newBitmapRenderer(img draw.Image, bound image.Rectangle, padding int) (eanRenderer, error) {
+func newBitmapRenderer(img draw.Image, bound image.Rectangle, padding int, layout eanLayout) (eanRenderer, error) {
 	bound = bound.Intersect(img.Bounds())
 	inner := image.Rectangle{
 		Min: bound.Min.Add(image.Pt(padding, padding)),
 		Max: bound.Max.Sub(image.Pt(padding, padding)),
 	}.Canon()
-	converter, err := newEanCoordinateConverter(inner, measureBitmapFont)
+	converter, err := newEanCoordinateConverter(inner, layout, measureBitmapFont)
 	if err != nil {
 		return nil, err
 	}
@@ -88,5 +88,32 @@ func (r *bitmapRenderer) DrawDigit(digit int, rect image.Rectangle, fontSize int
 	}
 }
 
+func (r *bitmapRenderer) DrawText(text string, rect image.Rectangle, fontSize int) {
+	// The index of a range over a string counts bytes, not characters
+	i := -1
+	for _, c := range text {
+		i++
+		topLeft := rect.Min.Add(image.Pt(i*digitsImageWidth*fontSize, 0))
+		if c >= '0' && c <= '9' {
+			r.DrawDigit(int(c-'0'), image.Rectangle{Min: topLeft, Max: topLeft}, fontSize)
+			continue
+		}
+		glyph, ok := bitmapGlyphs[c]
+		if !ok {
+			glyph = bitmapGlyphs[bitmapSubstitute(c)]
+		}
+		for j, row := range glyph {
+			for k := 0; k < 5; k++ {
+				if row&(0x10>>uint(k)) == 0 {
+					continue
+				}
+				dotTopLeft := topLeft.Add(image.Pt((k+1)*fontSize, (j+1)*fontSize))
+				dotBottomRight := dotTopLeft.Add(image.Pt(fontSize, fontSize))
+				fillRect(r.img, image.Rectangle{Min: dotTopLeft, Max: dotBottomRight}, color.Black)
+			}
+		}
+	}
+}
+
 func (r *bitmapRenderer) End() {
 }
@@ -31,13 +31,13 @@ func measurePdfFont(width int) (fontSize, fontWidth, fontHeight int) {
 	return
 }
 
-func newPdfRenderer(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) (*pdfRenderer, error) {
+func newPdfRenderer(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit, layout eanLayout) (*pdfRenderer, error) {
 	imgRect := image.Rect(
 		int(padding*pdfCoordinateScale),
 		int(padding*pdfCoordinateScale),
 		int((bound.Dx()-padding)*pdfCoordinateScale),
 		int((bound.Dy()-padding)*pdfCoordinateScale))
-	converter, err := newEanCoordinateConverter(imgRect, measurePdfFont)
+	converter, err := newEanCoordinateConverter(imgRect, layout, measurePdfFont)
 	if err != nil {
 		return nil, err
 	}
@@ -71,15 +71,19 @@ func (r *pdfRenderer) DrawBar(rect image.Rectangle) {
 var digitsString = []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}
 
 func (r *pdfRenderer) DrawDigit(digit int, rect image.Rectangle, fontSize int) {
-	text := new(pdf.Text)
-	text.SetFont(pdf.Helvetica, pdf.Unit(fontSize))
-	text.Text(digitsString[digit])
+	r.DrawText(digitsString[digit], rect, fontSize)
+}
+
+func (r *pdfRenderer) DrawText(text string, rect image.Rectangle, fontSize int) {
+	t := new(pdf.Text)
+	t.SetFont(pdf.Helvetica, pdf.Unit(fontSize))
+	t.Text(text)
 	r.canvas.Push()
 	r.canvas.Transform(
 		1, 0, 0, -1,
 		float32(rect.Min.X)/pdfCoordinateScale,
 		float32(rect.Min.Y)/pdfCoordinateScale+float32(pdfFontAscender*fontSize)/pdfFontUnitScale)
-	r.canvas.DrawText(text)
+	r.canvas.DrawText(t)
 	r.canvas.Pop()
 }
 
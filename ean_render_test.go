@@ -2,6 +2,7 @@ package barcode
 
 import (
 	"bitbucket.org/saintfish/gopdf/pdf"
+	"bytes"
 	"image"
 	"image/gif"
 	"os"
@@ -40,3 +41,383 @@ func TestRenderPdf(t *testing.T) {
 	defer f.Close()
 	doc.Encode(f)
 }
+
+func TestBitmapDrawText(t *testing.T) {
+	// A character without glyph is replaced by a substitute in its place, whatever
+	// its length in bytes
+	data := []struct {
+		text, expected string
+	}{
+		{"\u20ac1", "?1"},
+		{"Gr\u00fc\u00dfe 1", "Gruse 1"},
+		{"\u00c0\u00e7\u00a01", "Ac 1"},
+	}
+	r := image.Rect(0, 0, 100, 20)
+	for _, d := range data {
+		expected := image.NewGray(r)
+		(&bitmapRenderer{img: expected}).DrawText(d.expected, r, 1)
+		img := image.NewGray(r)
+		(&bitmapRenderer{img: img}).DrawText(d.text, r, 1)
+		if !bytes.Equal(img.Pix, expected.Pix) {
+			t.Errorf("Unexpected glyphs for %q", d.text)
+		}
+	}
+}
+
+func TestRenderEAN8Image(t *testing.T) {
+	r := image.Rect(0, 0, 400, 300)
+	img := image.NewGray(r)
+	code, _ := EAN8FromString7("9638507")
+	if err := code.RenderImage(img, r, 20); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_ean8.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderAddonImage(t *testing.T) {
+	r := image.Rect(0, 0, 800, 300)
+	img := image.NewGray(r)
+	addon, _ := AddonFromString("52495")
+	code, _ := EAN13FromString("9780306406157")
+	if err := code.WithAddon(addon).RenderImage(img, r, 20); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_addon.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderISBNImage(t *testing.T) {
+	r := image.Rect(0, 0, 600, 300)
+	img := image.NewGray(r)
+	code, _ := ISBNFromString("978-3-16-148410-0")
+	if err := code.RenderImage(img, r, 20); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_isbn.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderITF14Pdf(t *testing.T) {
+	doc := pdf.New()
+	p := doc.NewPage(pdf.USLetterWidth, pdf.USLetterHeight)
+	gtin, _ := GTIN14FromString("15400141288763")
+	rect := pdf.Rectangle{
+		Min: pdf.Point{X: 0.5 * pdf.Inch, Y: 0.5 * pdf.Inch},
+		Max: pdf.Point{X: 6.5 * pdf.Inch, Y: 2 * pdf.Inch},
+	}
+	if err := ITF14FromGTIN14(gtin).RenderPdf(p, rect, 0.1*pdf.Inch); err != nil {
+		t.Fatal(err)
+	}
+	p.Close()
+
+	f, _ := os.Create("test_itf14.pdf")
+	defer f.Close()
+	doc.Encode(f)
+}
+
+func TestRenderCode128Image(t *testing.T) {
+	r := image.Rect(0, 0, 600, 200)
+	img := image.NewGray(r)
+	code, _ := Code128FromString("Code 128 ABC1234")
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_code128.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderGS1128Pdf(t *testing.T) {
+	doc := pdf.New()
+	p := doc.NewPage(pdf.USLetterWidth, pdf.USLetterHeight)
+	code, _ := GS1128FromString("(01)09501101530003(17)250101(10)ABC123")
+	rect := pdf.Rectangle{
+		Min: pdf.Point{X: 0.5 * pdf.Inch, Y: 0.5 * pdf.Inch},
+		Max: pdf.Point{X: 6.5 * pdf.Inch, Y: 2 * pdf.Inch},
+	}
+	if err := code.RenderPdf(p, rect, 0.1*pdf.Inch); err != nil {
+		t.Fatal(err)
+	}
+	p.Close()
+
+	f, _ := os.Create("test_gs1128.pdf")
+	defer f.Close()
+	doc.Encode(f)
+}
+
+func TestRenderCode39Image(t *testing.T) {
+	r := image.Rect(0, 0, 800, 100)
+	img := image.NewGray(r)
+	code, _ := Code39FullASCIIFromString("Part-0042")
+	if err := code.WithChecksum().RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_code39.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderCode93Image(t *testing.T) {
+	r := image.Rect(0, 0, 600, 100)
+	img := image.NewGray(r)
+	code, _ := Code93ExtendedFromString("Part-0042")
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_code93.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderCodabarImage(t *testing.T) {
+	r := image.Rect(0, 0, 600, 100)
+	img := image.NewGray(r)
+	code, _ := CodabarFromString("31117013206375")
+	if err := code.WithChecksum().RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_codabar.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderInterleaved2of5Image(t *testing.T) {
+	r := image.Rect(0, 0, 400, 100)
+	img := image.NewGray(r)
+	code, _ := Interleaved2of5FromString("1234567")
+	if err := code.WithChecksum().WithBearer(BearerTopBottom).RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_interleaved2of5.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderStandard2of5Image(t *testing.T) {
+	r := image.Rect(0, 0, 400, 100)
+	img := image.NewGray(r)
+	code, _ := Standard2of5FromString("1234567")
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_standard2of5.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderDataBarImage(t *testing.T) {
+	r := image.Rect(0, 0, 300, 200)
+	img := image.NewGray(r)
+	gtin, _ := GTIN14FromString("00012345678905")
+	code := DataBarFromGTIN14(gtin).WithStyle(DataBarStackedOmnidirectional)
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_databar.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderDataBarExpandedImage(t *testing.T) {
+	r := image.Rect(0, 0, 400, 200)
+	img := image.NewGray(r)
+	code, _ := DataBarExpandedFromString("(01)98898765432106(3202)012345(15)991231")
+	code, _ = code.WithSegmentsPerRow(4)
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_databar_expanded.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderIntelligentMailImage(t *testing.T) {
+	r := image.Rect(0, 0, 400, 60)
+	img := image.NewGray(r)
+	code, _ := IntelligentMailFromFields("01", "234", "567094", "987654321", "01234567891")
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_intelligentmail.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderRM4SCCImage(t *testing.T) {
+	r := image.Rect(0, 0, 300, 60)
+	img := image.NewGray(r)
+	code, _ := RM4SCCFromString("SN34RD1A")
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_rm4scc.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderKIXImage(t *testing.T) {
+	r := image.Rect(0, 0, 300, 60)
+	img := image.NewGray(r)
+	code, _ := KIXFromString("2500GG30250")
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_kix.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderAusPostImage(t *testing.T) {
+	r := image.Rect(0, 0, 300, 60)
+	img := image.NewGray(r)
+	code, _ := AusPostFromString("39987520AbC")
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_auspost.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderPharmacodeImage(t *testing.T) {
+	r := image.Rect(0, 0, 300, 100)
+	img := image.NewGray(r)
+	code, _ := PharmacodeFromInt(1234)
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_pharmacode.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderPZNImage(t *testing.T) {
+	r := image.Rect(0, 0, 600, 100)
+	img := image.NewGray(r)
+	code, _ := PZNFromString("12345678")
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_pzn.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderMSIImage(t *testing.T) {
+	r := image.Rect(0, 0, 400, 100)
+	img := image.NewGray(r)
+	code, _ := MSIFromString("1234567")
+	code, _ = code.WithCheck(MSIMod10)
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_msi.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderPlesseyImage(t *testing.T) {
+	r := image.Rect(0, 0, 600, 100)
+	img := image.NewGray(r)
+	code, _ := PlesseyFromString("01234ABCD")
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_plessey.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderQRCodeImage(t *testing.T) {
+	r := image.Rect(0, 0, 300, 300)
+	img := image.NewGray(r)
+	code, _ := QRCodeFromString("https://id.gs1.org/01/05901234123457", QRLevelM)
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_qrcode.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderMicroQRImage(t *testing.T) {
+	r := image.Rect(0, 0, 200, 200)
+	img := image.NewGray(r)
+	code, _ := MicroQRFromString("MICRO QR", QRLevelM)
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_microqr.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderPDF417Image(t *testing.T) {
+	r := image.Rect(0, 0, 600, 300)
+	img := image.NewGray(r)
+	code, _ := PDF417FromString("PDF417 shipping document, 0123456789012345")
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_pdf417.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderAztecImage(t *testing.T) {
+	r := image.Rect(0, 0, 300, 300)
+	img := image.NewGray(r)
+	code, _ := AztecFromString("Ticket 0123456789, seat 42A", 23)
+	if err := code.RenderImage(img, r, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	f, _ := os.Create("test_aztec.gif")
+	defer f.Close()
+	gif.Encode(f, img, nil)
+}
+
+func TestRenderDataMatrixPdf(t *testing.T) {
+	doc := pdf.New()
+	p := doc.NewPage(pdf.USLetterWidth, pdf.USLetterHeight)
+	es, _ := GS1ElementStringFromString("(01)09501101530003(17)250101(10)ABC123")
+	code, _ := DataMatrixFromGS1(es, DataMatrixSquare)
+	rect := pdf.Rectangle{
+		Min: pdf.Point{X: 0.5 * pdf.Inch, Y: 0.5 * pdf.Inch},
+		Max: pdf.Point{X: 2 * pdf.Inch, Y: 2 * pdf.Inch},
+	}
+	if err := code.RenderPdf(p, rect, 0.1*pdf.Inch); err != nil {
+		t.Fatal(err)
+	}
+	p.Close()
+
+	f, _ := os.Create("test_datamatrix.pdf")
+	defer f.Close()
+	doc.Encode(f)
+}
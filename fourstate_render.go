@@ -0,0 +1,64 @@
+package barcode
+
+// fourStateBar is a bar of a 4-state postal symbol, made of the tracker in the
+// middle and optionally an ascender above it and a descender below it.
+type fourStateBar int
+
+const (
+	barTracker fourStateBar = iota
+	barAscender
+	barDescender
+	barFull
+)
+
+// Heights in modules of the tracker and of the ascender and descender
+const (
+	fourStateTrackerHeight  = 2
+	fourStateExtenderHeight = 3
+)
+
+// fourStateSymbol describes a 4-state postal symbol, whose bars are one module wide
+// and one module apart.
+type fourStateSymbol struct {
+	bars []fourStateBar
+	// Quiet zone on the left and right in modules
+	quietZone int
+	// Whether the bars have no tracker, the ascenders and descenders meeting in the
+	// middle as in two-track Pharmacode
+	twoTrack bool
+}
+
+func (s fourStateSymbol) trackerHeight() int {
+	if s.twoTrack {
+		return 0
+	}
+	return fourStateTrackerHeight
+}
+
+func (s fourStateSymbol) layout() eanLayout {
+	width := 0
+	if len(s.bars) > 0 {
+		width = 2*len(s.bars) - 1
+	}
+	return eanLayout{
+		width:  width + 2*s.quietZone,
+		height: s.trackerHeight() + 2*fourStateExtenderHeight,
+	}
+}
+
+func renderFourState(s fourStateSymbol, r eanRenderer) {
+	c := r.Start()
+	for i, bar := range s.bars {
+		top, bottom := fourStateExtenderHeight, fourStateExtenderHeight+s.trackerHeight()
+		if bar == barAscender || bar == barFull {
+			top = 0
+		}
+		if bar == barDescender || bar == barFull {
+			bottom += fourStateExtenderHeight
+		}
+		rect := c.translateModule(s.quietZone+2*i, top)
+		rect.Max.Y += (bottom - top - 1) * c.scale
+		r.DrawBar(rect)
+	}
+	r.End()
+}
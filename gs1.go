@@ -0,0 +1,426 @@
+package barcode
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// gs1Check is an additional validation of the first component of an element.
+type gs1Check int
+
+const (
+	gs1NoCheck gs1Check = iota
+	// The component ends with a GS1 mod-10 check digit
+	gs1CheckDigit
+	// The component is a date YYMMDD, followed by the hour HH and the minutes MM
+	// when longer
+	gs1Date
+	// The component is a date YYMMDD whose day may be 00 when unknown
+	gs1DateNoDay
+)
+
+// gs1AI describes the data following an Application Identifier. The format uses
+// the notation of the GS1 General Specifications, with components separated by
+// '+': "N6" is exactly 6 digits, "N..15" up to 15 digits and "X..20" up to 20
+// characters of the GS1 character set 82. "Y" stands for the character set 39
+// and "Z" for the character set 64.
+type gs1AI struct {
+	format string
+	check  gs1Check
+}
+
+// gs1Component is a parsed component of a format.
+type gs1Component struct {
+	charset string
+	fixed   bool
+	length  int
+}
+
+var gs1AIs = map[string]gs1AI{
+	"00":   {"N18", gs1CheckDigit},
+	"01":   {"N14", gs1CheckDigit},
+	"02":   {"N14", gs1CheckDigit},
+	"03":   {"N14", gs1CheckDigit},
+	"10":   {"X..20", gs1NoCheck},
+	"11":   {"N6", gs1DateNoDay},
+	"12":   {"N6", gs1DateNoDay},
+	"13":   {"N6", gs1DateNoDay},
+	"15":   {"N6", gs1DateNoDay},
+	"16":   {"N6", gs1DateNoDay},
+	"17":   {"N6", gs1DateNoDay},
+	"20":   {"N2", gs1NoCheck},
+	"21":   {"X..20", gs1NoCheck},
+	"22":   {"X..20", gs1NoCheck},
+	"235":  {"X..28", gs1NoCheck},
+	"240":  {"X..30", gs1NoCheck},
+	"241":  {"X..30", gs1NoCheck},
+	"242":  {"N..6", gs1NoCheck},
+	"243":  {"X..20", gs1NoCheck},
+	"250":  {"X..30", gs1NoCheck},
+	"251":  {"X..30", gs1NoCheck},
+	"253":  {"N13+X..17", gs1CheckDigit},
+	"254":  {"X..20", gs1NoCheck},
+	"255":  {"N13+N..12", gs1CheckDigit},
+	"30":   {"N..8", gs1NoCheck},
+	"37":   {"N..8", gs1NoCheck},
+	"400":  {"X..30", gs1NoCheck},
+	"401":  {"X..30", gs1NoCheck},
+	"402":  {"N17", gs1CheckDigit},
+	"403":  {"X..30", gs1NoCheck},
+	"410":  {"N13", gs1CheckDigit},
+	"411":  {"N13", gs1CheckDigit},
+	"412":  {"N13", gs1CheckDigit},
+	"413":  {"N13", gs1CheckDigit},
+	"414":  {"N13", gs1CheckDigit},
+	"415":  {"N13", gs1CheckDigit},
+	"416":  {"N13", gs1CheckDigit},
+	"417":  {"N13", gs1CheckDigit},
+	"420":  {"X..20", gs1NoCheck},
+	"421":  {"N3+X..9", gs1NoCheck},
+	"422":  {"N3", gs1NoCheck},
+	"423":  {"N3+N..12", gs1NoCheck},
+	"424":  {"N3", gs1NoCheck},
+	"425":  {"N3+N..12", gs1NoCheck},
+	"426":  {"N3", gs1NoCheck},
+	"427":  {"X..3", gs1NoCheck},
+	"4300": {"X..35", gs1NoCheck},
+	"4301": {"X..35", gs1NoCheck},
+	"4302": {"X..70", gs1NoCheck},
+	"4303": {"X..70", gs1NoCheck},
+	"4304": {"X..70", gs1NoCheck},
+	"4305": {"X..70", gs1NoCheck},
+	"4306": {"X..70", gs1NoCheck},
+	"4307": {"X2", gs1NoCheck},
+	"4308": {"X..30", gs1NoCheck},
+	"4309": {"N20", gs1NoCheck},
+	"4310": {"X..35", gs1NoCheck},
+	"4311": {"X..35", gs1NoCheck},
+	"4312": {"X..70", gs1NoCheck},
+	"4313": {"X..70", gs1NoCheck},
+	"4314": {"X..70", gs1NoCheck},
+	"4315": {"X..70", gs1NoCheck},
+	"4316": {"X..70", gs1NoCheck},
+	"4317": {"X2", gs1NoCheck},
+	"4318": {"X..20", gs1NoCheck},
+	"4319": {"X..30", gs1NoCheck},
+	"4320": {"X..35", gs1NoCheck},
+	"4321": {"N1", gs1NoCheck},
+	"4322": {"N1", gs1NoCheck},
+	"4323": {"N1", gs1NoCheck},
+	"4324": {"N10", gs1Date},
+	"4325": {"N10", gs1Date},
+	"4326": {"N6", gs1Date},
+	"7001": {"N13", gs1NoCheck},
+	"7002": {"X..30", gs1NoCheck},
+	"7003": {"N10", gs1Date},
+	"7004": {"N..4", gs1NoCheck},
+	"7005": {"X..12", gs1NoCheck},
+	"7006": {"N6", gs1Date},
+	"7007": {"N6+N..6", gs1Date},
+	"7008": {"X..3", gs1NoCheck},
+	"7009": {"X..10", gs1NoCheck},
+	"7010": {"X..2", gs1NoCheck},
+	"7011": {"N6+N..4", gs1Date},
+	"7020": {"X..20", gs1NoCheck},
+	"7021": {"X..20", gs1NoCheck},
+	"7022": {"X..20", gs1NoCheck},
+	"7023": {"X..30", gs1NoCheck},
+	"7040": {"N1+X3", gs1NoCheck},
+	"710":  {"X..20", gs1NoCheck},
+	"711":  {"X..20", gs1NoCheck},
+	"712":  {"X..20", gs1NoCheck},
+	"713":  {"X..20", gs1NoCheck},
+	"714":  {"X..20", gs1NoCheck},
+	"715":  {"X..20", gs1NoCheck},
+	"716":  {"X..20", gs1NoCheck},
+	"7240": {"X..20", gs1NoCheck},
+	"7241": {"N2", gs1NoCheck},
+	"7242": {"X..25", gs1NoCheck},
+	"7250": {"N8", gs1NoCheck},
+	"7251": {"N12", gs1NoCheck},
+	"7252": {"N1", gs1NoCheck},
+	"7253": {"X..40", gs1NoCheck},
+	"7254": {"X..40", gs1NoCheck},
+	"7255": {"X..10", gs1NoCheck},
+	"7256": {"X..90", gs1NoCheck},
+	"7257": {"X..70", gs1NoCheck},
+	"7258": {"N1+X1+N1", gs1NoCheck},
+	"7259": {"X..40", gs1NoCheck},
+	"8001": {"N14", gs1NoCheck},
+	"8002": {"X..20", gs1NoCheck},
+	"8003": {"N14+X..16", gs1CheckDigit},
+	"8004": {"X..30", gs1NoCheck},
+	"8005": {"N6", gs1NoCheck},
+	"8006": {"N14+N2+N2", gs1CheckDigit},
+	"8007": {"X..34", gs1NoCheck},
+	"8008": {"N8+N..4", gs1Date},
+	"8009": {"X..50", gs1NoCheck},
+	"8010": {"Y..30", gs1NoCheck},
+	"8011": {"N..12", gs1NoCheck},
+	"8012": {"X..20", gs1NoCheck},
+	"8013": {"X..25", gs1NoCheck},
+	"8014": {"X..25", gs1NoCheck},
+	"8017": {"N18", gs1CheckDigit},
+	"8018": {"N18", gs1CheckDigit},
+	"8019": {"N..10", gs1NoCheck},
+	"8020": {"X..25", gs1NoCheck},
+	"8026": {"N14+N2+N2", gs1CheckDigit},
+	"8030": {"Z..90", gs1NoCheck},
+	"8110": {"X..70", gs1NoCheck},
+	"8111": {"N4", gs1NoCheck},
+	"8112": {"X..70", gs1NoCheck},
+	"8200": {"X..70", gs1NoCheck},
+	"90":   {"X..30", gs1NoCheck},
+}
+
+// gs1AIFamilies lists the AIs whose last digit is a parameter, such as the
+// position of the implied decimal point, with the range of that digit.
+var gs1AIFamilies = []struct {
+	first, last string
+	maxDigit    byte
+	ai          gs1AI
+}{
+	{"310", "316", '5', gs1AI{"N6", gs1NoCheck}},
+	{"320", "337", '5', gs1AI{"N6", gs1NoCheck}},
+	{"340", "357", '5', gs1AI{"N6", gs1NoCheck}},
+	{"360", "369", '5', gs1AI{"N6", gs1NoCheck}},
+	{"390", "390", '9', gs1AI{"N..15", gs1NoCheck}},
+	{"391", "391", '9', gs1AI{"N3+N..15", gs1NoCheck}},
+	{"392", "392", '9', gs1AI{"N..15", gs1NoCheck}},
+	{"393", "393", '9', gs1AI{"N3+N..15", gs1NoCheck}},
+	{"394", "394", '3', gs1AI{"N4", gs1NoCheck}},
+	{"395", "395", '5', gs1AI{"N6", gs1NoCheck}},
+	{"703", "703", '9', gs1AI{"N3+X..27", gs1NoCheck}},
+	{"723", "723", '9', gs1AI{"X2+X..28", gs1NoCheck}},
+	{"91", "99", 0, gs1AI{"X..90", gs1NoCheck}},
+}
+
+// gs1DecimalFamilies lists the families of AIs whose last digit gives the number of
+// decimal places of the value.
+var gs1DecimalFamilies = map[string]bool{
+	"31": true, "32": true, "33": true, "34": true, "35": true, "36": true, "39": true,
+}
+
+func hasImpliedDecimalPoint(ai string) bool {
+	return len(ai) == 4 && gs1DecimalFamilies[ai[:2]]
+}
+
+func init() {
+	for _, f := range gs1AIFamilies {
+		first, _ := strconv.Atoi(f.first)
+		last, _ := strconv.Atoi(f.last)
+		for p := first; p <= last; p++ {
+			prefix := strconv.Itoa(p)
+			if f.maxDigit == 0 {
+				gs1AIs[prefix] = f.ai
+				continue
+			}
+			for d := byte('0'); d <= f.maxDigit; d++ {
+				gs1AIs[prefix+string(d)] = f.ai
+			}
+		}
+	}
+}
+
+// gs1PredefinedLengths gives the total length of the AI and its data for the AIs
+// starting with these two digits. These elements are never followed by FNC1.
+var gs1PredefinedLengths = map[string]int{
+	"00": 20, "01": 16, "02": 16, "03": 16, "04": 18,
+	"11": 8, "12": 8, "13": 8, "14": 8, "15": 8, "16": 8, "17": 8, "18": 8, "19": 8,
+	"20": 4, "31": 10, "32": 10, "33": 10, "34": 10, "35": 10, "36": 10, "41": 16,
+}
+
+func hasPredefinedLength(ai string) bool {
+	_, ok := gs1PredefinedLengths[ai[:2]]
+	return ok
+}
+
+// gs1CharacterSets gives the characters of each character set of the formats.
+var gs1CharacterSets = map[byte]string{
+	'N': "0123456789",
+	'X': "!\"%&'()*+,-./0123456789:;<=>?ABCDEFGHIJKLMNOPQRSTUVWXYZ_abcdefghijklmnopqrstuvwxyz",
+	'Y': "#-/0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	'Z': "-0123456789=ABCDEFGHIJKLMNOPQRSTUVWXYZ_abcdefghijklmnopqrstuvwxyz",
+}
+
+var (
+	errInvalidGS1   = errors.New("Invalid GS1 element string")
+	errUnknownGS1AI = errors.New("Unknown GS1 Application Identifier")
+	errInvalidGS1AI = errors.New("Invalid data for GS1 Application Identifier")
+)
+
+func parseGS1Format(format string) []gs1Component {
+	var components []gs1Component
+	for _, f := range strings.Split(format, "+") {
+		c := gs1Component{charset: gs1CharacterSets[f[0]]}
+		if strings.HasPrefix(f[1:], "..") {
+			c.length, _ = strconv.Atoi(f[3:])
+		} else {
+			c.fixed = true
+			c.length, _ = strconv.Atoi(f[1:])
+		}
+		components = append(components, c)
+	}
+	return components
+}
+
+// gs1Year returns the full year of a two digit year, following the GS1 rule that
+// dates are at most 49 years in the future and 50 years in the past.
+func gs1Year(yy int, now time.Time) int {
+	year := now.Year()
+	century := year - year%100
+	switch diff := yy - year%100; {
+	case diff >= 51:
+		century -= 100
+	case diff <= -50:
+		century += 100
+	}
+	return century + yy
+}
+
+// parseGS1Date parses a date YYMMDD. When noDay is set, a day of 00 stands for
+// the last day of the month.
+func parseGS1Date(date string, noDay bool) (time.Time, bool) {
+	if len(date) != 6 || !allDigits(date) {
+		return time.Time{}, false
+	}
+	yy, _ := strconv.Atoi(date[0:2])
+	mm, _ := strconv.Atoi(date[2:4])
+	dd, _ := strconv.Atoi(date[4:6])
+	if mm < 1 || mm > 12 {
+		return time.Time{}, false
+	}
+	year := gs1Year(yy, time.Now())
+	// Day 0 of the next month is the last day of this month
+	last := time.Date(year, time.Month(mm)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if dd == 0 && noDay {
+		dd = last
+	}
+	if dd < 1 || dd > last {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.Month(mm), dd, 0, 0, 0, 0, time.UTC), true
+}
+
+// validGS1Time checks a time HH or HHMM following a date.
+func validGS1Time(hhmm string) bool {
+	if hh, _ := strconv.Atoi(hhmm[:2]); hh > 23 {
+		return false
+	}
+	if len(hhmm) >= 4 {
+		if mm, _ := strconv.Atoi(hhmm[2:4]); mm > 59 {
+			return false
+		}
+	}
+	return true
+}
+
+// validateGS1Element checks that data has the format required by ai.
+func validateGS1Element(ai, data string) error {
+	def, ok := gs1AIs[ai]
+	if !ok {
+		return errUnknownGS1AI
+	}
+	rest := data
+	for i, c := range parseGS1Format(def.format) {
+		var part string
+		switch {
+		case c.fixed && len(rest) >= c.length:
+			part, rest = rest[:c.length], rest[c.length:]
+		case !c.fixed && len(rest) >= 1 && len(rest) <= c.length:
+			part, rest = rest, ""
+		default:
+			return errInvalidGS1AI
+		}
+		for _, r := range part {
+			if !strings.ContainsRune(c.charset, r) {
+				return errInvalidGS1AI
+			}
+		}
+		if i > 0 {
+			continue
+		}
+		switch def.check {
+		case gs1CheckDigit:
+			code, _ := strconv.ParseUint(part, 10, 64)
+			if code%10 != computeEANChecksum(code/10) {
+				return errInvalidGS1AI
+			}
+		case gs1Date, gs1DateNoDay:
+			if _, ok := parseGS1Date(part[:6], def.check == gs1DateNoDay); !ok {
+				return errInvalidGS1AI
+			}
+			if len(part) > 6 && !validGS1Time(part[6:]) {
+				return errInvalidGS1AI
+			}
+		}
+	}
+	if rest != "" {
+		return errInvalidGS1AI
+	}
+	return nil
+}
+
+// gs1Element is an Application Identifier with its data.
+type gs1Element struct {
+	ai   string
+	data string
+}
+
+// bracketedAI returns the AI at the start of s if s starts with a known AI in
+// parentheses, and the length of the bracketed AI.
+func bracketedAI(s string) (string, int) {
+	end := strings.IndexByte(s, ')')
+	if len(s) == 0 || s[0] != '(' || end < 0 {
+		return "", 0
+	}
+	ai := s[1:end]
+	if _, ok := gs1AIs[ai]; !ok {
+		return "", 0
+	}
+	return ai, end + 1
+}
+
+// parseBracketedGS1 parses an element string in the human readable notation,
+// such as "(01)09501101530003(17)250101", and validates each element.
+func parseBracketedGS1(s string) ([]gs1Element, error) {
+	var elements []gs1Element
+	for len(s) > 0 {
+		ai, n := bracketedAI(s)
+		if n == 0 {
+			if strings.HasPrefix(s, "(") {
+				return nil, errUnknownGS1AI
+			}
+			return nil, errInvalidGS1
+		}
+		s = s[n:]
+		end := len(s)
+		// The data runs until the next bracketed AI
+		for i := 0; i < len(s); i++ {
+			if _, m := bracketedAI(s[i:]); m > 0 {
+				end = i
+				break
+			}
+		}
+		if err := validateGS1Element(ai, s[:end]); err != nil {
+			return nil, err
+		}
+		elements = append(elements, gs1Element{ai, s[:end]})
+		s = s[end:]
+	}
+	if len(elements) == 0 {
+		return nil, errInvalidGS1
+	}
+	return elements, nil
+}
+
+// bracketedGS1 returns the human readable interpretation of elements.
+func bracketedGS1(elements []gs1Element) string {
+	var b strings.Builder
+	for _, e := range elements {
+		b.WriteString("(" + e.ai + ")" + e.data)
+	}
+	return b.String()
+}
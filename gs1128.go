@@ -0,0 +1,71 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"image"
+	"image/draw"
+)
+
+// GS1128 is a Code 128 symbol starting with FNC1 and encoding GS1 element strings.
+type GS1128 struct {
+	code     Code128
+	elements GS1ElementString
+}
+
+// GS1128FromString encodes an element string in the human readable notation,
+// such as "(01)09501101530003(17)250101(10)ABC123". Each element is validated
+// against the format of its Application Identifier.
+func GS1128FromString(s string) (GS1128, error) {
+	es, err := GS1ElementStringFromString(s)
+	if err != nil {
+		return GS1128{}, err
+	}
+	return GS1128FromElementString(es)
+}
+
+func GS1128FromElementString(es GS1ElementString) (GS1128, error) {
+	if len(es.elements) == 0 {
+		return GS1128{}, errInvalidGS1
+	}
+	data := []int{code128FNC1}
+	for i, e := range es.elements {
+		for _, c := range e.ai + e.data {
+			data = append(data, int(c))
+		}
+		// Only elements of variable length need a separator, unless they come last
+		if needsGS1Separator(es.elements, i) {
+			data = append(data, code128FNC1)
+		}
+	}
+	code, err := code128FromData(data, es.String())
+	if err != nil {
+		return GS1128{}, err
+	}
+	return GS1128{
+		code:     code,
+		elements: es,
+	}, nil
+}
+
+// String returns the human readable interpretation, with the AIs in parentheses.
+func (gs1 GS1128) String() string {
+	return gs1.code.String()
+}
+
+// ElementString returns the encoded element string.
+func (gs1 GS1128) ElementString() GS1ElementString {
+	return gs1.elements
+}
+
+// Code128 returns the underlying Code 128 symbol.
+func (gs1 GS1128) Code128() Code128 {
+	return gs1.code
+}
+
+func (gs1 GS1128) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	return gs1.code.RenderImage(img, bound, padding)
+}
+
+func (gs1 GS1128) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	return gs1.code.RenderPdf(canvas, bound, padding)
+}
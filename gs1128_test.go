@@ -0,0 +1,46 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func countFNC1(code Code128) int {
+	n := 0
+	// The checksum is not a symbol character
+	for _, v := range code.values[1 : len(code.values)-1] {
+		if v == code128FNC1Value {
+			n++
+		}
+	}
+	return n
+}
+
+func TestGS1128(t *testing.T) {
+	data := []struct {
+		text string
+		fnc1 int
+	}{
+		{"(01)09501101530003(17)250101(10)ABC123", 1},
+		{"(10)ABC123(01)09501101530003", 2},
+		{"(01)09501101530003(3103)001250(21)12345", 1},
+		{"(400)PO123(7004)1234(422)250", 3},
+	}
+	for _, d := range data {
+		gs1, err := GS1128FromString(d.text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gs1.String() != d.text {
+			t.Errorf("Unexpected text %q v.s. %q", d.text, gs1.String())
+		}
+		if gs1.Code128().values[0] != code128StartAValue+code128SetC {
+			t.Errorf("Unexpected start of %q", d.text)
+		}
+		if n := countFNC1(gs1.Code128()); n != d.fnc1 {
+			t.Errorf("Unexpected FNC1 count of %q: %d v.s. %d", d.text, d.fnc1, n)
+		}
+	}
+	if _, err := GS1128FromString("(01)09501101530004"); err == nil {
+		t.Errorf("Unexpected valid GTIN")
+	}
+}
@@ -0,0 +1,79 @@
+package barcode
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateGS1Element(t *testing.T) {
+	data := []struct {
+		ai, data string
+		valid    bool
+	}{
+		{"01", "09501101530003", true},
+		{"01", "09501101530004", false},
+		{"01", "0950110153000", false},
+		{"00", "106141411234567897", true},
+		{"17", "250101", true},
+		{"17", "250100", true},
+		{"17", "251301", false},
+		{"7006", "250100", false},
+		{"11", "240230", false},
+		{"7003", "2501312359", true},
+		{"7003", "2501312400", false},
+		{"7003", "2501310060", false},
+		{"4324", "2501010000", true},
+		{"4324", "2501012500", false},
+		{"4325", "2501011299", false},
+		{"8008", "250101230000", true},
+		{"8008", "250101240000", false},
+		{"10", "ABC123", true},
+		{"10", "ABC 123", false},
+		{"10", "123456789012345678901", false},
+		{"3103", "001250", true},
+		{"3106", "001250", false},
+		{"3922", "1299", true},
+		{"421", "528ABC", true},
+		{"421", "52", false},
+		{"253", "4012345000016ABC", true},
+		{"99", "anything", true},
+		{"23", "1", false},
+	}
+	for _, d := range data {
+		err := validateGS1Element(d.ai, d.data)
+		if (err == nil) != d.valid {
+			t.Errorf("Unexpected validation of (%s)%s: %v", d.ai, d.data, err)
+		}
+	}
+}
+
+func TestGS1Year(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	data := map[int]int{24: 2024, 73: 2073, 74: 2074, 75: 1975, 99: 1999, 0: 2000}
+	for yy, year := range data {
+		if y := gs1Year(yy, now); y != year {
+			t.Errorf("Unexpected year of %02d: %d v.s. %d", yy, year, y)
+		}
+	}
+}
+
+func TestParseBracketedGS1(t *testing.T) {
+	elements, err := parseBracketedGS1("(01)09501101530003(17)250101(10)AB(C)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []gs1Element{{"01", "09501101530003"}, {"17", "250101"}, {"10", "AB(C)"}}
+	if len(elements) != len(expected) {
+		t.Fatalf("Unexpected elements %v", elements)
+	}
+	for i := range expected {
+		if elements[i] != expected[i] {
+			t.Errorf("Unexpected elements %v", elements)
+		}
+	}
+	for _, s := range []string{"", "01)09501101530003", "(23)1", "(01)09501101530003(10)"} {
+		if _, err := parseBracketedGS1(s); err == nil {
+			t.Errorf("Unexpected valid element string %q", s)
+		}
+	}
+}
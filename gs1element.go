@@ -0,0 +1,203 @@
+package barcode
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GS1ElementString is a sequence of GS1 Application Identifiers with their data,
+// as encoded in GS1-128 and other GS1 symbols.
+type GS1ElementString struct {
+	elements []gs1Element
+}
+
+// gs1Separator is the group separator scanners transmit in place of FNC1.
+const gs1Separator = '\x1d'
+
+var (
+	errMissingGS1AI = errors.New("GS1 Application Identifier not present")
+	errNotGS1Date   = errors.New("GS1 Application Identifier is not a date")
+	errNotGS1Number = errors.New("GS1 Application Identifier has no implied decimal point")
+)
+
+// GS1ElementStringFromString parses an element string in the human readable
+// notation, such as "(01)09501101530003(17)250101(10)ABC123".
+func GS1ElementStringFromString(s string) (GS1ElementString, error) {
+	elements, err := parseBracketedGS1(s)
+	if err != nil {
+		return GS1ElementString{}, err
+	}
+	return GS1ElementString{elements}, nil
+}
+
+// GS1ElementStringFromScan parses the data transmitted by a scanner, where the
+// elements of variable length are terminated by a group separator (ASCII 29).
+// A leading symbology identifier such as "]C1" is skipped.
+func GS1ElementStringFromScan(s string) (GS1ElementString, error) {
+	if len(s) >= 3 && s[0] == ']' {
+		s = s[3:]
+	}
+	s = strings.TrimPrefix(s, string(gs1Separator))
+	var elements []gs1Element
+	for len(s) > 0 {
+		ai := ""
+		for n := 2; n <= 4 && n <= len(s); n++ {
+			if _, ok := gs1AIs[s[:n]]; ok {
+				ai = s[:n]
+				break
+			}
+		}
+		if ai == "" {
+			return GS1ElementString{}, errUnknownGS1AI
+		}
+		s = s[len(ai):]
+		end := strings.IndexByte(s, gs1Separator)
+		if end < 0 {
+			end = len(s)
+		}
+		if length, ok := gs1PredefinedLengths[ai[:2]]; ok && length-len(ai) <= len(s) {
+			end = length - len(ai)
+		}
+		if err := validateGS1Element(ai, s[:end]); err != nil {
+			return GS1ElementString{}, err
+		}
+		elements = append(elements, gs1Element{ai, s[:end]})
+		s = strings.TrimPrefix(s[end:], string(gs1Separator))
+	}
+	if len(elements) == 0 {
+		return GS1ElementString{}, errInvalidGS1
+	}
+	return GS1ElementString{elements}, nil
+}
+
+// String returns the human readable notation, with the AIs in parentheses.
+func (es GS1ElementString) String() string {
+	return bracketedGS1(es.elements)
+}
+
+// Scan returns the data as transmitted by a scanner, without symbology identifier,
+// with group separators after the elements of variable length.
+func (es GS1ElementString) Scan() string {
+	var b strings.Builder
+	for i, e := range es.elements {
+		b.WriteString(e.ai + e.data)
+		if needsGS1Separator(es.elements, i) {
+			b.WriteByte(gs1Separator)
+		}
+	}
+	return b.String()
+}
+
+// AIs returns the Application Identifiers in order.
+func (es GS1ElementString) AIs() []string {
+	ais := make([]string, len(es.elements))
+	for i, e := range es.elements {
+		ais[i] = e.ai
+	}
+	return ais
+}
+
+// Value returns the data of the first element with the given AI.
+func (es GS1ElementString) Value(ai string) (string, bool) {
+	for _, e := range es.elements {
+		if e.ai == ai {
+			return e.data, true
+		}
+	}
+	return "", false
+}
+
+// GTIN returns the GTIN of the trade item, AI (01).
+func (es GS1ElementString) GTIN() (GTIN14, error) {
+	data, ok := es.Value("01")
+	if !ok {
+		return GTIN14{}, errMissingGS1AI
+	}
+	return GTIN14FromString(data)
+}
+
+// Date returns the date of a date AI such as (17), along with the time for the
+// AIs including hours and minutes. A day of 00 stands for the last day of the month.
+func (es GS1ElementString) Date(ai string) (time.Time, error) {
+	data, ok := es.Value(ai)
+	if !ok {
+		return time.Time{}, errMissingGS1AI
+	}
+	def := gs1AIs[ai]
+	if def.check != gs1Date && def.check != gs1DateNoDay {
+		return time.Time{}, errNotGS1Date
+	}
+	date, _ := parseGS1Date(data[:6], def.check == gs1DateNoDay)
+	// The rest of the first component gives the hours, minutes and seconds
+	length := parseGS1Format(def.format)[0].length
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+	for i := 6; i+2 <= length && i+2 <= len(data); i += 2 {
+		n, _ := strconv.Atoi(data[i : i+2])
+		date = date.Add(time.Duration(n) * units[(i-6)/2])
+	}
+	return date, nil
+}
+
+// Decimal returns the value of an AI whose last digit gives the position of the
+// implied decimal point, such as the net weight in kilograms (310n).
+func (es GS1ElementString) Decimal(ai string) (GS1Decimal, error) {
+	data, ok := es.Value(ai)
+	if !ok {
+		return GS1Decimal{}, errMissingGS1AI
+	}
+	if !hasImpliedDecimalPoint(ai) {
+		return GS1Decimal{}, errNotGS1Number
+	}
+	// Amounts in a currency start with the ISO 4217 code
+	components := parseGS1Format(gs1AIs[ai].format)
+	if len(components) > 1 {
+		data = data[components[0].length:]
+	}
+	value, err := strconv.ParseUint(data, 10, 64)
+	if err != nil {
+		return GS1Decimal{}, err
+	}
+	return GS1Decimal{
+		value:  value,
+		places: int(ai[3] - '0'),
+	}, nil
+}
+
+// needsGS1Separator returns whether the element i must be followed by FNC1.
+func needsGS1Separator(elements []gs1Element, i int) bool {
+	return i < len(elements)-1 && !hasPredefinedLength(elements[i].ai)
+}
+
+// GS1Decimal is a number with an implied decimal point.
+type GS1Decimal struct {
+	value  uint64
+	places int
+}
+
+// Value returns the digits of the number, without the decimal point.
+func (d GS1Decimal) Value() uint64 {
+	return d.value
+}
+
+// Places returns the number of digits after the decimal point.
+func (d GS1Decimal) Places() int {
+	return d.places
+}
+
+func (d GS1Decimal) Float64() float64 {
+	return float64(d.value) / math.Pow10(d.places)
+}
+
+func (d GS1Decimal) String() string {
+	s := strconv.FormatUint(d.value, 10)
+	if d.places == 0 {
+		return s
+	}
+	if len(s) <= d.places {
+		s = strings.Repeat("0", d.places-len(s)+1) + s
+	}
+	return s[:len(s)-d.places] + "." + s[len(s)-d.places:]
+}
@@ -0,0 +1,98 @@
+package barcode
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGS1ElementStringFromScan(t *testing.T) {
+	data := []struct {
+		scan, bracketed string
+	}{
+		{"]C10109501101530003172501011" + "0ABC123", "(01)09501101530003(17)250101(10)ABC123"},
+		{"\x1d10ABC\x1d0109501101530003", "(10)ABC(01)09501101530003"},
+		{"]d20109501101530003310300125039221299\x1d21XYZ", "(01)09501101530003(3103)001250(3922)1299(21)XYZ"},
+	}
+	for _, d := range data {
+		es, err := GS1ElementStringFromScan(d.scan)
+		if err != nil {
+			t.Fatalf("%q: %v", d.scan, err)
+		}
+		if es.String() != d.bracketed {
+			t.Errorf("Unexpected element string %q v.s. %q", d.bracketed, es.String())
+		}
+	}
+	for _, s := range []string{"", "01095011015300", "0109501101530004", "2312"} {
+		if _, err := GS1ElementStringFromScan(s); err == nil {
+			t.Errorf("Unexpected valid scan %q", s)
+		}
+	}
+}
+
+func TestGS1ElementStringScan(t *testing.T) {
+	es, _ := GS1ElementStringFromString("(10)ABC(01)09501101530003(3103)001250(21)XYZ")
+	if s := es.Scan(); s != "10ABC\x1d01095011015300033103001250"+"21XYZ" {
+		t.Errorf("Unexpected scan %q", s)
+	}
+	parsed, err := GS1ElementStringFromScan(es.Scan())
+	if err != nil || parsed.String() != es.String() {
+		t.Errorf("Unexpected round trip %q: %v", parsed.String(), err)
+	}
+}
+
+func TestGS1ElementStringFields(t *testing.T) {
+	es, err := GS1ElementStringFromString("(01)04012345123456(17)250200(7003)2403151230(3103)001250(3922)1299(3932)9781000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ais := es.AIs(); len(ais) != 6 || ais[1] != "17" {
+		t.Errorf("Unexpected AIs %v", ais)
+	}
+	gtin, err := es.GTIN()
+	if err != nil || gtin.Code14() != 4012345123456 {
+		t.Errorf("Unexpected GTIN %v: %v", gtin, err)
+	}
+	if ean, err := gtin.EAN13(); err != nil || ean.Code13() != 4012345123456 {
+		t.Errorf("Unexpected EAN %v: %v", ean, err)
+	}
+	expiry, err := es.Date("17")
+	if err != nil || !expiry.Equal(time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected expiry %v: %v", expiry, err)
+	}
+	expiry, err = es.Date("7003")
+	if err != nil || !expiry.Equal(time.Date(2024, 3, 15, 12, 30, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected expiry %v: %v", expiry, err)
+	}
+	if _, err := es.Date("3103"); err == nil {
+		t.Errorf("Unexpected date of (3103)")
+	}
+	if _, err := es.Date("11"); err == nil {
+		t.Errorf("Unexpected date of missing (11)")
+	}
+	decimals := map[string]string{"3103": "1.250", "3922": "12.99", "3932": "10.00"}
+	for ai, expected := range decimals {
+		d, err := es.Decimal(ai)
+		if err != nil || d.String() != expected {
+			t.Errorf("Unexpected decimal of (%s) %v v.s. %s: %v", ai, d, expected, err)
+		}
+	}
+	if d, _ := es.Decimal("3103"); d.Float64() != 1.25 || d.Value() != 1250 || d.Places() != 3 {
+		t.Errorf("Unexpected decimal %v", d)
+	}
+	if _, err := es.Decimal("17"); err == nil {
+		t.Errorf("Unexpected decimal of (17)")
+	}
+}
+
+func TestGS1DecimalString(t *testing.T) {
+	data := map[GS1Decimal]string{
+		{5, 3}:    "0.005",
+		{1250, 0}: "1250",
+		{125, 2}:  "1.25",
+	}
+	for d, expected := range data {
+		if d.String() != expected {
+			t.Errorf("Unexpected string %q v.s. %q", expected, d.String())
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// GTIN14 is a 14 digit Global Trade Item Number, used to identify trade items in
+// outer packaging. The first digit is the packaging indicator.
+type GTIN14 struct {
+	code14 uint64
+}
+
+func (gtin GTIN14) Code14() uint64 {
+	return gtin.code14
+}
+
+func (gtin GTIN14) Code13() uint64 {
+	return gtin.code14 / 10
+}
+
+func (gtin GTIN14) Checksum() uint8 {
+	return uint8(gtin.code14 % 10)
+}
+
+// Indicator returns the packaging indicator digit.
+func (gtin GTIN14) Indicator() uint8 {
+	return uint8(gtin.code14 / 10000000000000)
+}
+
+func (gtin GTIN14) String() string {
+	return fmt.Sprintf("%014d", gtin.code14)
+}
+
+var errNotEAN13 = errors.New("GTIN-14 has a packaging indicator")
+
+// EAN13 returns the EAN-13 code of a GTIN-14 whose packaging indicator is 0.
+func (gtin GTIN14) EAN13() (EAN13, error) {
+	if gtin.Indicator() != 0 {
+		return EAN13{}, errNotEAN13
+	}
+	return EAN13{
+		code13: gtin.code14,
+	}, nil
+}
+
+// GTIN14FromEAN13 builds the GTIN-14 of the packaging level indicator containing the
+// trade item ean. The check digit is computed again.
+func GTIN14FromEAN13(indicator uint8, ean EAN13) (GTIN14, error) {
+	if indicator > 9 {
+		return GTIN14{}, errInvalidEAN
+	}
+	return GTIN14FromCode13(uint64(indicator)*1000000000000 + ean.Code12())
+}
+
+func GTIN14FromCode13(code13 uint64) (GTIN14, error) {
+	if code13 > 9999999999999 {
+		return GTIN14{}, errInvalidEAN
+	}
+	checksum := computeEANChecksum(code13)
+	return GTIN14{
+		code14: code13*10 + checksum,
+	}, nil
+}
+
+func GTIN14FromCode14(code14 uint64) (GTIN14, error) {
+	if code14 > 99999999999999 {
+		return GTIN14{}, errInvalidEAN
+	}
+	checksum := computeEANChecksum(code14 / 10)
+	if code14%10 != checksum {
+		return GTIN14{}, errInvalidEANChecksum
+	}
+	return GTIN14{
+		code14: code14,
+	}, nil
+}
+
+func GTIN14FromString(code string) (GTIN14, error) {
+	if (len(code) != 13 && len(code) != 14) || !allDigits(code) {
+		return GTIN14{}, errInvalidEAN
+	}
+	c, err := strconv.ParseUint(code, 10, 64)
+	if err != nil {
+		return GTIN14{}, err
+	}
+	if len(code) == 13 {
+		return GTIN14FromCode13(c)
+	}
+	return GTIN14FromCode14(c)
+}
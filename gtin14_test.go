@@ -0,0 +1,40 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestGTIN14(t *testing.T) {
+	ean, _ := EAN13FromString("5901234123457")
+	gtin, err := GTIN14FromEAN13(1, ean)
+	if err != nil {
+		t.Fatalf("Invalid gtin-14 for ean %s", ean)
+	}
+	if gtin.String() != "15901234123454" {
+		t.Errorf("Unexpected gtin-14 %s", gtin)
+	}
+	if gtin.Indicator() != 1 {
+		t.Errorf("Unexpected indicator %d", gtin.Indicator())
+	}
+	if _, err := gtin.EAN13(); err == nil {
+		t.Errorf("Unexpected ean for gtin-14 %s", gtin)
+	}
+	gtin, _ = GTIN14FromEAN13(0, ean)
+	if back, err := gtin.EAN13(); err != nil || back != ean {
+		t.Errorf("Unexpected ean %s for gtin-14 %s", back, gtin)
+	}
+	if _, err := GTIN14FromString("15901234123454"); err != nil {
+		t.Errorf("Invalid gtin-14 %s", "15901234123454")
+	}
+	var invalidString = []string{
+		"15901234123455",  // invalid checksum
+		"159012341234",    // too short
+		"1590123412345a",  // not all digits
+		"159012341234541", // too long
+	}
+	for _, c := range invalidString {
+		if _, err := GTIN14FromString(c); err == nil {
+			t.Errorf("Unexpected valid code %s", c)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+	"math/big"
+)
+
+// Quiet zone of the Intelligent Mail barcode in modules
+const imbQuietZone = 6
+
+var errInvalidIntelligentMail = errors.New("Invalid Intelligent Mail barcode fields")
+
+// imbNof13Table returns the 13 bit characters with n bits set, each followed by
+// its reversal, the symmetric ones being at the end.
+func imbNof13Table(n, length int) []int {
+	table := make([]int, length)
+	lower, upper := 0, length-1
+	for c := 0; c < 1<<13; c++ {
+		count, reversed := 0, 0
+		for i := uint(0); i < 13; i++ {
+			bit := c >> i & 1
+			count += bit
+			reversed |= bit << (12 - i)
+		}
+		if count != n || reversed < c {
+			continue
+		}
+		if reversed == c {
+			table[upper] = c
+			upper--
+		} else {
+			table[lower], table[lower+1] = c, reversed
+			lower += 2
+		}
+	}
+	return table
+}
+
+// Characters of the codewords below 1287 and of the remaining ones
+var imb5of13, imb2of13 = imbNof13Table(5, 1287), imbNof13Table(2, 78)
+
+// imbBars gives for each bar the character and the bit of its descender, then of
+// its ascender.
+var imbBars = [65][4]uint{
+	{7, 2, 4, 3}, {1, 10, 0, 0}, {9, 12, 2, 8}, {5, 5, 6, 11}, {8, 9, 3, 1},
+	{0, 1, 5, 12}, {2, 5, 1, 8}, {4, 4, 9, 11}, {6, 3, 8, 10}, {3, 9, 7, 6},
+	{5, 11, 1, 4}, {8, 5, 2, 12}, {9, 10, 0, 2}, {7, 1, 6, 7}, {3, 6, 4, 9},
+	{0, 3, 8, 6}, {6, 4, 2, 7}, {1, 1, 9, 9}, {7, 10, 5, 2}, {4, 0, 3, 8},
+	{6, 2, 0, 4}, {8, 11, 1, 0}, {9, 8, 3, 12}, {2, 6, 7, 7}, {5, 1, 4, 10},
+	{1, 12, 6, 9}, {7, 3, 8, 0}, {5, 8, 9, 7}, {4, 6, 2, 10}, {3, 4, 0, 5},
+	{8, 4, 5, 7}, {7, 11, 1, 9}, {6, 0, 9, 6}, {0, 6, 4, 8}, {2, 1, 3, 2},
+	{5, 9, 8, 12}, {4, 11, 6, 1}, {9, 5, 7, 4}, {3, 3, 1, 2}, {0, 7, 2, 0},
+	{1, 3, 4, 1}, {6, 10, 3, 5}, {8, 7, 9, 4}, {2, 11, 5, 6}, {0, 8, 7, 12},
+	{4, 2, 8, 1}, {5, 10, 3, 0}, {9, 3, 0, 9}, {6, 5, 2, 4}, {7, 8, 1, 7},
+	{5, 0, 4, 5}, {2, 3, 0, 10}, {6, 12, 9, 2}, {3, 11, 1, 6}, {8, 8, 7, 9},
+	{5, 4, 0, 11}, {1, 5, 2, 2}, {9, 1, 4, 12}, {8, 3, 6, 6}, {7, 0, 3, 7},
+	{4, 7, 7, 5}, {0, 12, 1, 11}, {2, 9, 9, 0}, {6, 8, 5, 3}, {3, 10, 8, 2},
+}
+
+// IntelligentMail is a USPS Intelligent Mail barcode, made of a 20 digit tracking
+// code and a routing code.
+type IntelligentMail struct {
+	barcodeID    string
+	serviceType  string
+	mailerID     string
+	serialNumber string
+	routingCode  string
+}
+
+// IntelligentMailFromFields returns the barcode of the fields: a 2 digit barcode
+// identifier whose second digit is 0 to 4, a 3 digit service type, a 6 digit
+// mailer identifier and 9 digit serial number, or a 9 digit mailer identifier
+// starting with 9 and 6 digit serial number, and a routing ZIP code of 0, 5, 9
+// or 11 digits.
+func IntelligentMailFromFields(barcodeID, serviceType, mailerID, serialNumber, routingCode string) (IntelligentMail, error) {
+	for _, field := range []string{barcodeID, serviceType, mailerID, serialNumber, routingCode} {
+		if !allDigits(field) {
+			return IntelligentMail{}, errInvalidIntelligentMail
+		}
+	}
+	if len(barcodeID) != 2 || barcodeID[1] > '4' || len(serviceType) != 3 {
+		return IntelligentMail{}, errInvalidIntelligentMail
+	}
+	if len(mailerID) != 6 && len(mailerID) != 9 || (len(mailerID) == 9) != (mailerID[0] == '9') ||
+		len(mailerID)+len(serialNumber) != 15 {
+		return IntelligentMail{}, errInvalidIntelligentMail
+	}
+	switch len(routingCode) {
+	case 0, 5, 9, 11:
+	default:
+		return IntelligentMail{}, errInvalidIntelligentMail
+	}
+	return IntelligentMail{
+		barcodeID:    barcodeID,
+		serviceType:  serviceType,
+		mailerID:     mailerID,
+		serialNumber: serialNumber,
+		routingCode:  routingCode,
+	}, nil
+}
+
+// String returns the tracking code followed by the routing code.
+func (imb IntelligentMail) String() string {
+	return imb.barcodeID + imb.serviceType + imb.mailerID + imb.serialNumber + imb.routingCode
+}
+
+// value returns the binary value of the fields, the routing code being offset
+// by the number of routing codes of fewer digits.
+func (imb IntelligentMail) value() *big.Int {
+	v := new(big.Int)
+	if imb.routingCode != "" {
+		v.SetString(imb.routingCode, 10)
+		offsets := map[int]int64{5: 1, 9: 100001, 11: 1000100001}
+		v.Add(v, big.NewInt(offsets[len(imb.routingCode)]))
+	}
+	v.Mul(v, big.NewInt(10))
+	v.Add(v, big.NewInt(int64(imb.barcodeID[0]-'0')))
+	v.Mul(v, big.NewInt(5))
+	v.Add(v, big.NewInt(int64(imb.barcodeID[1]-'0')))
+	for _, c := range imb.serviceType + imb.mailerID + imb.serialNumber {
+		v.Mul(v, big.NewInt(10))
+		v.Add(v, big.NewInt(int64(c-'0')))
+	}
+	return v
+}
+
+// imbCRC returns the 11 bit frame check sequence of the 102 bits of the 13 bytes.
+func imbCRC(data []byte) int {
+	const polynomial = 0xf35
+	fcs := 0x7ff
+	for i, b := range data {
+		bits := uint(8)
+		if i == 0 {
+			// The 2 most significant bits are not part of the value
+			bits = 6
+		}
+		d := int(b) << 3
+		for j := uint(8) - bits; j < 8; j++ {
+			if (fcs^d<<j)&0x400 != 0 {
+				fcs = fcs<<1 ^ polynomial
+			} else {
+				fcs <<= 1
+			}
+			fcs &= 0x7ff
+		}
+	}
+	return fcs
+}
+
+// characters returns the 10 characters of 13 bits of the symbol.
+func (imb IntelligentMail) characters() [10]int {
+	v := imb.value()
+	data := make([]byte, 13)
+	b := v.Bytes()
+	copy(data[len(data)-len(b):], b)
+	fcs := imbCRC(data)
+
+	var codewords [10]int
+	m := new(big.Int)
+	v.DivMod(v, big.NewInt(636), m)
+	codewords[9] = int(m.Int64())
+	for i := 8; i > 0; i-- {
+		v.DivMod(v, big.NewInt(1365), m)
+		codewords[i] = int(m.Int64())
+	}
+	codewords[0] = int(v.Int64())
+	// The orientation is encoded in the last codeword, and the most significant
+	// bit of the frame check sequence in the first one
+	codewords[9] *= 2
+	if fcs&0x400 != 0 {
+		codewords[0] += 659
+	}
+
+	var chars [10]int
+	for i, cw := range codewords {
+		if cw < len(imb5of13) {
+			chars[i] = imb5of13[cw]
+		} else {
+			chars[i] = imb2of13[cw-len(imb5of13)]
+		}
+		// The other bits of the frame check sequence complement the characters
+		if fcs>>uint(i)&1 != 0 {
+			chars[i] ^= 0x1fff
+		}
+	}
+	return chars
+}
+
+func (imb IntelligentMail) bars() []fourStateBar {
+	chars := imb.characters()
+	bars := make([]fourStateBar, len(imbBars))
+	for i, m := range imbBars {
+		if chars[m[0]]>>m[1]&1 != 0 {
+			bars[i] |= barDescender
+		}
+		if chars[m[2]]>>m[3]&1 != 0 {
+			bars[i] |= barAscender
+		}
+	}
+	return bars
+}
+
+func (imb IntelligentMail) symbol() fourStateSymbol {
+	return fourStateSymbol{bars: imb.bars(), quietZone: imbQuietZone}
+}
+
+func (imb IntelligentMail) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := imb.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderFourState(s, r)
+	return nil
+}
+
+func (imb IntelligentMail) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := imb.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderFourState(s, r)
+	return nil
+}
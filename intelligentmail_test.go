@@ -0,0 +1,66 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func fourStateString(bars []fourStateBar) string {
+	s := make([]byte, len(bars))
+	for i, bar := range bars {
+		s[i] = "TADF"[bar]
+	}
+	return string(s)
+}
+
+func TestIntelligentMailTables(t *testing.T) {
+	if imb5of13[0] != 0x1f || imb5of13[1] != 0x1f00 || imb2of13[77] != 0xa0 {
+		t.Errorf("Unexpected characters %x %x %x", imb5of13[0], imb5of13[1], imb2of13[77])
+	}
+	// Each bit of each character is used once
+	used := make(map[[2]uint]bool)
+	for _, m := range imbBars {
+		used[[2]uint{m[0], m[1]}] = true
+		used[[2]uint{m[2], m[3]}] = true
+	}
+	if len(used) != 130 {
+		t.Errorf("Unexpected number of bits %d", len(used))
+	}
+}
+
+func TestIntelligentMailBars(t *testing.T) {
+	for _, test := range []struct {
+		routing  string
+		expected string
+	}{
+		{"", "ATTFATTDTTADTAATTDTDTATTDAFDDFADFDFTFFFFFTATFAAAATDFFTDAADFTFDTDT"},
+		{"01234", "DTTAFADDTTFTDTFTFDTDDADADAFADFATDDFTAAAFDTTADFAAATDFDTDFADDDTDFFT"},
+		{"012345678", "ADFTTAFDTTTTFATTADTAAATFTFTATDAAAFDDADATATDTDTTDFDTDATADADTDFFTFA"},
+		{"01234567891", "AADTFFDFTDADTAADAATFDTDDAAADDTDTTDAFADADDDTFFFDDTTTADFAAADFTDAADA"},
+	} {
+		imb, err := IntelligentMailFromFields("01", "234", "567094", "987654321", test.routing)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := fourStateString(imb.bars()); s != test.expected {
+			t.Errorf("Unexpected bars %s for %q", s, test.routing)
+		}
+	}
+}
+
+func TestIntelligentMailInvalid(t *testing.T) {
+	for _, fields := range [][5]string{
+		{"05", "234", "567094", "987654321", ""},
+		{"0", "234", "567094", "987654321", ""},
+		{"01", "234", "567094", "98765432", ""},
+		{"01", "234", "567094123", "987654", ""},
+		{"01", "234", "967094123", "987654", "1234"},
+		{"01", "2x4", "567094", "987654321", ""},
+	} {
+		if _, err := IntelligentMailFromFields(fields[0], fields[1], fields[2], fields[3], fields[4]); err == nil {
+			t.Errorf("Unexpected valid fields %v", fields)
+		}
+	}
+	if _, err := IntelligentMailFromFields("01", "234", "967094123", "987654", "12345"); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
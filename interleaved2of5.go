@@ -0,0 +1,252 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+)
+
+// interleaved2of5Table gives the wide (1) and narrow (0) elements of each digit.
+var interleaved2of5Table = [10][5]int{
+	{0, 0, 1, 1, 0},
+	{1, 0, 0, 0, 1},
+	{0, 1, 0, 0, 1},
+	{1, 1, 0, 0, 0},
+	{0, 0, 1, 0, 1},
+	{1, 0, 1, 0, 0},
+	{0, 1, 1, 0, 0},
+	{0, 0, 0, 1, 1},
+	{1, 0, 0, 1, 0},
+	{0, 1, 0, 1, 0},
+}
+
+// encodeInterleaved2of5 returns the widths of the bars and spaces encoding digits,
+// whose length must be even. The digits of each pair are encoded in the bars and the
+// spaces respectively.
+func encodeInterleaved2of5(digits []int, narrow, wide int) []int {
+	n, w := narrow, wide
+	// Start pattern
+	widths := []int{n, n, n, n}
+	for i := 0; i+1 < len(digits); i += 2 {
+		bars := interleaved2of5Table[digits[i]]
+		spaces := interleaved2of5Table[digits[i+1]]
+		for j := 0; j < 5; j++ {
+			widths = append(widths, n+bars[j]*(w-n), n+spaces[j]*(w-n))
+		}
+	}
+	// Stop pattern
+	return append(widths, w, n, n)
+}
+
+const (
+	// Quiet zone of the 2 of 5 symbols, 10 times the narrow bar
+	twoOf5QuietZone = 10 * narrowBarSize
+	// The bearer bars are as thick as the ones of ITF-14
+	twoOf5BearerSize = itf14BearerSize
+)
+
+var errInvalid2of5 = errors.New("Invalid 2 of 5 digits")
+
+// mod10CheckDigit returns the check digit of the digits, weighted 3 and 1 from
+// the right like the GTIN check digits.
+func mod10CheckDigit(digits string) byte {
+	sum := 0
+	for i := range digits {
+		sum += int(digits[len(digits)-1-i]-'0') * int(checksumWeights[i%2])
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// Interleaved2of5 is an Interleaved 2 of 5 symbol of any even number of digits.
+type Interleaved2of5 struct {
+	digits string
+	check  bool
+	ratio  float64
+	bearer BearerStyle
+}
+
+// Interleaved2of5FromString encodes the digits, with a wide to narrow ratio of 2.5
+// and no bearer bars. A leading zero is added when the number of digits is odd.
+func Interleaved2of5FromString(digits string) (Interleaved2of5, error) {
+	if len(digits) == 0 || !allDigits(digits) {
+		return Interleaved2of5{}, errInvalid2of5
+	}
+	return Interleaved2of5{
+		digits: digits,
+		ratio:  2.5,
+		bearer: BearerNone,
+	}, nil
+}
+
+// String returns the encoded digits, with the leading zero and check digit.
+func (itf Interleaved2of5) String() string {
+	digits := itf.digits
+	if itf.check {
+		digits += string(mod10CheckDigit(digits))
+	}
+	if len(digits)%2 != 0 {
+		digits = "0" + digits
+	}
+	return digits
+}
+
+// WithChecksum returns a copy with a modulo 10 check digit after the digits.
+func (itf Interleaved2of5) WithChecksum() Interleaved2of5 {
+	itf.check = true
+	return itf
+}
+
+// WithRatio returns a copy of the symbol with the wide to narrow ratio, between 2 and 3.
+// The ratio is rounded up to the next quarter when rendering.
+func (itf Interleaved2of5) WithRatio(ratio float64) (Interleaved2of5, error) {
+	if ratio < 2 || ratio > 3 {
+		return Interleaved2of5{}, errInvalidRatio
+	}
+	itf.ratio = ratio
+	return itf, nil
+}
+
+// WithBearer returns a copy of the symbol with the bearer bars style.
+func (itf Interleaved2of5) WithBearer(style BearerStyle) Interleaved2of5 {
+	itf.bearer = style
+	return itf
+}
+
+func (itf Interleaved2of5) symbol() linearSymbol {
+	text := itf.String()
+	digits := make([]int, len(text))
+	for i := range text {
+		digits[i] = int(text[i] - '0')
+	}
+	n := ratioUnit(itf.ratio)
+	return linearSymbol{
+		widths:      encodeInterleaved2of5(digits, n, wideBarSize(itf.ratio, n)),
+		quietZone:   twoOf5QuietZone * n / narrowBarSize,
+		text:        text,
+		bearerSize:  twoOf5BearerSize * n / narrowBarSize,
+		bearerStyle: itf.bearer,
+	}
+}
+
+func (itf Interleaved2of5) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := itf.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+func (itf Interleaved2of5) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := itf.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+// Standard2of5 is a Standard 2 of 5 symbol, also known as Industrial 2 of 5, which
+// encodes the digits in the bars only.
+type Standard2of5 struct {
+	digits string
+	check  bool
+	ratio  float64
+	bearer BearerStyle
+}
+
+// Industrial2of5 is the other name of Standard 2 of 5.
+type Industrial2of5 = Standard2of5
+
+// Standard2of5FromString encodes the digits, with a wide to narrow ratio of 3 and
+// no bearer bars.
+func Standard2of5FromString(digits string) (Standard2of5, error) {
+	if len(digits) == 0 || !allDigits(digits) {
+		return Standard2of5{}, errInvalid2of5
+	}
+	return Standard2of5{
+		digits: digits,
+		ratio:  3,
+		bearer: BearerNone,
+	}, nil
+}
+
+// String returns the encoded digits, with the check digit.
+func (code Standard2of5) String() string {
+	if code.check {
+		return code.digits + string(mod10CheckDigit(code.digits))
+	}
+	return code.digits
+}
+
+// WithChecksum returns a copy with a modulo 10 check digit after the digits.
+func (code Standard2of5) WithChecksum() Standard2of5 {
+	code.check = true
+	return code
+}
+
+// WithRatio returns a copy of the symbol with the wide to narrow ratio, between 2 and 3.
+// The ratio is rounded up to the next quarter when rendering.
+func (code Standard2of5) WithRatio(ratio float64) (Standard2of5, error) {
+	if ratio < 2 || ratio > 3 {
+		return Standard2of5{}, errInvalidRatio
+	}
+	code.ratio = ratio
+	return code, nil
+}
+
+// WithBearer returns a copy of the symbol with the bearer bars style.
+func (code Standard2of5) WithBearer(style BearerStyle) Standard2of5 {
+	code.bearer = style
+	return code
+}
+
+// widths returns the widths of the bars and spaces, each bar being followed by a
+// narrow space.
+func (code Standard2of5) widths() []int {
+	n := ratioUnit(code.ratio)
+	w := wideBarSize(code.ratio, n)
+	// Start pattern
+	widths := []int{w, n, w, n, n, n}
+	for _, c := range code.String() {
+		for _, wide := range interleaved2of5Table[c-'0'] {
+			widths = append(widths, n+wide*(w-n), n)
+		}
+	}
+	// Stop pattern
+	return append(widths, w, n, n, n, w)
+}
+
+func (code Standard2of5) symbol() linearSymbol {
+	n := ratioUnit(code.ratio)
+	return linearSymbol{
+		widths:      code.widths(),
+		quietZone:   twoOf5QuietZone * n / narrowBarSize,
+		text:        code.String(),
+		bearerSize:  twoOf5BearerSize * n / narrowBarSize,
+		bearerStyle: code.bearer,
+	}
+}
+
+func (code Standard2of5) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := code.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+func (code Standard2of5) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := code.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
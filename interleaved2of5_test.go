@@ -0,0 +1,94 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestMod10CheckDigit(t *testing.T) {
+	data := []struct {
+		digits string
+		check  byte
+	}{
+		{"1540014128876", '3'},
+		{"1234567", '0'},
+		{"0", '0'},
+		{"12345678901234567890", '0'},
+	}
+	for _, d := range data {
+		if c := mod10CheckDigit(d.digits); c != d.check {
+			t.Errorf("Unexpected check digit of %s: %c", d.digits, c)
+		}
+	}
+}
+
+func TestInterleaved2of5(t *testing.T) {
+	data := []struct {
+		digits string
+		check  bool
+		text   string
+	}{
+		{"1234", false, "1234"},
+		{"123", false, "0123"},
+		{"123", true, "1236"},
+		{"1234567", true, "12345670"},
+		{"12", true, "0123"},
+	}
+	for _, d := range data {
+		itf, err := Interleaved2of5FromString(d.digits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.check {
+			itf = itf.WithChecksum()
+		}
+		if itf.String() != d.text {
+			t.Errorf("Unexpected text of %s: %s", d.digits, itf.String())
+		}
+		n, w := narrowBarSize, wideBarSize(2.5, narrowBarSize)
+		if s := itf.symbol(); s.barsWidth() != 4*n+len(d.text)*(2*w+3*n)+w+2*n {
+			t.Errorf("Unexpected width %d", s.barsWidth())
+		}
+	}
+}
+
+func TestInterleaved2of5Invalid(t *testing.T) {
+	for _, digits := range []string{"", "12a4", "-1"} {
+		if _, err := Interleaved2of5FromString(digits); err == nil {
+			t.Errorf("Unexpected valid digits %q", digits)
+		}
+	}
+	itf, _ := Interleaved2of5FromString("12")
+	if _, err := itf.WithRatio(3.5); err == nil {
+		t.Errorf("Unexpected valid ratio")
+	}
+}
+
+func TestStandard2of5Widths(t *testing.T) {
+	var code Industrial2of5
+	code, _ = Standard2of5FromString("1")
+	code, _ = code.WithRatio(2)
+	n, w := narrowBarSize, wideBarSize(2, narrowBarSize)
+	expected := []int{
+		w, n, w, n, n, n, // start
+		w, n, n, n, n, n, n, n, w, n, // 1
+		w, n, n, n, w, // stop
+	}
+	widths := code.widths()
+	if !equalCodewords(widths, expected) {
+		t.Errorf("Unexpected widths %v", widths)
+	}
+	code = code.WithChecksum()
+	if code.String() != "17" || len(code.widths()) != len(widths)+10 {
+		t.Errorf("Missing check digit")
+	}
+}
+
+func TestStandard2of5Invalid(t *testing.T) {
+	if _, err := Standard2of5FromString("1 2"); err == nil {
+		t.Errorf("Unexpected valid digits")
+	}
+	code, _ := Standard2of5FromString("12")
+	if _, err := code.WithRatio(1); err == nil {
+		t.Errorf("Unexpected valid ratio")
+	}
+}
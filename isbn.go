@@ -0,0 +1,261 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"strconv"
+	"strings"
+)
+
+// isbnRule gives the length of the next element of a number when the 7 digits
+// following the prefix fall within [min, max]. A length of 0 means the range is
+// not in use.
+type isbnRule struct {
+	min, max uint64
+	length   int
+}
+
+type isbnRangeMessage struct {
+	Prefixes []isbnRangeGroup `xml:"EAN.UCCPrefixes>EAN.UCC"`
+	Groups   []isbnRangeGroup `xml:"RegistrationGroups>Group"`
+}
+
+type isbnRangeGroup struct {
+	Prefix string `xml:"Prefix"`
+	Rules  []struct {
+		Range  string `xml:"Range"`
+		Length int    `xml:"Length"`
+	} `xml:"Rules>Rule"`
+}
+
+func rangesFromFileOrDie(filename string) map[string][]isbnRule {
+	data, err := _asset(filename)
+	if err != nil {
+		panic(err)
+	}
+	var msg isbnRangeMessage
+	if err := xml.Unmarshal(data, &msg); err != nil {
+		panic(err)
+	}
+	ranges := make(map[string][]isbnRule)
+	for _, g := range append(msg.Prefixes, msg.Groups...) {
+		prefix := strings.Replace(g.Prefix, "-", "", -1)
+		for _, r := range g.Rules {
+			var rule isbnRule
+			if _, err := fmt.Sscanf(r.Range, "%d-%d", &rule.min, &rule.max); err != nil {
+				panic(fmt.Errorf("Invalid range %q of %s: %v", r.Range, g.Prefix, err))
+			}
+			rule.length = r.Length
+			ranges[prefix] = append(ranges[prefix], rule)
+		}
+	}
+	return ranges
+}
+
+// isbnRanges maps a prefix, e.g. "978" or "9783", to the rules splitting the digits after it.
+var isbnRanges map[string][]isbnRule
+
+func init() {
+	isbnRanges = rangesFromFileOrDie("RangeMessage.xml")
+}
+
+// elementLength returns the length of the element at the start of digits according to rules.
+// It returns 0 if no rule applies.
+func elementLength(rules []isbnRule, digits string) int {
+	if len(digits) < 7 {
+		digits += strings.Repeat("0", 7-len(digits))
+	}
+	v, err := strconv.ParseUint(digits[:7], 10, 64)
+	if err != nil {
+		return 0
+	}
+	for _, r := range rules {
+		if v >= r.min && v <= r.max {
+			return r.length
+		}
+	}
+	return 0
+}
+
+// hyphenate splits the body of a 13 digit code after prefix into a group and a registrant
+// element using groupRules, and joins the elements with hyphens. groupRules may be nil if
+// the prefix already includes the group. The last element is the check digit.
+func hyphenate(code13, prefix string, groupRules []isbnRule, registrantRules func(group string) []isbnRule) (string, bool) {
+	body := code13[len(prefix):12]
+	parts := []string{prefix}
+	group := ""
+	if groupRules != nil {
+		n := elementLength(groupRules, body)
+		if n <= 0 || n >= len(body) {
+			return "", false
+		}
+		group, body = body[:n], body[n:]
+		parts = append(parts, group)
+	}
+	n := elementLength(registrantRules(group), body)
+	if n <= 0 || n >= len(body) {
+		return "", false
+	}
+	parts = append(parts, body[:n], body[n:], code13[12:])
+	return strings.Join(parts, "-"), true
+}
+
+// normalizeStandardNumber strips the optional label of the standard, e.g. "ISBN",
+// "ISBN:" or "ISBN-13:", and the hyphens and spaces separating the elements of a
+// number.
+func normalizeStandardNumber(code, name string) string {
+	code = strings.TrimSpace(code)
+	if strings.HasPrefix(strings.ToUpper(code), name) {
+		code = strings.TrimLeft(code[len(name):], " ")
+		// The length of the form, e.g. "-13" or "13:"
+		for _, form := range []string{"-13", "-10", "13:", "10:"} {
+			if strings.HasPrefix(code, form) && (len(code) == len(form) || strings.ContainsRune(": ", rune(code[len(form)]))) {
+				code = code[len(form):]
+				break
+			}
+		}
+		code = strings.TrimLeft(code, ": ")
+	}
+	code = strings.Replace(code, "-", "", -1)
+	return strings.Replace(code, " ", "", -1)
+}
+
+// computeMod11Checksum computes the check character of ISBN-10 and ISSN, with weights
+// decreasing from len(code)+1 down to 2.
+func computeMod11Checksum(code string) byte {
+	var sum int
+	weight := len(code) + 1
+	for _, c := range code {
+		sum += int(c-'0') * weight
+		weight--
+	}
+	checksum := (11 - sum%11) % 11
+	if checksum == 10 {
+		return 'X'
+	}
+	return byte('0' + checksum)
+}
+
+// ISBN is an International Standard Book Number. It is stored as its 978 or 979
+// prefixed EAN-13 form.
+type ISBN struct {
+	ean EAN13
+}
+
+var (
+	errInvalidISBN      = errors.New("Invalid ISBN")
+	errNotISBN          = errors.New("EAN code is not an ISBN")
+	errUnknownISBNGroup = errors.New("ISBN registration group or range not in the range message")
+)
+
+func isISBN(code13 uint64) bool {
+	prefix := code13 / 10000000000
+	// 979-0 is used by ISMN
+	return (prefix == 978 || prefix == 979) && code13/1000000000 != 9790
+}
+
+// ISBNFromEAN13 converts an EAN-13 code with the 978 or 979 prefix to ISBN. The
+// registration group and registrant must be in a range of the range message.
+func ISBNFromEAN13(ean EAN13) (ISBN, error) {
+	if !isISBN(ean.code13) {
+		return ISBN{}, errNotISBN
+	}
+	if _, ok := hyphenateISBN(ean.String()); !ok {
+		return ISBN{}, errUnknownISBNGroup
+	}
+	return ISBN{
+		ean: ean,
+	}, nil
+}
+
+// hyphenateISBN splits a 13 digit ISBN according to the ISBN ranges.
+func hyphenateISBN(code13 string) (string, bool) {
+	prefix := code13[:3]
+	return hyphenate(code13, prefix, isbnRanges[prefix], func(group string) []isbnRule {
+		return isbnRanges[prefix+group]
+	})
+}
+
+// ISBNFromString parses an ISBN-13 or legacy ISBN-10, optionally hyphenated and
+// preceded by "ISBN".
+func ISBNFromString(code string) (ISBN, error) {
+	code = normalizeStandardNumber(code, "ISBN")
+	switch len(code) {
+	case 10:
+		body, check := code[:9], code[9]
+		if check == 'x' {
+			check = 'X'
+		}
+		if !allDigits(body) {
+			return ISBN{}, errInvalidISBN
+		}
+		if computeMod11Checksum(body) != check {
+			return ISBN{}, errInvalidEANChecksum
+		}
+		ean, err := EAN13FromString12("978" + body)
+		if err != nil {
+			return ISBN{}, err
+		}
+		return ISBNFromEAN13(ean)
+	case 13:
+		ean, err := EAN13FromString13(code)
+		if err != nil {
+			return ISBN{}, err
+		}
+		return ISBNFromEAN13(ean)
+	default:
+		return ISBN{}, errInvalidISBN
+	}
+}
+
+func (isbn ISBN) EAN13() EAN13 {
+	return isbn.ean
+}
+
+// ISBN10 returns the legacy 10 character form. Only ISBNs with the 978 prefix have one.
+func (isbn ISBN) ISBN10() (string, error) {
+	code13 := isbn.ean.String()
+	if !strings.HasPrefix(code13, "978") {
+		return "", errInvalidISBN
+	}
+	body := code13[3:12]
+	return body + string(computeMod11Checksum(body)), nil
+}
+
+// String returns the ISBN-13 hyphenated according to the ISBN ranges.
+func (isbn ISBN) String() string {
+	s, _ := hyphenateISBN(isbn.ean.String())
+	return s
+}
+
+// WithAddon returns a copy of the ISBN carrying the supplemental code addon, e.g. the price.
+func (isbn ISBN) WithAddon(addon Addon) ISBN {
+	isbn.ean = isbn.ean.WithAddon(addon)
+	return isbn
+}
+
+func (isbn ISBN) style() code13Style {
+	return code13Style{addon: isbn.ean.addon, caption: "ISBN " + isbn.String()}
+}
+
+func (isbn ISBN) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	r, err := newBitmapRenderer(img, bound, padding, isbn.style().layout())
+	if err != nil {
+		return err
+	}
+	renderCode13(isbn.ean.code13, isbn.style(), r)
+	return nil
+}
+
+func (isbn ISBN) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	r, err := newPdfRenderer(canvas, bound, padding, isbn.style().layout())
+	if err != nil {
+		return err
+	}
+	renderCode13(isbn.ean.code13, isbn.style(), r)
+	return nil
+}
@@ -0,0 +1,64 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestISBN(t *testing.T) {
+	type data struct {
+		code   string
+		isbn13 string
+		isbn10 string
+	}
+	var testdata = []data{
+		{"978-3-16-148410-0", "978-3-16-148410-0", "316148410X"},
+		{"3-16-148410-X", "978-3-16-148410-0", "316148410X"},
+		{"ISBN 0-306-40615-2", "978-0-306-40615-7", "0306406152"},
+		{"9781402894626", "978-1-4028-9462-6", "1402894627"},
+		{"ISBN: 979-10-90636-07-1", "979-10-90636-07-1", ""},
+		{"9791123456782", "979-11-23-45678-2", ""},
+		{"ISBN-13: 978-0-306-40615-7", "978-0-306-40615-7", "0306406152"},
+		{"ISBN-10 0-306-40615-2", "978-0-306-40615-7", "0306406152"},
+		{"isbn 10: 0306406152", "978-0-306-40615-7", "0306406152"},
+	}
+	for _, d := range testdata {
+		isbn, err := ISBNFromString(d.code)
+		if err != nil {
+			t.Errorf("Invalid isbn %s: %v", d.code, err)
+			continue
+		}
+		if isbn.String() != d.isbn13 {
+			t.Errorf("Unexpected isbn %s v.s. %s", d.isbn13, isbn)
+		}
+		isbn10, err := isbn.ISBN10()
+		if isbn10 != d.isbn10 || (err == nil) != (d.isbn10 != "") {
+			t.Errorf("Unexpected isbn-10 %s v.s. %s", d.isbn10, isbn10)
+		}
+		if _, err := ISBNFromEAN13(isbn.EAN13()); err != nil {
+			t.Errorf("Invalid ean %s for isbn %s", isbn.EAN13(), isbn)
+		}
+	}
+}
+
+func TestInvalidISBN(t *testing.T) {
+	var invalidString = []string{
+		"3-16-148410-0",     // invalid checksum
+		"978-3-16-148410-1", // invalid checksum
+		"590-1-23-412345-7", // not a book
+		"979-0-2600-0043-8", // ISMN
+		"3-16-14841",        // too short
+	}
+	for _, c := range invalidString {
+		if _, err := ISBNFromString(c); err == nil {
+			t.Errorf("Unexpected valid isbn %s", c)
+		}
+	}
+}
+
+func TestUnknownISBNGroup(t *testing.T) {
+	// 978-66 is not a registration group
+	ean, _ := EAN13FromString12("978660000000")
+	if _, err := ISBNFromEAN13(ean); err != errUnknownISBNGroup {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+	"strings"
+)
+
+// ISMN is an International Standard Music Number. It is stored as its EAN-13 form
+// with the 979-0 prefix.
+type ISMN struct {
+	ean EAN13
+}
+
+// ismnRanges gives the length of the publisher element, which is fixed by the standard.
+var ismnRanges = []isbnRule{
+	{0, 999999, 3},
+	{1000000, 3999999, 4},
+	{4000000, 6999999, 5},
+	{7000000, 8999999, 6},
+	{9000000, 9999999, 7},
+}
+
+var (
+	errInvalidISMN = errors.New("Invalid ISMN")
+	errNotISMN     = errors.New("EAN code is not an ISMN")
+)
+
+// ISMNFromEAN13 converts an EAN-13 code with the 979-0 prefix to ISMN.
+func ISMNFromEAN13(ean EAN13) (ISMN, error) {
+	if ean.code13/1000000000 != 9790 {
+		return ISMN{}, errNotISMN
+	}
+	return ISMN{
+		ean: ean,
+	}, nil
+}
+
+// ISMNFromString parses an ISMN-13 or a legacy ISMN starting with "M", optionally
+// hyphenated and preceded by "ISMN".
+func ISMNFromString(code string) (ISMN, error) {
+	code = normalizeStandardNumber(code, "ISMN")
+	switch len(code) {
+	case 10:
+		// The check digit of the legacy form is the one of the EAN-13 form
+		if code[0] != 'M' && code[0] != 'm' {
+			return ISMN{}, errInvalidISMN
+		}
+		code = "9790" + code[1:]
+	case 13:
+	default:
+		return ISMN{}, errInvalidISMN
+	}
+	ean, err := EAN13FromString13(code)
+	if err != nil {
+		return ISMN{}, err
+	}
+	return ISMNFromEAN13(ean)
+}
+
+func (ismn ISMN) EAN13() EAN13 {
+	return ismn.ean
+}
+
+// Legacy returns the legacy 10 character form, e.g. "M-2306-7118-7".
+func (ismn ISMN) Legacy() string {
+	return "M" + strings.TrimPrefix(ismn.String(), "979-0")
+}
+
+// String returns the hyphenated ISMN-13, e.g. "979-0-2306-7118-7".
+func (ismn ISMN) String() string {
+	code13 := ismn.ean.String()
+	s, _ := hyphenate(code13, "9790", nil, func(string) []isbnRule {
+		return ismnRanges
+	})
+	return "979-0" + strings.TrimPrefix(s, "9790")
+}
+
+// WithAddon returns a copy of the ISMN carrying the supplemental code addon.
+func (ismn ISMN) WithAddon(addon Addon) ISMN {
+	ismn.ean = ismn.ean.WithAddon(addon)
+	return ismn
+}
+
+func (ismn ISMN) style() code13Style {
+	return code13Style{addon: ismn.ean.addon, caption: "ISMN " + ismn.String()}
+}
+
+func (ismn ISMN) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	r, err := newBitmapRenderer(img, bound, padding, ismn.style().layout())
+	if err != nil {
+		return err
+	}
+	renderCode13(ismn.ean.code13, ismn.style(), r)
+	return nil
+}
+
+func (ismn ISMN) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	r, err := newPdfRenderer(canvas, bound, padding, ismn.style().layout())
+	if err != nil {
+		return err
+	}
+	renderCode13(ismn.ean.code13, ismn.style(), r)
+	return nil
+}
@@ -0,0 +1,29 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestISMN(t *testing.T) {
+	var codes = []string{
+		"979-0-2600-0043-8",
+		"M-2600-0043-8",
+		"ISMN 9790260000438",
+	}
+	for _, c := range codes {
+		ismn, err := ISMNFromString(c)
+		if err != nil {
+			t.Errorf("Invalid ismn %s: %v", c, err)
+			continue
+		}
+		if ismn.String() != "979-0-2600-0043-8" {
+			t.Errorf("Unexpected ismn %s", ismn)
+		}
+		if ismn.Legacy() != "M-2600-0043-8" {
+			t.Errorf("Unexpected legacy ismn %s", ismn.Legacy())
+		}
+	}
+	if _, err := ISMNFromString("M-2600-0043-9"); err == nil {
+		t.Errorf("Unexpected valid ismn %s", "M-2600-0043-9")
+	}
+}
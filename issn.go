@@ -0,0 +1,130 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"strconv"
+)
+
+// ISSN is an International Standard Serial Number. Its EAN-13 form has the 977 prefix,
+// followed by the first 7 digits of the ISSN and a 2 digit variant, e.g. for different
+// prices or editions.
+type ISSN struct {
+	code7   uint64
+	variant uint8
+	addon   Addon
+}
+
+var (
+	errInvalidISSN = errors.New("Invalid ISSN")
+	errNotISSN     = errors.New("EAN code is not an ISSN")
+)
+
+// ISSNFromEAN13 converts an EAN-13 code with the 977 prefix to ISSN.
+func ISSNFromEAN13(ean EAN13) (ISSN, error) {
+	if ean.code13/10000000000 != 977 {
+		return ISSN{}, errNotISSN
+	}
+	return ISSN{
+		code7:   ean.code13 / 1000 % 10000000,
+		variant: uint8(ean.code13 / 10 % 100),
+		addon:   ean.addon,
+	}, nil
+}
+
+// ISSNFromString parses an 8 character ISSN, optionally hyphenated and preceded by
+// "ISSN", or its 13 digit EAN form.
+func ISSNFromString(code string) (ISSN, error) {
+	code = normalizeStandardNumber(code, "ISSN")
+	switch len(code) {
+	case 8:
+		body, check := code[:7], code[7]
+		if check == 'x' {
+			check = 'X'
+		}
+		if !allDigits(body) {
+			return ISSN{}, errInvalidISSN
+		}
+		if computeMod11Checksum(body) != check {
+			return ISSN{}, errInvalidEANChecksum
+		}
+		code7, err := strconv.ParseUint(body, 10, 64)
+		if err != nil {
+			return ISSN{}, err
+		}
+		return ISSN{
+			code7: code7,
+		}, nil
+	case 13:
+		ean, err := EAN13FromString13(code)
+		if err != nil {
+			return ISSN{}, err
+		}
+		return ISSNFromEAN13(ean)
+	default:
+		return ISSN{}, errInvalidISSN
+	}
+}
+
+// Checksum returns the check character, '0' to '9' or 'X'.
+func (issn ISSN) Checksum() byte {
+	return computeMod11Checksum(fmt.Sprintf("%07d", issn.code7))
+}
+
+// Variant returns the 2 digit variant used in the EAN-13 form.
+func (issn ISSN) Variant() uint8 {
+	return issn.variant
+}
+
+// WithVariant returns a copy of the ISSN with the variant used in the EAN-13 form.
+func (issn ISSN) WithVariant(variant uint8) (ISSN, error) {
+	if variant > 99 {
+		return ISSN{}, errInvalidISSN
+	}
+	issn.variant = variant
+	return issn, nil
+}
+
+// WithAddon returns a copy of the ISSN carrying the supplemental code addon, e.g. the issue number.
+func (issn ISSN) WithAddon(addon Addon) ISSN {
+	issn.addon = addon
+	return issn
+}
+
+func (issn ISSN) EAN13() EAN13 {
+	code12 := 977000000000 + issn.code7*100 + uint64(issn.variant)
+	return EAN13{
+		code13: code12*10 + computeEANChecksum(code12),
+		addon:  issn.addon,
+	}
+}
+
+// String returns the hyphenated ISSN, e.g. "0317-8471".
+func (issn ISSN) String() string {
+	return fmt.Sprintf("%04d-%03d%c", issn.code7/1000, issn.code7%1000, issn.Checksum())
+}
+
+func (issn ISSN) style() code13Style {
+	return code13Style{addon: issn.addon, caption: "ISSN " + issn.String()}
+}
+
+func (issn ISSN) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	r, err := newBitmapRenderer(img, bound, padding, issn.style().layout())
+	if err != nil {
+		return err
+	}
+	renderCode13(issn.EAN13().code13, issn.style(), r)
+	return nil
+}
+
+func (issn ISSN) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	r, err := newPdfRenderer(canvas, bound, padding, issn.style().layout())
+	if err != nil {
+		return err
+	}
+	renderCode13(issn.EAN13().code13, issn.style(), r)
+	return nil
+}
@@ -0,0 +1,41 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestISSN(t *testing.T) {
+	type data struct {
+		code  string
+		issn  string
+		ean13 string
+	}
+	var testdata = []data{
+		{"0317-8471", "0317-8471", "9770317847001"},
+		{"ISSN 2049-3630", "2049-3630", "9772049363002"},
+		{"1050-124x", "1050-124X", "9771050124008"},
+		{"9770317847001", "0317-8471", "9770317847001"},
+	}
+	for _, d := range testdata {
+		issn, err := ISSNFromString(d.code)
+		if err != nil {
+			t.Errorf("Invalid issn %s: %v", d.code, err)
+			continue
+		}
+		if issn.String() != d.issn {
+			t.Errorf("Unexpected issn %s v.s. %s", d.issn, issn)
+		}
+		if issn.EAN13().String() != d.ean13 {
+			t.Errorf("Unexpected ean %s v.s. %s", d.ean13, issn.EAN13())
+		}
+	}
+	issn, _ := ISSNFromString("0317-8471")
+	issn, _ = issn.WithVariant(12)
+	back, err := ISSNFromEAN13(issn.EAN13())
+	if err != nil || back != issn {
+		t.Errorf("Unexpected issn %s with variant %d", back, back.Variant())
+	}
+	if _, err := ISSNFromString("0317-8472"); err == nil {
+		t.Errorf("Unexpected valid issn %s", "0317-8472")
+	}
+}
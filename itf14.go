@@ -0,0 +1,100 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+)
+
+// BearerStyle selects the bearer bars printed around a symbol, which keep the scanner
+// from reading a partial symbol when the printing plate presses unevenly.
+type BearerStyle int
+
+const (
+	// Bearer bars framing the whole symbol
+	BearerFrame BearerStyle = iota
+	// Bearer bars on the top and bottom of the bars
+	BearerTopBottom
+	// No bearer bars
+	BearerNone
+)
+
+const (
+	// Quiet zone of ITF-14, 10 times the narrow bar
+	itf14QuietZone = 10 * narrowBarSize
+	// Thickness of the bearer bars, about 5 times the narrow bar
+	itf14BearerSize = 5 * narrowBarSize
+)
+
+// ITF14 renders a GTIN-14 as an Interleaved 2 of 5 symbol for outer cartons.
+type ITF14 struct {
+	gtin   GTIN14
+	ratio  float64
+	bearer BearerStyle
+}
+
+var errInvalidRatio = errors.New("Invalid wide to narrow ratio")
+
+// ITF14FromGTIN14 returns the ITF-14 symbol of gtin with a wide to narrow ratio of 2.5
+// and a bearer frame.
+func ITF14FromGTIN14(gtin GTIN14) ITF14 {
+	return ITF14{
+		gtin:   gtin,
+		ratio:  2.5,
+		bearer: BearerFrame,
+	}
+}
+
+func (itf ITF14) GTIN14() GTIN14 {
+	return itf.gtin
+}
+
+// WithRatio returns a copy of the symbol with the wide to narrow ratio, between 2.25 and 3.
+// The ratio is rounded up to the next quarter when rendering.
+func (itf ITF14) WithRatio(ratio float64) (ITF14, error) {
+	if ratio < 2.25 || ratio > 3 {
+		return ITF14{}, errInvalidRatio
+	}
+	itf.ratio = ratio
+	return itf, nil
+}
+
+// WithBearer returns a copy of the symbol with the bearer bars style.
+func (itf ITF14) WithBearer(style BearerStyle) ITF14 {
+	itf.bearer = style
+	return itf
+}
+
+func (itf ITF14) symbol() linearSymbol {
+	var digits [14]int
+	splitDigits(itf.gtin.code14, digits[:])
+	n := ratioUnit(itf.ratio)
+	return linearSymbol{
+		widths:      encodeInterleaved2of5(digits[:], n, wideBarSize(itf.ratio, n)),
+		quietZone:   itf14QuietZone * n / narrowBarSize,
+		text:        itf.gtin.String(),
+		bearerSize:  itf14BearerSize * n / narrowBarSize,
+		bearerStyle: itf.bearer,
+	}
+}
+
+func (itf ITF14) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := itf.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+func (itf ITF14) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := itf.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
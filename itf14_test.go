@@ -0,0 +1,93 @@
+package barcode
+
+import (
+	"image"
+	"image/draw"
+	"testing"
+)
+
+func TestEncodeInterleaved2of5(t *testing.T) {
+	n, w := narrowBarSize, wideBarSize(3, narrowBarSize)
+	widths := encodeInterleaved2of5([]int{1, 2}, n, w)
+	expected := []int{
+		n, n, n, n, // start
+		w, n, n, w, n, n, n, n, w, w, // 1 in bars, 2 in spaces
+		w, n, n, // stop
+	}
+	if len(widths) != len(expected) {
+		t.Fatalf("Unexpected widths %v v.s. %v", expected, widths)
+	}
+	for i := range widths {
+		if widths[i] != expected[i] {
+			t.Fatalf("Unexpected widths %v v.s. %v", expected, widths)
+		}
+	}
+}
+
+func TestITF14(t *testing.T) {
+	gtin, _ := GTIN14FromString("15400141288763")
+	itf := ITF14FromGTIN14(gtin)
+	if _, err := itf.WithRatio(1.5); err == nil {
+		t.Errorf("Unexpected valid ratio %v", 1.5)
+	}
+	itf, err := itf.WithRatio(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := itf.WithBearer(BearerTopBottom).symbol()
+	// 4 start, 14 digits of 2 wide and 3 narrow elements, 1 wide and 2 narrow stop
+	n, w := narrowBarSize, wideBarSize(3, narrowBarSize)
+	if s.barsWidth() != 4*n+14*(2*w+3*n)+w+2*n {
+		t.Errorf("Unexpected width %d", s.barsWidth())
+	}
+	if s.layout().width != s.barsWidth()+2*itf14QuietZone {
+		t.Errorf("Unexpected layout width %d", s.layout().width)
+	}
+}
+
+// renderedRatio renders a symbol without bearer bars and returns the ratio of the
+// widest to the narrowest run of dark or light pixels across its bars.
+func renderedRatio(t *testing.T, render func(draw.Image, image.Rectangle, int) error) float64 {
+	r := image.Rect(0, 0, 1200, 200)
+	img := image.NewGray(r)
+	if err := render(img, r, 0); err != nil {
+		t.Fatal(err)
+	}
+	var runs []int
+	var last uint8
+	y := r.Dy() / 2
+	for x := r.Min.X; x < r.Max.X; x++ {
+		c := img.GrayAt(x, y).Y
+		if len(runs) > 0 && c == last {
+			runs[len(runs)-1]++
+		} else if len(runs) > 0 || c == 0 {
+			runs = append(runs, 1)
+		}
+		last = c
+	}
+	// The last run is the quiet zone
+	runs = runs[:len(runs)-1]
+	narrowest, widest := runs[0], runs[0]
+	for _, run := range runs {
+		if run < narrowest {
+			narrowest = run
+		}
+		if run > widest {
+			widest = run
+		}
+	}
+	return float64(widest) / float64(narrowest)
+}
+
+func TestITF14Ratio(t *testing.T) {
+	gtin, _ := GTIN14FromString("15400141288763")
+	for _, ratio := range []float64{2.25, 2.5, 3} {
+		itf, err := ITF14FromGTIN14(gtin).WithBearer(BearerNone).WithRatio(ratio)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rendered := renderedRatio(t, itf.RenderImage); rendered != ratio {
+			t.Errorf("Unexpected rendered ratio %v for %v", rendered, ratio)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package barcode
+
+import (
+	"math"
+	"unicode/utf8"
+)
+
+// Wide to narrow ratios are expressed in logical units, with a narrow element two
+// units wide so that ratios can be set in steps of 0.5.
+const narrowBarSize = 2
+
+// ratioUnit returns the width of a narrow element in logical units for the given wide
+// to narrow ratios: narrowBarSize, or twice as much if a ratio is not in steps of 0.5,
+// so that it can be rendered in steps of 0.25.
+func ratioUnit(ratios ...float64) int {
+	for _, ratio := range ratios {
+		if w := ratio * narrowBarSize; math.Abs(w-math.Floor(w+0.5)) > 1e-9 {
+			return 2 * narrowBarSize
+		}
+	}
+	return narrowBarSize
+}
+
+// wideBarSize returns the width of a wide element for the given wide to narrow ratio
+// and narrow element width, rounded up so that the ratio is never smaller than asked.
+func wideBarSize(ratio float64, narrow int) int {
+	return int(math.Ceil(ratio*float64(narrow) - 1e-9))
+}
+
+// linearSymbol describes a one dimensional symbol, whose bars span the whole height.
+type linearSymbol struct {
+	// Widths of the alternating bars and spaces in logical units, starting with a bar
+	widths []int
+	// Quiet zone on each side of the bars in logical units
+	quietZone int
+	// Human readable text printed below the bars
+	text string
+	// Thickness of the bearer bars in logical units
+	bearerSize  int
+	bearerStyle BearerStyle
+}
+
+func (s linearSymbol) barsWidth() int {
+	width := 0
+	for _, w := range s.widths {
+		width += w
+	}
+	return width
+}
+
+func (s linearSymbol) layout() eanLayout {
+	width := s.barsWidth() + 2*s.quietZone
+	if s.bearerStyle == BearerFrame {
+		width += 2 * s.bearerSize
+	}
+	return eanLayout{width: width}
+}
+
+func renderLinear(s linearSymbol, r eanRenderer) {
+	c := r.Start()
+
+	width := s.layout().width
+	inset := 0
+	cx := 0
+	switch s.bearerStyle {
+	case BearerFrame:
+		r.DrawBar(c.translateBar(0, s.bearerSize, false))
+		r.DrawBar(c.translateBar(width-s.bearerSize, width, false))
+		cx += s.bearerSize
+		fallthrough
+	case BearerTopBottom:
+		top, bottom := c.translateBearers(cx, width-cx, s.bearerSize)
+		r.DrawBar(top)
+		r.DrawBar(bottom)
+		inset = s.bearerSize
+	}
+	cx += s.quietZone
+	// Draw bars, skipping the spaces
+	for i, w := range s.widths {
+		if i%2 == 0 {
+			r.DrawBar(c.translateInsetBar(cx, cx+w, inset))
+		}
+		cx += w
+	}
+	if s.text != "" {
+		rect, fontSize := c.translateText(0, width, utf8.RuneCountInString(s.text))
+		r.DrawText(s.text, rect, fontSize)
+	}
+	r.End()
+}
@@ -0,0 +1,72 @@
+package barcode
+
+import (
+	"image"
+)
+
+// matrixSymbol describes a two dimensional symbol made of square modules.
+type matrixSymbol struct {
+	// Dark modules, indexed by row then column
+	modules [][]bool
+	// Quiet zone on each side in modules
+	quietZone int
+}
+
+func (s matrixSymbol) layout() eanLayout {
+	width := 0
+	if len(s.modules) > 0 {
+		width = len(s.modules[0])
+	}
+	return eanLayout{
+		width:  width + 2*s.quietZone,
+		height: len(s.modules) + 2*s.quietZone,
+	}
+}
+
+// newMatrixCoordinateConverter scales the modules to the largest integer size that
+// fits in both directions, and centers the symbol in the bound.
+func newMatrixCoordinateConverter(outerBound image.Rectangle, layout eanLayout) (*eanCoordinateConverter, error) {
+	scale := outerBound.Dx() / layout.width
+	if s := outerBound.Dy() / layout.height; s < scale {
+		scale = s
+	}
+	if scale <= 0 {
+		return nil, errAreaTooSmall
+	}
+	margin := image.Pt(
+		(outerBound.Dx()-layout.width*scale)/2,
+		(outerBound.Dy()-layout.height*scale)/2)
+	return &eanCoordinateConverter{
+		bound: image.Rectangle{
+			Min: outerBound.Min.Add(margin),
+			Max: outerBound.Min.Add(margin).Add(image.Pt(layout.width*scale, layout.height*scale)),
+		},
+		scale: scale,
+	}, nil
+}
+
+// translateModule translates the module at column x and row y, in logical units.
+func (c *eanCoordinateConverter) translateModule(x, y int) image.Rectangle {
+	topLeft := c.bound.Min.Add(image.Pt(x*c.scale, y*c.scale))
+	return image.Rectangle{Min: topLeft, Max: topLeft.Add(image.Pt(c.scale, c.scale))}
+}
+
+func renderMatrix(s matrixSymbol, r eanRenderer) {
+	c := r.Start()
+	for y, row := range s.modules {
+		// Merge the horizontal runs of dark modules into a single bar
+		for x := 0; x < len(row); x++ {
+			if !row[x] {
+				continue
+			}
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+			rect := c.translateModule(s.quietZone+start, s.quietZone+y)
+			rect.Max.X += (x - start - 1) * c.scale
+			r.DrawBar(rect)
+		}
+	}
+	r.End()
+}
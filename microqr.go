@@ -0,0 +1,286 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+)
+
+const (
+	microQRMinVersion       = 1
+	microQRMaxVersion       = 4
+	microQRDefaultQuietZone = 2
+	microQRFormatMask       = 0x4445
+)
+
+// microQRDataBits gives the data capacity in bits, indexed by version and level, or
+// 0 if the level is not available. M1 only detects errors and is used for level L.
+var microQRDataBits = [5][3]int{
+	{},
+	{20, 0, 0},
+	{40, 32, 0},
+	{84, 68, 0},
+	{128, 112, 80},
+}
+
+// microQRTotalCodewords gives the number of data and error correction codewords,
+// indexed by version. The last data codeword of M1 and M3 has only 4 bits.
+var microQRTotalCodewords = [5]int{0, 5, 10, 17, 24}
+
+// microQRCharCountBits gives the length of the character count of each mode,
+// indexed by version, or 0 if the mode is not available.
+var microQRCharCountBits = [4][5]int{
+	{0, 3, 4, 5, 6},
+	{0, 0, 3, 4, 5},
+	{0, 0, 0, 4, 5},
+	{0, 0, 0, 3, 4},
+}
+
+// microQRSymbolNumbers gives the version and level as encoded in the format
+// information.
+var microQRSymbolNumbers = [5][3]int{
+	{},
+	{0, 0, 0},
+	{1, 2, 0},
+	{3, 4, 0},
+	{5, 6, 7},
+}
+
+// microQRMasks are the 4 data mask patterns of Micro QR, a subset of the QR Code
+// ones.
+var microQRMasks = [4]func(x, y int) bool{qrMasks[1], qrMasks[4], qrMasks[6], qrMasks[7]}
+
+var (
+	errMicroQRTooLong     = errors.New("Data too long for a Micro QR Code")
+	errInvalidMicroQRText = errors.New("Micro QR Code cannot encode characters outside of ISO 8859-1 and Kanji")
+)
+
+// microQRSize returns the number of modules on each side of a version.
+func microQRSize(version int) int {
+	return version*2 + 9
+}
+
+// encodeMicroQRData returns the smallest version able to hold the text at the
+// given level, and the data codewords padded to its capacity.
+func encodeMicroQRData(text string, level QRLevel) (int, []int, error) {
+	t := newQRText(text)
+	if t.utf8 {
+		return 0, nil, errInvalidMicroQRText
+	}
+	for version := microQRMinVersion; version <= microQRMaxVersion; version++ {
+		capacity := microQRDataBits[version][level]
+		if capacity == 0 {
+			continue
+		}
+		// The mode indicator has one bit less than the version number
+		var headBits [4]int
+		for m := range headBits {
+			if countBits := microQRCharCountBits[m][version]; countBits > 0 {
+				headBits[m] = version - 1 + countBits
+			}
+		}
+		segments := t.optimalSegments(headBits)
+		if segments == nil && len(t.runes) > 0 {
+			continue
+		}
+		var w bitWriter
+		fits := true
+		for _, s := range segments {
+			countBits := microQRCharCountBits[s.mode][version]
+			if s.count >= 1<<uint(countBits) {
+				fits = false
+				break
+			}
+			w.write(uint64(s.mode), version-1)
+			w.write(uint64(s.count), countBits)
+			w.bits = append(w.bits, s.data.bits...)
+		}
+		if !fits || len(w.bits) > capacity {
+			continue
+		}
+		return version, padMicroQRData(w, capacity, version*2+1), nil
+	}
+	return 0, nil, errMicroQRTooLong
+}
+
+// padMicroQRData terminates the bit stream and fills the capacity with the pad
+// codewords. A last codeword of 4 bits is returned in the high bits.
+func padMicroQRData(w bitWriter, capacity, terminator int) []int {
+	if terminator > capacity-len(w.bits) {
+		terminator = capacity - len(w.bits)
+	}
+	w.write(0, terminator)
+	if n := (8 - len(w.bits)%8) % 8; n <= capacity-len(w.bits) {
+		w.write(0, n)
+	}
+	for i := 0; capacity-len(w.bits) >= 8; i++ {
+		w.write(uint64(qrPadCodewords>>uint(8-i%2*8)&0xff), 8)
+	}
+	w.write(0, capacity-len(w.bits))
+	codewords := make([]int, (capacity+7)/8)
+	r := bitReader{w.bits}
+	for i := range codewords {
+		n := 8
+		if capacity-i*8 < 8 {
+			n = capacity - i*8
+		}
+		v, _ := r.read(n)
+		codewords[i] = int(v) << uint(8-n)
+	}
+	return codewords
+}
+
+// microQRFormatBits returns the format information of a version, level and mask.
+func microQRFormatBits(version int, level QRLevel, mask int) int {
+	return bchCode(microQRSymbolNumbers[version][level]<<2|mask, qrFormatGenerator, 10) ^ microQRFormatMask
+}
+
+// drawMicroQRFormat draws the 15 bits of format information below and right of the
+// finder.
+func drawMicroQRFormat(m *qrMatrix, bits int) {
+	for i := 0; i < 8; i++ {
+		m.set(8, 1+i, bits>>uint(i)&1 != 0)
+	}
+	for i := 8; i < 15; i++ {
+		m.set(15-i, 8, bits>>uint(i)&1 != 0)
+	}
+}
+
+// drawMicroQRFunctionPatterns draws the finder and timing patterns and reserves the
+// format information.
+func drawMicroQRFunctionPatterns(m *qrMatrix) {
+	for i := 0; i < m.width; i++ {
+		m.set(i, 0, i%2 == 0)
+		m.set(0, i, i%2 == 0)
+	}
+	m.drawFinder(3, 3)
+	drawMicroQRFormat(m, 0)
+}
+
+// microQRMaskScore returns the score of the masked symbol, higher being better,
+// from the dark modules on its right and bottom edges.
+func microQRMaskScore(m *qrMatrix) int {
+	right, bottom := 0, 0
+	for i := 1; i < m.width; i++ {
+		if m.modules[i][m.width-1] {
+			right++
+		}
+		if m.modules[m.width-1][i] {
+			bottom++
+		}
+	}
+	if right <= bottom {
+		return right*16 + bottom
+	}
+	return bottom*16 + right
+}
+
+// MicroQR is a Micro QR Code symbol, with a single finder pattern. Rectangular
+// Micro QR (rMQR) is not available.
+type MicroQR struct {
+	modules   [][]bool
+	version   int
+	level     QRLevel
+	mask      int
+	quietZone int
+}
+
+// MicroQRFromString encodes text in the smallest version, from M1 to M4, for the
+// level, which is L, M or Q. Like QRCodeFromString, it splits the text in segments
+// to minimize its length.
+func MicroQRFromString(text string, level QRLevel) (MicroQR, error) {
+	if level < QRLevelL || level > QRLevelQ {
+		return MicroQR{}, errInvalidQRLevel
+	}
+	version, data, err := encodeMicroQRData(text, level)
+	if err != nil {
+		return MicroQR{}, err
+	}
+	capacity := microQRDataBits[version][level]
+	ecc := qrField.rsEncode(data, microQRTotalCodewords[version]-len(data), 0)
+	var w bitWriter
+	for i, c := range data {
+		if i == len(data)-1 && capacity%8 != 0 {
+			w.write(uint64(c>>4), 4)
+		} else {
+			w.write(uint64(c), 8)
+		}
+	}
+	w.bits = append(w.bits, codewordBits(ecc, 8)...)
+	size := microQRSize(version)
+	m := newQRMatrix(size, size)
+	drawMicroQRFunctionPatterns(m)
+	// The timing pattern is on the left edge, out of the column pairs
+	m.placeData(w.bits, -1)
+	best, bestScore := 0, -1
+	for mask, f := range microQRMasks {
+		m.applyMask(f)
+		if s := microQRMaskScore(m); s > bestScore {
+			best, bestScore = mask, s
+		}
+		m.applyMask(f)
+	}
+	m.applyMask(microQRMasks[best])
+	drawMicroQRFormat(m, microQRFormatBits(version, level, best))
+	return MicroQR{
+		modules:   m.modules,
+		version:   version,
+		level:     level,
+		mask:      best,
+		quietZone: microQRDefaultQuietZone,
+	}, nil
+}
+
+// Version returns the version, from 1 for M1 to 4 for M4.
+func (qr MicroQR) Version() int {
+	return qr.version
+}
+
+func (qr MicroQR) Level() QRLevel {
+	return qr.level
+}
+
+// Size returns the number of modules on each side, without the quiet zone.
+func (qr MicroQR) Size() int {
+	return len(qr.modules)
+}
+
+// Module returns whether the module at column x and row y is dark.
+func (qr MicroQR) Module(x, y int) bool {
+	return qr.modules[y][x]
+}
+
+// WithQuietZone returns a copy with a quiet zone of the given number of modules
+// on each side, 2 by default.
+func (qr MicroQR) WithQuietZone(modules int) MicroQR {
+	qr.quietZone = modules
+	return qr
+}
+
+func (qr MicroQR) symbol() matrixSymbol {
+	return matrixSymbol{
+		modules:   qr.modules,
+		quietZone: qr.quietZone,
+	}
+}
+
+func (qr MicroQR) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := qr.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
+
+func (qr MicroQR) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := qr.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
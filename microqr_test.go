@@ -0,0 +1,266 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestMicroQRVersion(t *testing.T) {
+	data := []struct {
+		text    string
+		level   QRLevel
+		version int
+	}{
+		{"", QRLevelL, 1},
+		{"12345", QRLevelL, 1},
+		{"123456", QRLevelL, 2},
+		{"12345", QRLevelM, 2},
+		{"AB12", QRLevelL, 2},
+		{"ab", QRLevelL, 3},
+		{"点茗", QRLevelL, 3},
+		{"12345", QRLevelQ, 4},
+		{strings.Repeat("9", 35), QRLevelL, 4},
+		{"https://gs1.org", QRLevelL, 4},
+	}
+	for _, d := range data {
+		qr, err := MicroQRFromString(d.text, d.level)
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %v", d.text, err)
+		}
+		if qr.Version() != d.version || qr.Size() != microQRSize(d.version) {
+			t.Errorf("Unexpected version of %q: M%d of size %d", d.text, qr.Version(), qr.Size())
+		}
+	}
+	if _, err := MicroQRFromString(strings.Repeat("9", 36), QRLevelL); err != errMicroQRTooLong {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if _, err := MicroQRFromString("12345", QRLevelH); err != errInvalidQRLevel {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if _, err := MicroQRFromString("€", QRLevelL); err != errInvalidMicroQRText {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestEncodeMicroQRData(t *testing.T) {
+	data := []struct {
+		text     string
+		level    QRLevel
+		version  int
+		expected []int
+	}{
+		// Example of ISO/IEC 18004, with a 1 bit mode indicator and a 4 bit count
+		{"01234567", QRLevelL, 2, []int{0x40, 0x18, 0xac, 0xc3, 0x00}},
+		// No mode indicator and 3 bit count, the last codeword having 4 bits
+		{"12345", QRLevelL, 1, []int{0xa3, 0xda, 0xd0}},
+		// 3 bit count and terminator, then pad codewords
+		{"1", QRLevelL, 1, []int{0x22, 0x00, 0x00}},
+		{"1", QRLevelM, 2, []int{0x08, 0x80, 0xec, 0x11}},
+	}
+	for _, d := range data {
+		version, codewords, err := encodeMicroQRData(d.text, d.level)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version != d.version || !equalCodewords(codewords, d.expected) {
+			t.Errorf("Unexpected codewords of %q in M%d: %x", d.text, version, codewords)
+		}
+	}
+	ecc := qrField.rsEncode([]int{0x40, 0x18, 0xac, 0xc3, 0x00}, 5, 0)
+	if !equalCodewords(ecc, []int{0x86, 0x0d, 0x22, 0xae, 0x30}) {
+		t.Errorf("Unexpected error correction codewords %x", ecc)
+	}
+}
+
+func TestMicroQRFormatBits(t *testing.T) {
+	if bits := microQRFormatBits(1, QRLevelL, 0); bits != microQRFormatMask {
+		t.Errorf("Unexpected format %015b", bits)
+	}
+	// The format information is a BCH code masked by microQRFormatMask
+	for version := microQRMinVersion; version <= microQRMaxVersion; version++ {
+		for mask := range microQRMasks {
+			bits := microQRFormatBits(version, QRLevelL, mask) ^ microQRFormatMask
+			if bits>>10 != microQRSymbolNumbers[version][QRLevelL]<<2|mask || bchCode(bits>>10, qrFormatGenerator, 10) != bits {
+				t.Errorf("Unexpected format %015b of M%d/%d", bits, version, mask)
+			}
+		}
+	}
+}
+
+func TestMicroQR(t *testing.T) {
+	qr, err := MicroQRFromString("MICRO QR", QRLevelM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version() != 3 || qr.Level() != QRLevelM || qr.Size() != 15 {
+		t.Errorf("Unexpected version M%d/%d of size %d", qr.Version(), qr.Level(), qr.Size())
+	}
+	// Finder pattern with its separator, and timing patterns along the top and left
+	// edges
+	for i := 0; i < qr.Size(); i++ {
+		expected := i < 7 || (i > 7 && i%2 == 0)
+		if qr.Module(i, 0) != expected || qr.Module(0, i) != expected {
+			t.Errorf("Unexpected finder or timing pattern at %d", i)
+		}
+	}
+	// Format information read back from the row below the finder and the column
+	// on its right
+	format := 0
+	for i := 0; i < 8; i++ {
+		if qr.Module(8, 1+i) {
+			format |= 1 << uint(i)
+		}
+	}
+	for i := 8; i < 15; i++ {
+		if qr.Module(15-i, 8) {
+			format |= 1 << uint(i)
+		}
+	}
+	if format != microQRFormatBits(3, QRLevelM, qr.mask) {
+		t.Errorf("Unexpected format %015b", format)
+	}
+	if s := qr.WithQuietZone(4).symbol().layout(); s.width != 23 || s.height != 23 {
+		t.Errorf("Unexpected layout %v", s)
+	}
+}
+
+// readMicroQR decodes the numeric, alphanumeric and byte segments of a symbol from
+// its modules, reading the format information, the data modules and the error
+// correction codewords without the encoder.
+func readMicroQR(qr MicroQR) (string, error) {
+	size := qr.Size()
+	format := 0
+	for i := 0; i < 8; i++ {
+		if qr.Module(8, 1+i) {
+			format |= 1 << uint(i)
+		}
+	}
+	for i := 8; i < 15; i++ {
+		if qr.Module(15-i, 8) {
+			format |= 1 << uint(i)
+		}
+	}
+	format ^= microQRFormatMask
+	if bchCode(format>>10, qrFormatGenerator, 10) != format {
+		return "", errors.New("Invalid format information")
+	}
+	version, level, mask := 0, QRLevelL, format>>10&3
+	for v := microQRMinVersion; v <= microQRMaxVersion; v++ {
+		for l := QRLevelL; l <= QRLevelQ; l++ {
+			if microQRDataBits[v][l] > 0 && microQRSymbolNumbers[v][l] == format>>12 {
+				version, level = v, l
+			}
+		}
+	}
+	if microQRSize(version) != size {
+		return "", errors.New("Unexpected size")
+	}
+	// Pairs of columns from the right, alternately upwards and downwards, skipping
+	// the timing patterns and the finder, separator and format information
+	var bits []bool
+	upward := true
+	for right := size - 1; right >= 1; right -= 2 {
+		for v := 0; v < size; v++ {
+			y := v
+			if upward {
+				y = size - 1 - v
+			}
+			for x := right; x > right-2; x-- {
+				if x == 0 || y == 0 || (x <= 8 && y <= 8) {
+					continue
+				}
+				bits = append(bits, qr.Module(x, y) != microQRMasks[mask](x, y))
+			}
+		}
+		upward = !upward
+	}
+	capacity := microQRDataBits[version][level]
+	r := bitReader{bits}
+	var data []int
+	for n := capacity; n > 0; n -= 8 {
+		k := 8
+		if n < 8 {
+			k = n
+		}
+		v, _ := r.read(k)
+		data = append(data, int(v)<<uint(8-k))
+	}
+	ecc := make([]int, microQRTotalCodewords[version]-len(data))
+	for i := range ecc {
+		v, _ := r.read(8)
+		ecc[i] = int(v)
+	}
+	if !equalCodewords(qrField.rsEncode(data, len(ecc), 0), ecc) {
+		return "", errors.New("Invalid error correction codewords")
+	}
+	// The terminator reads as an empty numeric segment, and may be truncated
+	r = bitReader{bits[:capacity]}
+	text := ""
+	for {
+		mode, ok := r.read(version - 1)
+		if !ok {
+			break
+		}
+		count, ok := r.read(microQRCharCountBits[mode][version])
+		if !ok || count == 0 {
+			break
+		}
+		switch qrMode(mode) {
+		case qrNumeric:
+			for n := int(count); n > 0; n -= 3 {
+				k := 3
+				if n < 3 {
+					k = n
+				}
+				v, _ := r.read(3*k + 1)
+				text += fmt.Sprintf("%0*d", k, v)
+			}
+		case qrAlphanumeric:
+			for n := int(count); n > 0; n -= 2 {
+				if n == 1 {
+					v, _ := r.read(6)
+					text += qrAlphanumericString[v : v+1]
+					break
+				}
+				v, _ := r.read(11)
+				text += qrAlphanumericString[v/45:v/45+1] + qrAlphanumericString[v%45:v%45+1]
+			}
+		case qrByte:
+			for i := 0; i < int(count); i++ {
+				v, _ := r.read(8)
+				text += string(rune(v))
+			}
+		default:
+			return "", errors.New("Unexpected mode")
+		}
+	}
+	return text, nil
+}
+
+func TestMicroQRDecode(t *testing.T) {
+	data := []struct {
+		text  string
+		level QRLevel
+	}{
+		{"12345", QRLevelL},
+		{"01234567", QRLevelL},
+		{"AB12", QRLevelM},
+		{"MICRO QR", QRLevelM},
+		{"Grüße", QRLevelL},
+		{"https://gs1.org", QRLevelL},
+		{"ABCDEF0123456789", QRLevelM},
+		{"HELLO123", QRLevelQ},
+	}
+	for _, d := range data {
+		qr, err := MicroQRFromString(d.text, d.level)
+		if err != nil {
+			t.Fatal(err)
+		}
+		text, err := readMicroQR(qr)
+		if err != nil || text != d.text {
+			t.Errorf("Unexpected text %q of M%d read for %q: %v", text, qr.Version(), d.text, err)
+		}
+	}
+}
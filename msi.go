@@ -0,0 +1,156 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+	"strconv"
+)
+
+// MSICheck selects the check digits of an MSI symbol.
+type MSICheck int
+
+const (
+	// No check digit
+	MSINoCheck MSICheck = iota
+	// Modulo 10 (Luhn) check digit
+	MSIMod10
+	// Modulo 11 check digit with weights 2 to 7, which is 10 when the remainder is 1
+	MSIMod11
+	// Two modulo 10 check digits, the second one including the first one
+	MSIMod10Mod10
+	// Modulo 11 check digit followed by a modulo 10 check digit
+	MSIMod11Mod10
+)
+
+// Quiet zone of MSI, 10 times the narrow bar
+const msiQuietZone = 10 * narrowBarSize
+
+var (
+	errInvalidMSI      = errors.New("MSI encodes only digits")
+	errInvalidMSICheck = errors.New("Invalid MSI check scheme")
+)
+
+// MSI is an MSI (Modified Plessey) symbol.
+type MSI struct {
+	digits string
+	check  MSICheck
+}
+
+// MSIFromString encodes the digits, without check digit.
+func MSIFromString(digits string) (MSI, error) {
+	if digits == "" || !allDigits(digits) {
+		return MSI{}, errInvalidMSI
+	}
+	return MSI{digits: digits}, nil
+}
+
+// String returns the encoded digits, including the check digits.
+func (code MSI) String() string {
+	return code.digits + code.checkDigits()
+}
+
+// WithCheck returns a copy with the check digits of the scheme.
+func (code MSI) WithCheck(check MSICheck) (MSI, error) {
+	if check < MSINoCheck || check > MSIMod11Mod10 {
+		return MSI{}, errInvalidMSICheck
+	}
+	code.check = check
+	return code, nil
+}
+
+// msiMod10 returns the Luhn check digit, doubling every other digit from the
+// right one.
+func msiMod10(digits string) string {
+	sum := 0
+	for i := range digits {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return strconv.Itoa((10 - sum%10) % 10)
+}
+
+// msiMod11 returns the modulo 11 check digits, with weights from 2 to 7 from the
+// right.
+func msiMod11(digits string) string {
+	sum := 0
+	for i := range digits {
+		sum += int(digits[len(digits)-1-i]-'0') * (i%6 + 2)
+	}
+	return strconv.Itoa((11 - sum%11) % 11)
+}
+
+func (code MSI) checkDigits() string {
+	switch code.check {
+	case MSIMod10:
+		return msiMod10(code.digits)
+	case MSIMod11:
+		return msiMod11(code.digits)
+	case MSIMod10Mod10:
+		c := msiMod10(code.digits)
+		return c + msiMod10(code.digits+c)
+	case MSIMod11Mod10:
+		c := msiMod11(code.digits)
+		return c + msiMod10(code.digits+c)
+	}
+	return ""
+}
+
+// msiBit appends the bar and space of a bit: a wide bar and a narrow space for 1, a
+// narrow bar and a wide space for 0.
+func msiBit(widths []int, bit bool) []int {
+	if bit {
+		return append(widths, 2*narrowBarSize, narrowBarSize)
+	}
+	return append(widths, narrowBarSize, 2*narrowBarSize)
+}
+
+func (code MSI) widths() []int {
+	// Start character
+	widths := msiBit(nil, true)
+	for _, c := range code.String() {
+		// Binary coded decimal, from the most significant bit
+		for i := 3; i >= 0; i-- {
+			widths = msiBit(widths, (c-'0')>>uint(i)&1 != 0)
+		}
+	}
+	// Stop character
+	widths = msiBit(widths, false)
+	return append(widths, narrowBarSize)
+}
+
+func (code MSI) symbol() linearSymbol {
+	return linearSymbol{
+		widths:      code.widths(),
+		quietZone:   msiQuietZone,
+		text:        humanReadable(code.String()),
+		bearerStyle: BearerNone,
+	}
+}
+
+func (code MSI) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := code.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+func (code MSI) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := code.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
@@ -0,0 +1,54 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestMSICheckDigits(t *testing.T) {
+	for _, test := range []struct {
+		digits   string
+		check    MSICheck
+		expected string
+	}{
+		{"1234567", MSINoCheck, "1234567"},
+		{"1234567", MSIMod10, "12345674"},
+		{"80523", MSIMod10, "805234"},
+		{"1234567", MSIMod10Mod10, "123456741"},
+		{"1234567", MSIMod11, "12345674"},
+		{"1234", MSIMod11, "12343"},
+		{"1234", MSIMod11Mod10, "123430"},
+		// Remainder of 1
+		{"6", MSIMod11, "610"},
+	} {
+		code, _ := MSIFromString(test.digits)
+		code, _ = code.WithCheck(test.check)
+		if s := code.String(); s != test.expected {
+			t.Errorf("Unexpected text %s for %s", s, test.expected)
+		}
+	}
+}
+
+func TestMSIWidths(t *testing.T) {
+	code, _ := MSIFromString("5")
+	n, w := narrowBarSize, 2*narrowBarSize
+	expected := []int{
+		w, n, // start
+		n, w, w, n, n, w, w, n, // 0101
+		n, w, n, // stop
+	}
+	if widths := code.widths(); !equalCodewords(widths, expected) {
+		t.Errorf("Unexpected widths %v", widths)
+	}
+}
+
+func TestMSIInvalid(t *testing.T) {
+	for _, digits := range []string{"", "12A"} {
+		if _, err := MSIFromString(digits); err == nil {
+			t.Errorf("Unexpected valid digits %q", digits)
+		}
+	}
+	code, _ := MSIFromString("1")
+	if _, err := code.WithCheck(MSICheck(5)); err == nil {
+		t.Errorf("Unexpected valid check scheme")
+	}
+}
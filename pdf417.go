@@ -0,0 +1,353 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"math"
+	"strconv"
+)
+
+// Limits of the PDF417 symbols
+const (
+	pdf417MinRows      = 3
+	pdf417MaxRows      = 90
+	pdf417MaxColumns   = 30
+	pdf417MaxCodewords = 928
+	pdf417MaxLevel     = 8
+)
+
+// Default appearance of the PDF417 symbols
+const (
+	pdf417DefaultRowHeight   = 3
+	pdf417DefaultAspectRatio = 3
+	pdf417DefaultQuietZone   = 2
+)
+
+// Start and stop patterns, the stop pattern being 18 modules wide
+const (
+	pdf417Start = 0x1fea8
+	pdf417Stop  = 0x3fa29
+)
+
+var (
+	errPDF417TooLong         = errors.New("Data too long for a PDF417")
+	errInvalidPDF417Level    = errors.New("Invalid PDF417 security level")
+	errInvalidPDF417Columns  = errors.New("Invalid PDF417 column count")
+	errInvalidPDF417Ratio    = errors.New("Invalid PDF417 aspect ratio")
+	errInvalidPDF417FileID   = errors.New("Invalid Macro PDF417 file ID")
+	errInvalidPDF417Segments = errors.New("Invalid Macro PDF417 segment length")
+)
+
+// pdf417RecommendedLevel returns the minimum security level recommended for n
+// data codewords.
+func pdf417RecommendedLevel(n int) int {
+	switch {
+	case n <= 40:
+		return 2
+	case n <= 160:
+		return 3
+	case n <= 320:
+		return 4
+	}
+	return 5
+}
+
+// pdf417Dimensions is the arrangement of the codewords of a symbol.
+type pdf417Dimensions struct {
+	rows, columns, level int
+}
+
+// PDF417 is a PDF417 symbol, or one segment of a Macro PDF417.
+type PDF417 struct {
+	data []int
+	// Macro PDF417 control block, after the padding
+	macro []int
+	// Requested security level, or -1 for the recommended one
+	level int
+	// Requested number of data columns, or 0 to follow the aspect ratio
+	columns     int
+	aspectRatio float64
+	rowHeight   int
+	quietZone   int
+	dim         pdf417Dimensions
+}
+
+// PDF417FromString encodes text in a PDF417. Latin-1 text is encoded as is, and
+// other text in UTF-8 behind an ECI.
+func PDF417FromString(text string) (PDF417, error) {
+	var data []byte
+	var eci []int
+	for _, c := range text {
+		if c > 0xff {
+			data, eci = []byte(text), []int{pdf417ECI, pdf417ECIUTF8}
+			break
+		}
+		data = append(data, byte(c))
+	}
+	return pdf417FromData(append(eci, encodePDF417(data)...), nil)
+}
+
+// PDF417FromBytes encodes binary data in a PDF417.
+func PDF417FromBytes(data []byte) (PDF417, error) {
+	return pdf417FromData(encodePDF417(data), nil)
+}
+
+// MacroPDF417FromBytes splits data over as many symbols as needed for segments of
+// at most segmentLength bytes. The symbols share the file ID, made of groups of 3
+// digits, and carry their segment index and the segment count.
+func MacroPDF417FromBytes(data []byte, segmentLength int, fileID string) ([]PDF417, error) {
+	if segmentLength <= 0 {
+		return nil, errInvalidPDF417Segments
+	}
+	if len(fileID) == 0 || len(fileID)%3 != 0 {
+		return nil, errInvalidPDF417FileID
+	}
+	var id []int
+	for i := 0; i < len(fileID); i += 3 {
+		v, err := strconv.Atoi(fileID[i : i+3])
+		if err != nil || v >= 900 || !allDigits(fileID[i:i+3]) {
+			return nil, errInvalidPDF417FileID
+		}
+		id = append(id, v)
+	}
+	count := (len(data) + segmentLength - 1) / segmentLength
+	if count == 0 {
+		count = 1
+	}
+	if count > 99999 {
+		return nil, errInvalidPDF417Segments
+	}
+	symbols := make([]PDF417, count)
+	for i := range symbols {
+		end := (i + 1) * segmentLength
+		if end > len(data) {
+			end = len(data)
+		}
+		macro := []int{pdf417MacroBegin}
+		macro = append(macro, encodePDF417Numeric([]byte(fmt.Sprintf("%05d", i)))...)
+		macro = append(macro, id...)
+		macro = append(macro, pdf417MacroOptional, 1)
+		macro = append(macro, encodePDF417Numeric([]byte(strconv.Itoa(count)))...)
+		if i == count-1 {
+			macro = append(macro, pdf417MacroTerminate)
+		}
+		var err error
+		if symbols[i], err = pdf417FromData(encodePDF417(data[i*segmentLength:end]), macro); err != nil {
+			return nil, err
+		}
+	}
+	return symbols, nil
+}
+
+func pdf417FromData(data, macro []int) (PDF417, error) {
+	p := PDF417{
+		data:        data,
+		macro:       macro,
+		level:       -1,
+		aspectRatio: pdf417DefaultAspectRatio,
+		rowHeight:   pdf417DefaultRowHeight,
+		quietZone:   pdf417DefaultQuietZone,
+	}
+	return p.arrange()
+}
+
+// arrange computes the dimensions of the symbol for the requested settings.
+func (p PDF417) arrange() (PDF417, error) {
+	n := 1 + len(p.data) + len(p.macro)
+	if p.level >= 0 {
+		dim, ok := p.arrangeLevel(n, p.level)
+		if !ok {
+			return PDF417{}, errPDF417TooLong
+		}
+		p.dim = dim
+		return p, nil
+	}
+	// Lower the recommended level when the data does not fit
+	for level := pdf417RecommendedLevel(n); level >= 0; level-- {
+		if dim, ok := p.arrangeLevel(n, level); ok {
+			p.dim = dim
+			return p, nil
+		}
+	}
+	return PDF417{}, errPDF417TooLong
+}
+
+// arrangeLevel returns the dimensions of a symbol with n data codewords at the
+// security level, with the requested columns or closest to the aspect ratio.
+func (p PDF417) arrangeLevel(n, level int) (pdf417Dimensions, bool) {
+	total := n + 2<<uint(level)
+	best, bestDistance := pdf417Dimensions{}, math.Inf(1)
+	for columns := 1; columns <= pdf417MaxColumns; columns++ {
+		if p.columns > 0 && columns != p.columns {
+			continue
+		}
+		rows := (total + columns - 1) / columns
+		if rows < pdf417MinRows {
+			rows = pdf417MinRows
+		}
+		if rows > pdf417MaxRows || rows*columns > pdf417MaxCodewords {
+			continue
+		}
+		ratio := float64(17*columns+69) / float64(rows*p.rowHeight)
+		if d := math.Abs(ratio - p.aspectRatio); d < bestDistance {
+			best, bestDistance = pdf417Dimensions{rows, columns, level}, d
+		}
+	}
+	return best, best.rows > 0
+}
+
+// WithSecurityLevel returns a copy using the security level, from 0 to 8, with
+// 2^(level+1) error correction codewords. By default the level is the one
+// recommended for the data length.
+func (p PDF417) WithSecurityLevel(level int) (PDF417, error) {
+	if level < 0 || level > pdf417MaxLevel {
+		return PDF417{}, errInvalidPDF417Level
+	}
+	p.level = level
+	return p.arrange()
+}
+
+// WithColumns returns a copy with the number of data columns, from 1 to 30.
+func (p PDF417) WithColumns(columns int) (PDF417, error) {
+	if columns < 1 || columns > pdf417MaxColumns {
+		return PDF417{}, errInvalidPDF417Columns
+	}
+	p.columns = columns
+	return p.arrange()
+}
+
+// WithAspectRatio returns a copy with the number of columns giving the width to
+// height ratio closest to ratio, 3 by default.
+func (p PDF417) WithAspectRatio(ratio float64) (PDF417, error) {
+	if !(ratio > 0) {
+		return PDF417{}, errInvalidPDF417Ratio
+	}
+	p.columns, p.aspectRatio = 0, ratio
+	return p.arrange()
+}
+
+// WithRowHeight returns a copy whose rows are multiplier modules high, 3 by
+// default. Unless the columns were set, they are chosen again for the aspect ratio.
+func (p PDF417) WithRowHeight(multiplier int) PDF417 {
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	p.rowHeight = multiplier
+	// The dimensions that fit with another ratio still fit
+	p, _ = p.arrange()
+	return p
+}
+
+// WithQuietZone returns a copy with a quiet zone of the given number of modules
+// on each side, 2 by default.
+func (p PDF417) WithQuietZone(modules int) PDF417 {
+	p.quietZone = modules
+	return p
+}
+
+// Rows returns the number of rows of codewords.
+func (p PDF417) Rows() int {
+	return p.dim.rows
+}
+
+// Columns returns the number of data columns, without the row indicators.
+func (p PDF417) Columns() int {
+	return p.dim.columns
+}
+
+// SecurityLevel returns the security level in use.
+func (p PDF417) SecurityLevel() int {
+	return p.dim.level
+}
+
+// codewords returns the codewords of the symbol, row by row: the length
+// descriptor, the data, the padding, the macro control block and the error
+// correction codewords.
+func (p PDF417) codewords() []int {
+	eccCount := 2 << uint(p.dim.level)
+	n := p.dim.rows*p.dim.columns - eccCount
+	codewords := make([]int, 0, p.dim.rows*p.dim.columns)
+	codewords = append(codewords, n)
+	codewords = append(codewords, p.data...)
+	for len(codewords)+len(p.macro) < n {
+		codewords = append(codewords, pdf417Pad)
+	}
+	codewords = append(codewords, p.macro...)
+	return append(codewords, pdf417ECC(codewords, eccCount)...)
+}
+
+// rowIndicators returns the left and right row indicators of row y, which carry
+// the row count, column count and security level.
+func (p PDF417) rowIndicators(y int) (int, int) {
+	rows := (p.dim.rows - 1) / 3
+	level := p.dim.level*3 + (p.dim.rows-1)%3
+	columns := p.dim.columns - 1
+	base := y / 3 * 30
+	switch y % 3 {
+	case 0:
+		return base + rows, base + columns
+	case 1:
+		return base + level, base + rows
+	}
+	return base + columns, base + level
+}
+
+// modules returns the modules of the rows, each row being 17 modules for the start
+// pattern, each codeword and the row indicators, and 18 modules for the stop
+// pattern.
+func (p PDF417) modules() [][]bool {
+	codewords := p.codewords()
+	width := 17*(p.dim.columns+4) + 1
+	var modules [][]bool
+	for y := 0; y < p.dim.rows; y++ {
+		cluster := y % 3
+		row := make([]bool, 0, width)
+		appendPattern := func(pattern uint32, n int) {
+			for i := n - 1; i >= 0; i-- {
+				row = append(row, pattern>>uint(i)&1 != 0)
+			}
+		}
+		left, right := p.rowIndicators(y)
+		appendPattern(pdf417Start, 17)
+		appendPattern(pdf417Patterns[cluster][left], 17)
+		for _, c := range codewords[y*p.dim.columns : (y+1)*p.dim.columns] {
+			appendPattern(pdf417Patterns[cluster][c], 17)
+		}
+		appendPattern(pdf417Patterns[cluster][right], 17)
+		appendPattern(pdf417Stop, 18)
+		for i := 0; i < p.rowHeight; i++ {
+			modules = append(modules, row)
+		}
+	}
+	return modules
+}
+
+func (p PDF417) symbol() matrixSymbol {
+	return matrixSymbol{
+		modules:   p.modules(),
+		quietZone: p.quietZone,
+	}
+}
+
+func (p PDF417) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := p.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
+
+func (p PDF417) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := p.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
@@ -0,0 +1,341 @@
+package barcode
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Codewords with a special meaning
+const (
+	pdf417LatchText      = 900
+	pdf417LatchByte      = 901
+	pdf417LatchNumeric   = 902
+	pdf417MacroTerminate = 922
+	pdf417MacroOptional  = 923
+	pdf417LatchByte6     = 924
+	pdf417ECI            = 927
+	pdf417MacroBegin     = 928
+	pdf417ShiftByte      = 913
+	pdf417Pad            = 900
+	pdf417ECIUTF8        = 26
+)
+
+// Submodes of the text compaction
+const (
+	pdf417Alpha = iota
+	pdf417Lower
+	pdf417Mixed
+	pdf417Punct
+)
+
+// Values of the latches and shifts between the text submodes
+const (
+	pdf417TextSpace      = 26
+	pdf417TextLatchLower = 27
+	pdf417TextShiftAlpha = 27
+	pdf417TextLatchMixed = 28
+	pdf417TextLatchAlpha = 28
+	pdf417TextShiftPunct = 29
+	pdf417TextLatchPunct = 25
+	pdf417TextPunctAlpha = 29
+)
+
+// Shortest runs worth a switch to the numeric or text compaction
+const (
+	pdf417MinNumericCount = 13
+	pdf417MinTextCount    = 5
+)
+
+// The characters of the mixed and punctuation submodes, by value. The latches are
+// marked with a NUL.
+const (
+	pdf417MixedChars = "0123456789&\r\t,:#-.$/+%*=^\x00 \x00\x00\x00"
+	pdf417PunctChars = ";<>@[\\]_`~!\r\t,:\n-.$/\"|*()?{}'\x00"
+)
+
+func pdf417IsDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func pdf417IsText(c byte) bool {
+	return c == '\t' || c == '\n' || c == '\r' || (c >= ' ' && c <= '~')
+}
+
+func pdf417IsUpper(c byte) bool {
+	return c == ' ' || (c >= 'A' && c <= 'Z')
+}
+
+func pdf417IsLower(c byte) bool {
+	return c == ' ' || (c >= 'a' && c <= 'z')
+}
+
+func pdf417MixedValue(c byte) int {
+	if c == 0 {
+		return -1
+	}
+	return strings.IndexByte(pdf417MixedChars, c)
+}
+
+func pdf417PunctValue(c byte) int {
+	if c == 0 {
+		return -1
+	}
+	return strings.IndexByte(pdf417PunctChars, c)
+}
+
+// pdf417DigitCount returns the number of consecutive digits at the start of data.
+func pdf417DigitCount(data []byte) int {
+	n := 0
+	for n < len(data) && pdf417IsDigit(data[n]) {
+		n++
+	}
+	return n
+}
+
+// pdf417TextCount returns the number of characters at the start of data that are
+// worth encoding in text compaction, stopping before a run of digits long enough
+// for numeric compaction.
+func pdf417TextCount(data []byte) int {
+	n := 0
+	for n < len(data) && pdf417IsText(data[n]) {
+		if pdf417DigitCount(data[n:]) >= pdf417MinNumericCount {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// pdf417ByteCount returns the number of bytes at the start of data to encode in
+// byte compaction, stopping before a run worth numeric or text compaction.
+func pdf417ByteCount(data []byte) int {
+	n := 0
+	for n < len(data) {
+		if pdf417DigitCount(data[n:]) >= pdf417MinNumericCount ||
+			pdf417TextCount(data[n:]) >= pdf417MinTextCount {
+			break
+		}
+		n++
+	}
+	if n == 0 {
+		n = 1
+	}
+	return n
+}
+
+// encodePDF417Text returns the codewords of the text, starting and ending in the
+// given submode.
+func encodePDF417Text(text []byte, submode int) ([]int, int) {
+	var values []int
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch submode {
+		case pdf417Alpha:
+			switch {
+			case pdf417IsUpper(c):
+				if c == ' ' {
+					values = append(values, pdf417TextSpace)
+				} else {
+					values = append(values, int(c-'A'))
+				}
+				i++
+			case pdf417IsLower(c):
+				values = append(values, pdf417TextLatchLower)
+				submode = pdf417Lower
+			case pdf417MixedValue(c) >= 0:
+				values = append(values, pdf417TextLatchMixed)
+				submode = pdf417Mixed
+			default:
+				values = append(values, pdf417TextShiftPunct, pdf417PunctValue(c))
+				i++
+			}
+		case pdf417Lower:
+			switch {
+			case pdf417IsLower(c):
+				if c == ' ' {
+					values = append(values, pdf417TextSpace)
+				} else {
+					values = append(values, int(c-'a'))
+				}
+				i++
+			case pdf417IsUpper(c):
+				values = append(values, pdf417TextShiftAlpha, int(c-'A'))
+				i++
+			case pdf417MixedValue(c) >= 0:
+				values = append(values, pdf417TextLatchMixed)
+				submode = pdf417Mixed
+			default:
+				values = append(values, pdf417TextShiftPunct, pdf417PunctValue(c))
+				i++
+			}
+		case pdf417Mixed:
+			switch {
+			case pdf417MixedValue(c) >= 0:
+				values = append(values, pdf417MixedValue(c))
+				i++
+			case pdf417IsUpper(c):
+				values = append(values, pdf417TextLatchAlpha)
+				submode = pdf417Alpha
+			case pdf417IsLower(c):
+				values = append(values, pdf417TextLatchLower)
+				submode = pdf417Lower
+			case i+1 < len(text) && pdf417PunctValue(text[i+1]) >= 0:
+				// Latch for a run of punctuation
+				values = append(values, pdf417TextLatchPunct)
+				submode = pdf417Punct
+			default:
+				values = append(values, pdf417TextShiftPunct, pdf417PunctValue(c))
+				i++
+			}
+		case pdf417Punct:
+			if v := pdf417PunctValue(c); v >= 0 {
+				values = append(values, v)
+				i++
+			} else {
+				values = append(values, pdf417TextPunctAlpha)
+				submode = pdf417Alpha
+			}
+		}
+	}
+	// Two values per codeword, the last one padded with a shift, which is a latch
+	// back to alpha in the punctuation submode
+	if len(values)%2 != 0 {
+		values = append(values, pdf417TextShiftPunct)
+		if submode == pdf417Punct {
+			submode = pdf417Alpha
+		}
+	}
+	codewords := make([]int, 0, len(values)/2)
+	for i := 0; i < len(values); i += 2 {
+		codewords = append(codewords, values[i]*30+values[i+1])
+	}
+	return codewords, submode
+}
+
+// encodePDF417Bytes returns the codewords of the bytes in byte compaction, each
+// group of 6 bytes giving 5 codewords in base 900.
+func encodePDF417Bytes(data []byte) []int {
+	var codewords []int
+	i := 0
+	for ; i+6 <= len(data); i += 6 {
+		var v uint64
+		for _, b := range data[i : i+6] {
+			v = v<<8 | uint64(b)
+		}
+		group := make([]int, 5)
+		for j := 4; j >= 0; j-- {
+			group[j] = int(v % 900)
+			v /= 900
+		}
+		codewords = append(codewords, group...)
+	}
+	for _, b := range data[i:] {
+		codewords = append(codewords, int(b))
+	}
+	return codewords
+}
+
+// encodePDF417Numeric returns the codewords of the digits in numeric compaction,
+// each group of up to 44 digits being prefixed with a 1 and converted to base 900.
+func encodePDF417Numeric(digits []byte) []int {
+	var codewords []int
+	base := big.NewInt(900)
+	for i := 0; i < len(digits); i += 44 {
+		end := i + 44
+		if end > len(digits) {
+			end = len(digits)
+		}
+		v, _ := new(big.Int).SetString("1"+string(digits[i:end]), 10)
+		var group []int
+		m := new(big.Int)
+		for v.Sign() > 0 {
+			v.DivMod(v, base, m)
+			group = append([]int{int(m.Int64())}, group...)
+		}
+		codewords = append(codewords, group...)
+	}
+	return codewords
+}
+
+// encodePDF417 returns the data codewords of the bytes, switching between the
+// text, byte and numeric compactions. The symbol starts in text compaction.
+func encodePDF417(data []byte) []int {
+	var codewords []int
+	// The compaction is identified by its latch
+	mode, submode := pdf417LatchText, pdf417Alpha
+	for len(data) > 0 {
+		if n := pdf417DigitCount(data); n >= pdf417MinNumericCount || n == len(data) {
+			codewords = append(codewords, pdf417LatchNumeric)
+			codewords = append(codewords, encodePDF417Numeric(data[:n])...)
+			mode = pdf417LatchNumeric
+			data = data[n:]
+			continue
+		}
+		// Short text is still worth the text compaction before a numeric run
+		if n := pdf417TextCount(data); n >= pdf417MinTextCount || n == len(data) ||
+			(n > 0 && pdf417DigitCount(data[n:]) >= pdf417MinNumericCount) {
+			if mode != pdf417LatchText {
+				codewords = append(codewords, pdf417LatchText)
+				mode, submode = pdf417LatchText, pdf417Alpha
+			}
+			var text []int
+			text, submode = encodePDF417Text(data[:n], submode)
+			codewords = append(codewords, text...)
+			data = data[n:]
+			continue
+		}
+		n := pdf417ByteCount(data)
+		switch {
+		case n == 1 && mode == pdf417LatchText:
+			// A single byte does not leave the text compaction
+			codewords = append(codewords, pdf417ShiftByte)
+		case n%6 == 0:
+			codewords = append(codewords, pdf417LatchByte6)
+			mode = pdf417LatchByte
+		default:
+			codewords = append(codewords, pdf417LatchByte)
+			mode = pdf417LatchByte
+		}
+		codewords = append(codewords, encodePDF417Bytes(data[:n])...)
+		data = data[n:]
+	}
+	return codewords
+}
+
+// pdf417Generator returns the coefficients of the generator polynomial of the n
+// error correction codewords, with the roots 3^1 to 3^n modulo 929, lowest
+// degree first and without the leading 1.
+func pdf417Generator(n int) []int {
+	g := []int{1}
+	root := 1
+	for i := 0; i < n; i++ {
+		root = root * 3 % 929
+		next := make([]int, len(g)+1)
+		for j, c := range g {
+			next[j+1] = (next[j+1] + c) % 929
+			next[j] = (next[j] + (929-root)*c) % 929
+		}
+		g = next
+	}
+	return g[:n]
+}
+
+// pdf417ECC returns the n error correction codewords of data.
+func pdf417ECC(data []int, n int) []int {
+	g := pdf417Generator(n)
+	// The remainder of the division, highest degree first
+	ecc := make([]int, n)
+	for _, d := range data {
+		factor := (d + ecc[0]) % 929
+		copy(ecc, ecc[1:])
+		ecc[n-1] = 0
+		for i := range ecc {
+			ecc[i] = (ecc[i] + 929*929 - factor*g[n-1-i]) % 929
+		}
+	}
+	// The codewords are the negated remainder
+	for i, c := range ecc {
+		ecc[i] = (929 - c) % 929
+	}
+	return ecc
+}
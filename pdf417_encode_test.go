@@ -0,0 +1,88 @@
+package barcode
+
+import (
+	"strings"
+	"testing"
+)
+
+func equalCodewords(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPDF417Text(t *testing.T) {
+	data := []struct {
+		text      string
+		codewords []int
+	}{
+		{"PDF417", []int{453, 178, 121, 239}},
+		{"AB", []int{1}},
+		// Latch to lower case, shift back to alpha
+		{"aBc", []int{810, 811, 89}},
+		// Latch to punctuation for a run
+		{"1@@", []int{841, 753, 119}},
+	}
+	for _, d := range data {
+		codewords, _ := encodePDF417Text([]byte(d.text), pdf417Alpha)
+		if !equalCodewords(codewords, d.codewords) {
+			t.Errorf("Unexpected codewords of %q: %v", d.text, codewords)
+		}
+	}
+}
+
+func TestPDF417Numeric(t *testing.T) {
+	codewords := encodePDF417Numeric([]byte("000213298174000"))
+	if !equalCodewords(codewords, []int{1, 624, 434, 632, 282, 200}) {
+		t.Errorf("Unexpected codewords %v", codewords)
+	}
+	// Groups of 44 digits
+	if n := len(encodePDF417Numeric([]byte(strings.Repeat("9", 45)))); n != 15+1 {
+		t.Errorf("Unexpected length %d", n)
+	}
+}
+
+func TestPDF417Bytes(t *testing.T) {
+	codewords := encodePDF417Bytes([]byte("\x00\x00\x00\x00\x01\x00\xff"))
+	if !equalCodewords(codewords, []int{0, 0, 0, 0, 256, 255}) {
+		t.Errorf("Unexpected codewords %v", codewords)
+	}
+}
+
+func TestEncodePDF417(t *testing.T) {
+	data := []struct {
+		data      string
+		codewords []int
+	}{
+		{"PDF417", []int{453, 178, 121, 239}},
+		{"123", []int{pdf417LatchNumeric, 1, 223}},
+		// Single byte shifted in text
+		{"ABCDE\xe9FGHIJ", []int{1, 63, 149, pdf417ShiftByte, 0xe9, 156, 218, 299}},
+		{"\x01\x02\x03\x04\x05\x06", []int{pdf417LatchByte6, 1, 620, 89, 74, 846}},
+		{"\x01\x02", []int{pdf417LatchByte, 1, 2}},
+		{"AB1234567890123", []int{1, pdf417LatchNumeric, 17, 110, 836, 811, 223}},
+		{"1234567890123AB", []int{pdf417LatchNumeric, 17, 110, 836, 811, 223, pdf417LatchText, 1}},
+	}
+	for _, d := range data {
+		codewords := encodePDF417([]byte(d.data))
+		if !equalCodewords(codewords, d.codewords) {
+			t.Errorf("Unexpected codewords of %q: %v", d.data, codewords)
+		}
+	}
+}
+
+func TestPDF417ECC(t *testing.T) {
+	ecc := pdf417ECC([]int{5, 453, 178, 121, 239}, 4)
+	if !equalCodewords(ecc, []int{452, 327, 657, 619}) {
+		t.Errorf("Unexpected error correction %v", ecc)
+	}
+	if g := pdf417Generator(2); !equalCodewords(g, []int{27, 917}) {
+		t.Errorf("Unexpected generator %v", g)
+	}
+}
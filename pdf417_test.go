@@ -0,0 +1,158 @@
+package barcode
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPDF417Dimensions(t *testing.T) {
+	code, err := PDF417FromString("PDF417")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 5 data codewords at the recommended level 2
+	if code.SecurityLevel() != 2 || code.Rows()*code.Columns() < 5+8 {
+		t.Errorf("Unexpected dimensions %dx%d at level %d", code.Rows(), code.Columns(), code.SecurityLevel())
+	}
+	code, err = code.WithColumns(2)
+	if err != nil || code.Columns() != 2 || code.Rows() != 7 {
+		t.Errorf("Unexpected dimensions %dx%d %v", code.Rows(), code.Columns(), err)
+	}
+	code, err = code.WithSecurityLevel(0)
+	if err != nil || code.Rows() != 4 {
+		t.Errorf("Unexpected rows %d %v", code.Rows(), err)
+	}
+	// At least 3 rows
+	code, _ = code.WithColumns(30)
+	if code.Rows() != 3 {
+		t.Errorf("Unexpected rows %d", code.Rows())
+	}
+	if _, err := code.WithSecurityLevel(9); err == nil {
+		t.Errorf("Unexpected security level 9")
+	}
+	if _, err := code.WithColumns(31); err == nil {
+		t.Errorf("Unexpected 31 columns")
+	}
+}
+
+func TestPDF417AspectRatio(t *testing.T) {
+	code, _ := PDF417FromString(strings.Repeat("Lorem ipsum dolor sit amet. ", 10))
+	wide, _ := code.WithAspectRatio(10)
+	tall, _ := code.WithAspectRatio(0.5)
+	if wide.Columns() <= code.Columns() || tall.Columns() >= code.Columns() {
+		t.Errorf("Unexpected columns %d %d %d", wide.Columns(), code.Columns(), tall.Columns())
+	}
+	// Taller rows need more columns for the same ratio
+	if high := code.WithRowHeight(6); high.Columns() <= code.Columns() {
+		t.Errorf("Unexpected columns %d", high.Columns())
+	}
+}
+
+func TestPDF417TooLong(t *testing.T) {
+	// The level is lowered to fit the data
+	code, err := PDF417FromBytes(make([]byte, 1100))
+	if err != nil || code.SecurityLevel() >= 5 {
+		t.Errorf("Unexpected level %d %v", code.SecurityLevel(), err)
+	}
+	if _, err := code.WithSecurityLevel(8); err == nil {
+		t.Errorf("Unexpected fit at level 8")
+	}
+	if _, err := PDF417FromBytes(make([]byte, 1200)); err == nil {
+		t.Errorf("Unexpected fit of 1200 bytes")
+	}
+}
+
+func TestPDF417Codewords(t *testing.T) {
+	code, _ := PDF417FromString("PDF417")
+	code, _ = code.WithColumns(1)
+	code, _ = code.WithSecurityLevel(1)
+	codewords := code.codewords()
+	expected := []int{5, 453, 178, 121, 239, 452, 327, 657, 619}
+	if !equalCodewords(codewords, expected) {
+		t.Errorf("Unexpected codewords %v", codewords)
+	}
+	// 9 rows of 1 column: 3 rows of indicators per cluster
+	left, right := code.rowIndicators(0)
+	if left != 2 || right != 0 {
+		t.Errorf("Unexpected indicators %d %d", left, right)
+	}
+	left, right = code.rowIndicators(4)
+	if left != 35 || right != 32 {
+		t.Errorf("Unexpected indicators %d %d", left, right)
+	}
+	left, right = code.rowIndicators(8)
+	if left != 60 || right != 65 {
+		t.Errorf("Unexpected indicators %d %d", left, right)
+	}
+}
+
+func TestPDF417Modules(t *testing.T) {
+	code, _ := PDF417FromString("PDF417")
+	code, _ = code.WithColumns(2)
+	code = code.WithRowHeight(4)
+	modules := code.modules()
+	if len(modules) != code.Rows()*4 || len(modules[0]) != 17*6+1 {
+		t.Fatalf("Unexpected size %dx%d", len(modules[0]), len(modules))
+	}
+	row := ""
+	for _, dark := range modules[0][:17] {
+		if dark {
+			row += "1"
+		} else {
+			row += "0"
+		}
+	}
+	if row != "11111111010101000" {
+		t.Errorf("Unexpected start pattern %s", row)
+	}
+	s := code.WithQuietZone(0).symbol()
+	if l := s.layout(); l.width != 17*6+1 || l.height != code.Rows()*4 {
+		t.Errorf("Unexpected layout %v", l)
+	}
+}
+
+func TestPDF417UTF8(t *testing.T) {
+	code, _ := PDF417FromString("ÉTÉ")
+	if code.data[0] == pdf417ECI {
+		t.Errorf("Unexpected ECI for Latin-1 text")
+	}
+	code, _ = PDF417FromString("Żółw")
+	if code.data[0] != pdf417ECI || code.data[1] != pdf417ECIUTF8 {
+		t.Errorf("Missing ECI for UTF-8 text")
+	}
+}
+
+func TestMacroPDF417(t *testing.T) {
+	data := []byte(strings.Repeat("0123456789ABCDEFGHIJ", 10))
+	symbols, err := MacroPDF417FromBytes(data, 80, "000123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(symbols) != 3 {
+		t.Fatalf("Unexpected segment count %d", len(symbols))
+	}
+	for i, s := range symbols {
+		// Segment index 0000i, file ID and segment count 3
+		expected := []int{pdf417MacroBegin, 111, 100 + i, 0, 123, pdf417MacroOptional, 1, 13}
+		if i == 2 {
+			expected = append(expected, pdf417MacroTerminate)
+		}
+		if !equalCodewords(s.macro, expected) {
+			t.Errorf("Unexpected control block %v", s.macro)
+		}
+		codewords := s.codewords()
+		n := codewords[0]
+		if !equalCodewords(codewords[n-len(expected):n], expected) {
+			t.Errorf("Control block not at the end of the data %v", codewords)
+		}
+	}
+	if _, err := MacroPDF417FromBytes(data, 80, "12"); err == nil {
+		t.Errorf("Unexpected file ID 12")
+	}
+	if _, err := MacroPDF417FromBytes(data, 80, "900"); err == nil {
+		t.Errorf("Unexpected file ID 900")
+	}
+	if _, err := MacroPDF417FromBytes(data, 0, "000"); err == nil {
+		t.Errorf("Unexpected segment length 0")
+	}
+}
@@ -0,0 +1,166 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+)
+
+// Widths of the one-track Pharmacode in logical units, the wide bar being 3 times
+// and the space 2 times as wide as the narrow bar
+const (
+	pharmacodeNarrow = narrowBarSize
+	pharmacodeWide   = 3 * narrowBarSize
+	pharmacodeSpace  = 2 * narrowBarSize
+	// Quiet zone of Pharmacode, 10 times the narrow bar
+	pharmacodeQuietZone = 10 * narrowBarSize
+)
+
+// Ranges of the values of Pharmacode
+const (
+	pharmacodeMin         = 3
+	pharmacodeMax         = 131070
+	pharmacodeTwoTrackMin = 4
+	pharmacodeTwoTrackMax = 64570080
+	// Quiet zone of the two-track Pharmacode in modules
+	pharmacodeTwoTrackQuietZone = 4
+)
+
+var errInvalidPharmacode = errors.New("Pharmacode value out of range")
+
+// Pharmacode is a one-track Laetus Pharmacode symbol.
+type Pharmacode struct {
+	value int
+}
+
+// PharmacodeFromInt returns the one-track Pharmacode of value, from 3 to 131070.
+func PharmacodeFromInt(value int) (Pharmacode, error) {
+	if value < pharmacodeMin || value > pharmacodeMax {
+		return Pharmacode{}, errInvalidPharmacode
+	}
+	return Pharmacode{value: value}, nil
+}
+
+// Value returns the encoded value.
+func (code Pharmacode) Value() int {
+	return code.value
+}
+
+// widths returns the widths of the bars and spaces. Read from the right, a narrow
+// bar in position i weighs 2^i and a wide bar 2^(i+1).
+func (code Pharmacode) widths() []int {
+	var bars []int
+	for n := code.value; n > 0; {
+		if n%2 == 0 {
+			bars = append(bars, pharmacodeWide)
+			n = (n - 2) / 2
+		} else {
+			bars = append(bars, pharmacodeNarrow)
+			n = (n - 1) / 2
+		}
+	}
+	widths := make([]int, 0, 2*len(bars)-1)
+	for i := len(bars) - 1; i >= 0; i-- {
+		widths = append(widths, bars[i])
+		if i > 0 {
+			widths = append(widths, pharmacodeSpace)
+		}
+	}
+	return widths
+}
+
+func (code Pharmacode) symbol() linearSymbol {
+	return linearSymbol{
+		widths:      code.widths(),
+		quietZone:   pharmacodeQuietZone,
+		bearerStyle: BearerNone,
+	}
+}
+
+func (code Pharmacode) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := code.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+func (code Pharmacode) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := code.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+// PharmacodeTwoTrack is a two-track Laetus Pharmacode symbol, whose bars fill the
+// top track, the bottom track or both.
+type PharmacodeTwoTrack struct {
+	value int
+}
+
+// PharmacodeTwoTrackFromInt returns the two-track Pharmacode of value, from 4 to
+// 64570080.
+func PharmacodeTwoTrackFromInt(value int) (PharmacodeTwoTrack, error) {
+	if value < pharmacodeTwoTrackMin || value > pharmacodeTwoTrackMax {
+		return PharmacodeTwoTrack{}, errInvalidPharmacode
+	}
+	return PharmacodeTwoTrack{value: value}, nil
+}
+
+// Value returns the encoded value.
+func (code PharmacodeTwoTrack) Value() int {
+	return code.value
+}
+
+// bars returns the bars. Read from the right, a bar in position i weighs 3^i in
+// the bottom track, 2*3^i in the top track and 3^(i+1) in both.
+func (code PharmacodeTwoTrack) bars() []fourStateBar {
+	var bars []fourStateBar
+	for n := code.value; n > 0; {
+		switch n % 3 {
+		case 0:
+			bars = append(bars, barFull)
+			n = (n - 3) / 3
+		case 1:
+			bars = append(bars, barDescender)
+			n = (n - 1) / 3
+		case 2:
+			bars = append(bars, barAscender)
+			n = (n - 2) / 3
+		}
+	}
+	for i, j := 0, len(bars)-1; i < j; i, j = i+1, j-1 {
+		bars[i], bars[j] = bars[j], bars[i]
+	}
+	return bars
+}
+
+func (code PharmacodeTwoTrack) symbol() fourStateSymbol {
+	return fourStateSymbol{bars: code.bars(), quietZone: pharmacodeTwoTrackQuietZone, twoTrack: true}
+}
+
+func (code PharmacodeTwoTrack) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := code.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderFourState(s, r)
+	return nil
+}
+
+func (code PharmacodeTwoTrack) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := code.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderFourState(s, r)
+	return nil
+}
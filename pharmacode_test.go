@@ -0,0 +1,56 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestPharmacodeWidths(t *testing.T) {
+	for _, test := range []struct {
+		value    int
+		expected []int
+	}{
+		{3, []int{pharmacodeNarrow, pharmacodeSpace, pharmacodeNarrow}},
+		{4, []int{pharmacodeNarrow, pharmacodeSpace, pharmacodeWide}},
+		{6, []int{pharmacodeWide, pharmacodeSpace, pharmacodeWide}},
+	} {
+		code, _ := PharmacodeFromInt(test.value)
+		if widths := code.widths(); !equalCodewords(widths, test.expected) {
+			t.Errorf("Unexpected widths %v of %d", widths, test.value)
+		}
+	}
+	code, _ := PharmacodeFromInt(pharmacodeMax)
+	if widths := code.widths(); len(widths) != 31 || widths[0] != pharmacodeWide {
+		t.Errorf("Unexpected widths %v", widths)
+	}
+}
+
+func TestPharmacodeTwoTrackBars(t *testing.T) {
+	for _, test := range []struct {
+		value    int
+		expected string
+	}{
+		{4, "DD"},
+		{11, "FA"},
+		{12, "FF"},
+		{13, "DDD"},
+		{pharmacodeTwoTrackMax, "FFFFFFFFFFFFFFFF"},
+	} {
+		code, _ := PharmacodeTwoTrackFromInt(test.value)
+		if s := fourStateString(code.bars()); s != test.expected {
+			t.Errorf("Unexpected bars %s of %d", s, test.value)
+		}
+	}
+}
+
+func TestPharmacodeInvalid(t *testing.T) {
+	for _, value := range []int{0, 2, pharmacodeMax + 1} {
+		if _, err := PharmacodeFromInt(value); err == nil {
+			t.Errorf("Unexpected valid value %d", value)
+		}
+	}
+	for _, value := range []int{3, pharmacodeTwoTrackMax + 1} {
+		if _, err := PharmacodeTwoTrackFromInt(value); err == nil {
+			t.Errorf("Unexpected valid value %d", value)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+	"strings"
+)
+
+// plesseyChars lists the characters of UK Plessey by value.
+const plesseyChars = "0123456789ABCDEF"
+
+const (
+	// Quiet zone of UK Plessey, 10 times the narrow element
+	plesseyQuietZone = 10 * narrowBarSize
+	// Generator polynomial of the CRC, x^8+x^7+x^6+x^5+x^3+1
+	plesseyPolynomial = 0x1e9
+	plesseyCRCBits    = 8
+)
+
+// Bits of the start character, and bars and spaces of the stop character
+var (
+	plesseyStart = []bool{true, true, false, true}
+	plesseyStop  = []int{3, 3, 1, 3, 1, 1, 3, 1, 3}
+)
+
+var errInvalidPlessey = errors.New("Character cannot be encoded in UK Plessey")
+
+// Plessey is a UK Plessey symbol, with its CRC check characters.
+type Plessey struct {
+	data string
+}
+
+// PlesseyFromString encodes text made of digits and the letters A to F.
+func PlesseyFromString(text string) (Plessey, error) {
+	for _, c := range text {
+		if !strings.ContainsRune(plesseyChars, c) {
+			return Plessey{}, errInvalidPlessey
+		}
+	}
+	return Plessey{data: text}, nil
+}
+
+// String returns the encoded text, without the check characters.
+func (code Plessey) String() string {
+	return code.data
+}
+
+// bits returns the bits of the data, each character from the least significant
+// bit, followed by the bits of the CRC.
+func (code Plessey) bits() []bool {
+	var bits []bool
+	for _, c := range code.data {
+		v := strings.IndexRune(plesseyChars, c)
+		for i := uint(0); i < 4; i++ {
+			bits = append(bits, v>>i&1 != 0)
+		}
+	}
+	// The CRC is the remainder of the division of the bits by the polynomial
+	n := len(bits)
+	rem := append(append([]bool(nil), bits...), make([]bool, plesseyCRCBits)...)
+	for i := 0; i < n; i++ {
+		if rem[i] {
+			for j := 0; j <= plesseyCRCBits; j++ {
+				rem[i+j] = rem[i+j] != (plesseyPolynomial>>uint(plesseyCRCBits-j)&1 != 0)
+			}
+		}
+	}
+	return append(bits, rem[n:]...)
+}
+
+// plesseyBit appends the bar and space of a bit: a wide bar and a narrow space for
+// 1, a narrow bar and a wide space for 0.
+func plesseyBit(widths []int, bit bool) []int {
+	if bit {
+		return append(widths, 3*narrowBarSize, narrowBarSize)
+	}
+	return append(widths, narrowBarSize, 3*narrowBarSize)
+}
+
+func (code Plessey) widths() []int {
+	var widths []int
+	for _, bit := range append(plesseyStart, code.bits()...) {
+		widths = plesseyBit(widths, bit)
+	}
+	for _, w := range plesseyStop {
+		widths = append(widths, w*narrowBarSize)
+	}
+	return widths
+}
+
+func (code Plessey) symbol() linearSymbol {
+	return linearSymbol{
+		widths:      code.widths(),
+		quietZone:   plesseyQuietZone,
+		text:        humanReadable(code.data),
+		bearerStyle: BearerNone,
+	}
+}
+
+func (code Plessey) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := code.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+func (code Plessey) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := code.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
@@ -0,0 +1,49 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestPlesseyCRC(t *testing.T) {
+	code, _ := PlesseyFromString("01234ABCDEF")
+	bits := code.bits()
+	if len(bits) != 4*11+plesseyCRCBits {
+		t.Fatalf("Unexpected number of bits %d", len(bits))
+	}
+	// The bits with the CRC are a multiple of the polynomial
+	rem := 0
+	for _, bit := range bits {
+		rem <<= 1
+		if bit {
+			rem |= 1
+		}
+		if rem&(1<<plesseyCRCBits) != 0 {
+			rem ^= plesseyPolynomial
+		}
+	}
+	if rem != 0 {
+		t.Errorf("Unexpected remainder %x", rem)
+	}
+}
+
+func TestPlesseyWidths(t *testing.T) {
+	code, _ := PlesseyFromString("1")
+	widths := code.widths()
+	n, w := narrowBarSize, 3*narrowBarSize
+	expected := []int{
+		w, n, w, n, n, w, w, n, // start
+		w, n, n, w, n, w, n, w, // 1
+	}
+	if !equalCodewords(widths[:len(expected)], expected) {
+		t.Errorf("Unexpected widths %v", widths)
+	}
+	if len(widths) != 8*(2+plesseyCRCBits/4)+len(plesseyStop) {
+		t.Errorf("Unexpected number of widths %d", len(widths))
+	}
+}
+
+func TestPlesseyInvalid(t *testing.T) {
+	if _, err := PlesseyFromString("12G"); err == nil {
+		t.Errorf("Unexpected valid text")
+	}
+}
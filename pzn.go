@@ -0,0 +1,85 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+)
+
+var (
+	errInvalidPZN         = errors.New("Invalid PZN")
+	errInvalidPZNChecksum = errors.New("Invalid PZN checksum")
+)
+
+// PZN is a Pharmazentralnummer, the German pharmaceutical product number, printed
+// as a Code 39 symbol.
+type PZN struct {
+	// Digits including the check digit
+	code string
+}
+
+// PZNFromString returns the PZN of a 7 digit PZN7 or 8 digit PZN8, including the
+// check digit.
+func PZNFromString(code string) (PZN, error) {
+	if (len(code) != 7 && len(code) != 8) || !allDigits(code) {
+		return PZN{}, errInvalidPZN
+	}
+	checksum, ok := computePZNChecksum(code[:len(code)-1])
+	if !ok {
+		return PZN{}, errInvalidPZN
+	}
+	if code[len(code)-1] != checksum {
+		return PZN{}, errInvalidPZNChecksum
+	}
+	return PZN{code: code}, nil
+}
+
+// computePZNChecksum returns the modulo 11 check digit of the digits, weighted from
+// 2 for a PZN7 and from 1 for a PZN8. The numbers whose remainder is 10 are not
+// valid.
+func computePZNChecksum(digits string) (byte, bool) {
+	weight := 1
+	if len(digits) == 6 {
+		weight = 2
+	}
+	sum := 0
+	for i := range digits {
+		sum += int(digits[i]-'0') * (weight + i)
+	}
+	if sum%11 == 10 {
+		return 0, false
+	}
+	return byte('0' + sum%11), true
+}
+
+// String returns the digits of the PZN.
+func (p PZN) String() string {
+	return p.code
+}
+
+// Code39 returns the Code 39 symbol of the PZN, which encodes a - followed by the
+// digits and reads "PZN - " followed by the digits.
+func (p PZN) Code39() Code39 {
+	return code39FromData("-"+p.code, "PZN - "+p.code)
+}
+
+func (p PZN) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := p.Code39().symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
+
+func (p PZN) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := p.Code39().symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderLinear(s, r)
+	return nil
+}
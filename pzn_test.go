@@ -0,0 +1,37 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestPZNFromString(t *testing.T) {
+	for _, code := range []string{"1234562", "12345678"} {
+		p, err := PZNFromString(code)
+		if err != nil {
+			t.Errorf("Unexpected error %v for %s", err, code)
+			continue
+		}
+		c := p.Code39()
+		if c.data != "-"+code || c.String() != "PZN - "+code {
+			t.Errorf("Unexpected Code 39 %q %q", c.data, c.String())
+		}
+	}
+}
+
+func TestPZNInvalid(t *testing.T) {
+	for _, test := range []struct {
+		code string
+		err  error
+	}{
+		{"123456", errInvalidPZN},
+		{"123456a", errInvalidPZN},
+		{"1234563", errInvalidPZNChecksum},
+		{"12345679", errInvalidPZNChecksum},
+		// Remainder of 10
+		{"0000030", errInvalidPZN},
+	} {
+		if _, err := PZNFromString(test.code); err != test.err {
+			t.Errorf("Unexpected error %v for %s", err, test.code)
+		}
+	}
+}
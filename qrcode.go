@@ -0,0 +1,618 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"strings"
+	"unicode/utf8"
+)
+
+// QRLevel is the error correction level of a QR Code.
+type QRLevel int
+
+const (
+	// Recovers 7% of the codewords
+	QRLevelL QRLevel = iota
+	// Recovers 15% of the codewords
+	QRLevelM
+	// Recovers 25% of the codewords
+	QRLevelQ
+	// Recovers 30% of the codewords
+	QRLevelH
+)
+
+// qrECCCodewordsPerBlock gives the number of error correction codewords of each
+// block, indexed by level and version.
+var qrECCCodewordsPerBlock = [4][41]int{
+	{-1, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	{-1, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+// qrECCBlocks gives the number of error correction blocks, indexed by level and version.
+var qrECCBlocks = [4][41]int{
+	{-1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+	{-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+	{-1, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+	{-1, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+// qrLevelBits gives the level as encoded in the format information.
+var qrLevelBits = [4]int{1, 0, 3, 2}
+
+const (
+	qrMinVersion         = 1
+	qrMaxVersion         = 40
+	qrDefaultQuietZone   = 4
+	qrFormatGenerator    = 0x537
+	qrFormatMask         = 0x5412
+	qrVersionGenerator   = 0x1f25
+	qrTimingPosition     = 6
+	qrPadCodewords       = 0xec11
+	qrECIUTF8            = 26
+	qrECIModeIndicator   = 7
+	qrAlphanumericString = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+)
+
+type qrMode int
+
+// Segment modes, from the most to the least compact
+const (
+	qrNumeric qrMode = iota
+	qrAlphanumeric
+	qrByte
+	qrKanji
+)
+
+var qrModeIndicators = [4]int{1, 2, 4, 8}
+
+// qrCharCountBits gives the length of the character count of each mode for the
+// versions 1-9, 10-26 and 27-40.
+var qrCharCountBits = [4][3]int{
+	{10, 12, 14},
+	{9, 11, 13},
+	{8, 16, 16},
+	{8, 10, 12},
+}
+
+// qrKanjiCodes maps the characters of JIS X 0208 to their Shift JIS code.
+var qrKanjiCodes map[rune]int
+
+func init() {
+	data, err := _asset("shiftjis.bin")
+	if err != nil {
+		panic(err)
+	}
+	// Pairs of big endian 16 bit Shift JIS and Unicode code points
+	qrKanjiCodes = make(map[rune]int, len(data)/4)
+	for i := 0; i+4 <= len(data); i += 4 {
+		c := rune(binary.BigEndian.Uint16(data[i+2:]))
+		if _, ok := qrKanjiCodes[c]; !ok {
+			qrKanjiCodes[c] = int(binary.BigEndian.Uint16(data[i:]))
+		}
+	}
+}
+
+func qrVersionGroup(version int) int {
+	switch {
+	case version <= 9:
+		return 0
+	case version <= 26:
+		return 1
+	}
+	return 2
+}
+
+// qrSize returns the number of modules on each side of a version.
+func qrSize(version int) int {
+	return version*4 + 17
+}
+
+// qrAlignmentPositions returns the coordinates of the centers of the alignment
+// patterns on each axis.
+func qrAlignmentPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	n := version/7 + 2
+	step := 26
+	if version != 32 {
+		step = (version*4 + n*2 + 1) / (n*2 - 2) * 2
+	}
+	positions := make([]int, n)
+	positions[0] = qrTimingPosition
+	for i, pos := n-1, qrSize(version)-7; i >= 1; i, pos = i-1, pos-step {
+		positions[i] = pos
+	}
+	return positions
+}
+
+// qrTotalCodewords returns the number of data and error correction codewords.
+func qrTotalCodewords(version int) int {
+	modules := (16*version+128)*version + 64
+	if version >= 2 {
+		n := version/7 + 2
+		modules -= (25*n-10)*n - 55
+		if version >= 7 {
+			modules -= 36
+		}
+	}
+	return modules / 8
+}
+
+func qrDataCodewords(version int, level QRLevel) int {
+	return qrTotalCodewords(version) - qrECCCodewordsPerBlock[level][version]*qrECCBlocks[level][version]
+}
+
+// qrSegment is a run of characters encoded in the same mode.
+type qrSegment struct {
+	mode  qrMode
+	count int
+	data  bitWriter
+}
+
+// qrText holds the characters to encode. Byte mode uses ISO 8859-1, or UTF-8
+// announced with an ECI when some character is outside of it.
+type qrText struct {
+	runes []rune
+	utf8  bool
+}
+
+func newQRText(text string) qrText {
+	t := qrText{runes: []rune(text)}
+	for _, c := range t.runes {
+		if _, kanji := qrKanjiCodes[c]; c > 0xff && !kanji {
+			t.utf8 = true
+		}
+	}
+	return t
+}
+
+// byteLength returns the length of c in byte mode, or 0 if it cannot be encoded.
+func (t qrText) byteLength(c rune) int {
+	if t.utf8 {
+		return utf8.RuneLen(c)
+	}
+	if c > 0xff {
+		return 0
+	}
+	return 1
+}
+
+// qrCharCost returns the cost of c in the given mode in sixths of bits, or 0 if c
+// cannot be encoded in that mode.
+func (t qrText) charCost(mode qrMode, c rune) int {
+	switch mode {
+	case qrNumeric:
+		if c >= '0' && c <= '9' {
+			return 20
+		}
+	case qrAlphanumeric:
+		if strings.ContainsRune(qrAlphanumericString, c) {
+			return 33
+		}
+	case qrByte:
+		return t.byteLength(c) * 8 * 6
+	case qrKanji:
+		if _, ok := qrKanjiCodes[c]; ok {
+			return 78
+		}
+	}
+	return 0
+}
+
+// segments splits the text in the segments of minimal total length for the
+// versions of the given group, whose character counts have the same length.
+func (t qrText) segments(group int) []qrSegment {
+	var headBits [4]int
+	for m := range headBits {
+		headBits[m] = 4 + qrCharCountBits[m][group]
+	}
+	return t.optimalSegments(headBits)
+}
+
+// optimalSegments splits the text in the segments of minimal total length, given
+// the length of the mode indicator and character count of each mode, 0 for the
+// modes that are not available. It returns nil if some character cannot be
+// encoded.
+func (t qrText) optimalSegments(headBits [4]int) []qrSegment {
+	n := len(t.runes)
+	if n == 0 {
+		return nil
+	}
+	const infinity = 1 << 30
+	var headCosts [4]int
+	for m := range headCosts {
+		headCosts[m] = headBits[m] * 6
+		if headBits[m] == 0 {
+			headCosts[m] = infinity
+		}
+	}
+	// modes[i][m] is the mode of character i on the cheapest way to be in mode m
+	// after it, or -1 if there is none.
+	modes := make([][4]qrMode, n)
+	costs := headCosts
+	for i, c := range t.runes {
+		var next [4]int
+		for m := range next {
+			next[m] = infinity
+			modes[i][m] = -1
+			if cost := t.charCost(qrMode(m), c); cost > 0 && costs[m] < infinity {
+				next[m] = costs[m] + cost
+				modes[i][m] = qrMode(m)
+			}
+		}
+		// Switching mode after the character rounds up to a whole bit
+		for to := range next {
+			if headCosts[to] == infinity {
+				continue
+			}
+			for from := range next {
+				if modes[i][from] < 0 || next[from] == infinity {
+					continue
+				}
+				if cost := (next[from]+5)/6*6 + headCosts[to]; cost < next[to] {
+					next[to] = cost
+					modes[i][to] = qrMode(from)
+				}
+			}
+		}
+		costs = next
+	}
+	mode := qrNumeric
+	for m := range costs {
+		if costs[m] < costs[mode] {
+			mode = qrMode(m)
+		}
+	}
+	if costs[mode] == infinity {
+		return nil
+	}
+	charModes := make([]qrMode, n)
+	for i := n - 1; i >= 0; i-- {
+		mode = modes[i][mode]
+		charModes[i] = mode
+	}
+	var segments []qrSegment
+	for start := 0; start < n; {
+		end := start
+		for end < n && charModes[end] == charModes[start] {
+			end++
+		}
+		segments = append(segments, t.encodeSegment(charModes[start], t.runes[start:end]))
+		start = end
+	}
+	return segments
+}
+
+func (t qrText) encodeSegment(mode qrMode, runes []rune) qrSegment {
+	s := qrSegment{mode: mode, count: len(runes)}
+	switch mode {
+	case qrNumeric:
+		for i := 0; i < len(runes); i += 3 {
+			n := len(runes) - i
+			if n > 3 {
+				n = 3
+			}
+			v := 0
+			for _, c := range runes[i : i+n] {
+				v = v*10 + int(c-'0')
+			}
+			s.data.write(uint64(v), n*3+1)
+		}
+	case qrAlphanumeric:
+		for i := 0; i < len(runes); i += 2 {
+			v := strings.IndexRune(qrAlphanumericString, runes[i])
+			if i+1 < len(runes) {
+				v = v*45 + strings.IndexRune(qrAlphanumericString, runes[i+1])
+				s.data.write(uint64(v), 11)
+			} else {
+				s.data.write(uint64(v), 6)
+			}
+		}
+	case qrByte:
+		var bytes []byte
+		for _, c := range runes {
+			if t.utf8 {
+				bytes = append(bytes, string(c)...)
+			} else {
+				bytes = append(bytes, byte(c))
+			}
+		}
+		s.count = len(bytes)
+		for _, b := range bytes {
+			s.data.write(uint64(b), 8)
+		}
+	case qrKanji:
+		for _, c := range runes {
+			v := qrKanjiCodes[c]
+			if v < 0xe040 {
+				v -= 0x8140
+			} else {
+				v -= 0xc140
+			}
+			s.data.write(uint64(v>>8*0xc0+v&0xff), 13)
+		}
+	}
+	return s
+}
+
+// qrSegmentBits returns the number of bits of the segments, or -1 if a character
+// count overflows in the given group of versions.
+func qrSegmentBits(segments []qrSegment, group int, utf8 bool) int {
+	bits := 0
+	if utf8 {
+		bits += 4 + 8
+	}
+	for _, s := range segments {
+		countBits := qrCharCountBits[s.mode][group]
+		if s.count >= 1<<uint(countBits) {
+			return -1
+		}
+		bits += 4 + countBits + len(s.data.bits)
+	}
+	return bits
+}
+
+var (
+	errQRTooLong      = errors.New("Data too long for a QR Code")
+	errInvalidQRLevel = errors.New("Invalid QR Code error correction level")
+)
+
+// encodeQRData returns the smallest version able to hold the text at the given
+// level, and the data codewords padded to its capacity.
+func encodeQRData(text string, level QRLevel) (int, []int, error) {
+	t := newQRText(text)
+	for version := qrMinVersion; version <= qrMaxVersion; version++ {
+		group := qrVersionGroup(version)
+		segments := t.segments(group)
+		bits := qrSegmentBits(segments, group, t.utf8)
+		capacity := qrDataCodewords(version, level) * 8
+		if bits < 0 || bits > capacity {
+			continue
+		}
+		var w bitWriter
+		if t.utf8 {
+			w.write(qrECIModeIndicator, 4)
+			w.write(qrECIUTF8, 8)
+		}
+		for _, s := range segments {
+			w.write(uint64(qrModeIndicators[s.mode]), 4)
+			w.write(uint64(s.count), qrCharCountBits[s.mode][group])
+			w.bits = append(w.bits, s.data.bits...)
+		}
+		return version, padQRData(w, capacity), nil
+	}
+	return 0, nil, errQRTooLong
+}
+
+// padQRData terminates the bit stream and fills the capacity with the pad codewords.
+func padQRData(w bitWriter, capacity int) []int {
+	terminator := capacity - len(w.bits)
+	if terminator > 4 {
+		terminator = 4
+	}
+	w.write(0, terminator)
+	w.write(0, (8-len(w.bits)%8)%8)
+	for i := 0; len(w.bits) < capacity; i++ {
+		w.write(uint64(qrPadCodewords>>uint(8-i%2*8)&0xff), 8)
+	}
+	codewords := make([]int, len(w.bits)/8)
+	r := bitReader{w.bits}
+	for i := range codewords {
+		v, _ := r.read(8)
+		codewords[i] = int(v)
+	}
+	return codewords
+}
+
+var qrField = newGaloisField(256, 0x11d)
+
+// qrInterleave splits the data in blocks, computes their error correction codewords
+// and interleaves them. The last blocks hold one more data codeword.
+func qrInterleave(data []int, blocks, eccPerBlock int) []int {
+	total := len(data) + blocks*eccPerBlock
+	shortBlocks := blocks - total%blocks
+	shortLength := total/blocks - eccPerBlock
+	var dataBlocks, eccBlocks [][]int
+	for i, k := 0, 0; i < blocks; i++ {
+		length := shortLength
+		if i >= shortBlocks {
+			length++
+		}
+		dataBlocks = append(dataBlocks, data[k:k+length])
+		eccBlocks = append(eccBlocks, qrField.rsEncode(data[k:k+length], eccPerBlock, 0))
+		k += length
+	}
+	result := make([]int, 0, total)
+	for i := 0; i <= shortLength; i++ {
+		for _, b := range dataBlocks {
+			if i < len(b) {
+				result = append(result, b[i])
+			}
+		}
+	}
+	for i := 0; i < eccPerBlock; i++ {
+		for _, b := range eccBlocks {
+			result = append(result, b[i])
+		}
+	}
+	return result
+}
+
+func codewordBits(codewords []int, size int) []bool {
+	var w bitWriter
+	for _, c := range codewords {
+		w.write(uint64(c), size)
+	}
+	return w.bits
+}
+
+// drawQRFunctionPatterns draws the finder, alignment and timing patterns and
+// reserves the format and version information.
+func drawQRFunctionPatterns(m *qrMatrix, version int) {
+	size := m.width
+	for i := 0; i < size; i++ {
+		m.set(qrTimingPosition, i, i%2 == 0)
+		m.set(i, qrTimingPosition, i%2 == 0)
+	}
+	m.drawFinder(3, 3)
+	m.drawFinder(size-4, 3)
+	m.drawFinder(3, size-4)
+	positions := qrAlignmentPositions(version)
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			// Skip the corners of the finder patterns
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			m.drawAlignment(x, y)
+		}
+	}
+	drawQRFormat(m, 0)
+	drawQRVersion(m, version)
+}
+
+// qrFormatBits returns the format information of a level and mask.
+func qrFormatBits(level QRLevel, mask int) int {
+	return bchCode(qrLevelBits[level]<<3|mask, qrFormatGenerator, 10) ^ qrFormatMask
+}
+
+// drawQRFormat draws the two copies of the 15 bits of format information, with
+// the dark module next to the bottom left finder.
+func drawQRFormat(m *qrMatrix, bits int) {
+	size := m.width
+	bit := func(i int) bool {
+		return bits>>uint(i)&1 != 0
+	}
+	for i := 0; i < 6; i++ {
+		m.set(8, i, bit(i))
+	}
+	m.set(8, 7, bit(6))
+	m.set(8, 8, bit(7))
+	m.set(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		m.set(14-i, 8, bit(i))
+	}
+	for i := 0; i < 8; i++ {
+		m.set(size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		m.set(8, size-15+i, bit(i))
+	}
+	m.set(8, size-8, true)
+}
+
+// drawQRVersion draws the two copies of the version information of versions 7 and up.
+func drawQRVersion(m *qrMatrix, version int) {
+	if version < 7 {
+		return
+	}
+	bits := bchCode(version, qrVersionGenerator, 12)
+	for i := 0; i < 18; i++ {
+		dark := bits>>uint(i)&1 != 0
+		a, b := m.width-11+i%3, i/3
+		m.set(a, b, dark)
+		m.set(b, a, dark)
+	}
+}
+
+// QRCode is a QR Code symbol.
+type QRCode struct {
+	modules   [][]bool
+	version   int
+	level     QRLevel
+	mask      int
+	quietZone int
+}
+
+// QRCodeFromString encodes text in the smallest version for the level, splitting
+// it in numeric, alphanumeric, byte and Kanji segments to minimize its length.
+func QRCodeFromString(text string, level QRLevel) (QRCode, error) {
+	if level < QRLevelL || level > QRLevelH {
+		return QRCode{}, errInvalidQRLevel
+	}
+	version, data, err := encodeQRData(text, level)
+	if err != nil {
+		return QRCode{}, err
+	}
+	codewords := qrInterleave(data, qrECCBlocks[level][version], qrECCCodewordsPerBlock[level][version])
+	m := newQRMatrix(qrSize(version), qrSize(version))
+	drawQRFunctionPatterns(m, version)
+	m.placeData(codewordBits(codewords, 8), qrTimingPosition)
+	best, bestPenalty := 0, -1
+	for mask, f := range qrMasks {
+		m.applyMask(f)
+		drawQRFormat(m, qrFormatBits(level, mask))
+		if p := m.penalty(); bestPenalty < 0 || p < bestPenalty {
+			best, bestPenalty = mask, p
+		}
+		m.applyMask(f)
+	}
+	m.applyMask(qrMasks[best])
+	drawQRFormat(m, qrFormatBits(level, best))
+	return QRCode{
+		modules:   m.modules,
+		version:   version,
+		level:     level,
+		mask:      best,
+		quietZone: qrDefaultQuietZone,
+	}, nil
+}
+
+func (qr QRCode) Version() int {
+	return qr.version
+}
+
+func (qr QRCode) Level() QRLevel {
+	return qr.level
+}
+
+// Size returns the number of modules on each side, without the quiet zone.
+func (qr QRCode) Size() int {
+	return len(qr.modules)
+}
+
+// Module returns whether the module at column x and row y is dark.
+func (qr QRCode) Module(x, y int) bool {
+	return qr.modules[y][x]
+}
+
+// WithQuietZone returns a copy with a quiet zone of the given number of modules
+// on each side, 4 by default.
+func (qr QRCode) WithQuietZone(modules int) QRCode {
+	qr.quietZone = modules
+	return qr
+}
+
+func (qr QRCode) symbol() matrixSymbol {
+	return matrixSymbol{
+		modules:   qr.modules,
+		quietZone: qr.quietZone,
+	}
+}
+
+func (qr QRCode) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := qr.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
+
+func (qr QRCode) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := qr.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderMatrix(s, r)
+	return nil
+}
@@ -0,0 +1,214 @@
+package barcode
+
+// qrMatrix is the module grid of a QR Code or one of its variants, keeping track
+// of the function patterns which are excluded from the data and the masking.
+type qrMatrix struct {
+	width, height int
+	modules       [][]bool
+	function      [][]bool
+}
+
+func newQRMatrix(width, height int) *qrMatrix {
+	m := &qrMatrix{
+		width:    width,
+		height:   height,
+		modules:  make([][]bool, height),
+		function: make([][]bool, height),
+	}
+	for y := range m.modules {
+		m.modules[y] = make([]bool, width)
+		m.function[y] = make([]bool, width)
+	}
+	return m
+}
+
+// set draws a function module at column x and row y, ignoring the modules outside
+// of the symbol.
+func (m *qrMatrix) set(x, y int, dark bool) {
+	if x < 0 || y < 0 || x >= m.width || y >= m.height {
+		return
+	}
+	m.modules[y][x] = dark
+	m.function[y][x] = true
+}
+
+// drawFinder draws a finder pattern centered at cx, cy with its light separator.
+func (m *qrMatrix) drawFinder(cx, cy int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			d := maxInt(absInt(dx), absInt(dy))
+			m.set(cx+dx, cy+dy, d != 2 && d != 4)
+		}
+	}
+}
+
+// drawAlignment draws an alignment pattern centered at cx, cy.
+func (m *qrMatrix) drawAlignment(cx, cy int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			m.set(cx+dx, cy+dy, maxInt(absInt(dx), absInt(dy)) != 1)
+		}
+	}
+}
+
+// placeData fills the modules not used by function patterns with bits, in pairs of
+// columns from right to left, alternately upwards and downwards. The column
+// timingColumn, if any, is skipped.
+func (m *qrMatrix) placeData(bits []bool, timingColumn int) {
+	i := 0
+	upward := true
+	for right := m.width - 1; right >= 1; right -= 2 {
+		if right == timingColumn {
+			right--
+		}
+		for v := 0; v < m.height; v++ {
+			y := v
+			if upward {
+				y = m.height - 1 - v
+			}
+			for x := right; x > right-2; x-- {
+				if m.function[y][x] {
+					continue
+				}
+				// Remainder bits are light
+				m.modules[y][x] = i < len(bits) && bits[i]
+				i++
+			}
+		}
+		upward = !upward
+	}
+}
+
+// applyMask inverts the data modules for which mask returns true. Applying the
+// same mask again removes it.
+func (m *qrMatrix) applyMask(mask func(x, y int) bool) {
+	for y := 0; y < m.height; y++ {
+		for x := 0; x < m.width; x++ {
+			if !m.function[y][x] && mask(x, y) {
+				m.modules[y][x] = !m.modules[y][x]
+			}
+		}
+	}
+}
+
+// qrMasks are the 8 data mask patterns of QR Code, of column x and row y.
+var qrMasks = [8]func(x, y int) bool{
+	func(x, y int) bool { return (x+y)%2 == 0 },
+	func(x, y int) bool { return y%2 == 0 },
+	func(x, y int) bool { return x%3 == 0 },
+	func(x, y int) bool { return (x+y)%3 == 0 },
+	func(x, y int) bool { return (y/2+x/3)%2 == 0 },
+	func(x, y int) bool { return x*y%2+x*y%3 == 0 },
+	func(x, y int) bool { return (x*y%2+x*y%3)%2 == 0 },
+	func(x, y int) bool { return ((x+y)%2+x*y%3)%2 == 0 },
+}
+
+// Penalty weights of the mask evaluation
+const (
+	qrPenaltyRun     = 3
+	qrPenaltyBlock   = 3
+	qrPenaltyFinder  = 40
+	qrPenaltyBalance = 10
+)
+
+// qrFinderLike are the 1:1:3:1:1 patterns with 4 light modules on either side.
+var qrFinderLike = [2][]bool{
+	{true, false, true, true, true, false, true, false, false, false, false},
+	{false, false, false, false, true, false, true, true, true, false, true},
+}
+
+// penaltyLine evaluates the runs and the finder-like patterns of a row or column.
+// The modules beyond the ends are light, as in the quiet zone.
+func penaltyLine(line []bool) int {
+	penalty := 0
+	for i := 0; i < len(line); {
+		j := i
+		for j < len(line) && line[j] == line[i] {
+			j++
+		}
+		if j-i >= 5 {
+			penalty += qrPenaltyRun + j - i - 5
+		}
+		i = j
+	}
+	at := func(i int) bool {
+		return i >= 0 && i < len(line) && line[i]
+	}
+	for start := -4; start < len(line); start++ {
+		for _, pattern := range qrFinderLike {
+			match := true
+			for k, dark := range pattern {
+				if at(start+k) != dark {
+					match = false
+					break
+				}
+			}
+			if match {
+				penalty += qrPenaltyFinder
+			}
+		}
+	}
+	return penalty
+}
+
+// penalty evaluates the masked symbol; the mask with the lowest penalty is used.
+func (m *qrMatrix) penalty() int {
+	penalty := 0
+	column := make([]bool, m.height)
+	for x := 0; x < m.width; x++ {
+		for y := 0; y < m.height; y++ {
+			column[y] = m.modules[y][x]
+		}
+		penalty += penaltyLine(column)
+	}
+	dark := 0
+	for y, row := range m.modules {
+		penalty += penaltyLine(row)
+		for x, d := range row {
+			if d {
+				dark++
+			}
+			if x > 0 && y > 0 && d == row[x-1] && d == m.modules[y-1][x] && d == m.modules[y-1][x-1] {
+				penalty += qrPenaltyBlock
+			}
+		}
+	}
+	// Each 5% of deviation from half dark modules
+	total := m.width * m.height
+	k := (absInt(dark*20-total*10)+total-1)/total - 1
+	return penalty + k*qrPenaltyBalance
+}
+
+// bchCode appends to data the remainder of its division by the generator
+// polynomial of the given degree, as used by the format and version information.
+func bchCode(data, generator, degree int) int {
+	rem := data << uint(degree)
+	for i := bitLength(rem) - 1; i >= degree; i-- {
+		if rem&(1<<uint(i)) != 0 {
+			rem ^= generator << uint(i-degree)
+		}
+	}
+	return data<<uint(degree) | rem
+}
+
+func bitLength(x int) int {
+	n := 0
+	for ; x > 0; x >>= 1 {
+		n++
+	}
+	return n
+}
+
+func absInt(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,166 @@
+package barcode
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestQRCapacity(t *testing.T) {
+	data := []struct {
+		version int
+		level   QRLevel
+		data    int
+	}{
+		{1, QRLevelL, 19},
+		{1, QRLevelH, 9},
+		{5, QRLevelQ, 62},
+		{10, QRLevelM, 216},
+		{40, QRLevelL, 2956},
+		{40, QRLevelH, 1276},
+	}
+	for _, d := range data {
+		if n := qrDataCodewords(d.version, d.level); n != d.data {
+			t.Errorf("Unexpected capacity of %d-%d: %d v.s. %d", d.version, d.level, d.data, n)
+		}
+	}
+}
+
+func TestQRAlignmentPositions(t *testing.T) {
+	data := map[int][]int{
+		2:  {6, 18},
+		7:  {6, 22, 38},
+		32: {6, 34, 60, 86, 112, 138},
+		40: {6, 30, 58, 86, 114, 142, 170},
+	}
+	for version, expected := range data {
+		positions := qrAlignmentPositions(version)
+		if len(positions) != len(expected) {
+			t.Fatalf("Unexpected positions of version %d: %v", version, positions)
+		}
+		for i := range expected {
+			if positions[i] != expected[i] {
+				t.Errorf("Unexpected positions of version %d: %v", version, positions)
+			}
+		}
+	}
+}
+
+func TestQRFormatBits(t *testing.T) {
+	data := []struct {
+		level    QRLevel
+		mask     int
+		expected int
+	}{
+		{QRLevelL, 0, 0x77c4},
+		{QRLevelL, 4, 0x662f},
+		{QRLevelM, 0, 0x5412},
+		{QRLevelQ, 0, 0x355f},
+		{QRLevelH, 0, 0x1689},
+	}
+	for _, d := range data {
+		if bits := qrFormatBits(d.level, d.mask); bits != d.expected {
+			t.Errorf("Unexpected format of %d/%d: %015b v.s. %015b", d.level, d.mask, d.expected, bits)
+		}
+	}
+	if bits := bchCode(7, qrVersionGenerator, 12); bits != 0x07c94 {
+		t.Errorf("Unexpected version information %018b", bits)
+	}
+}
+
+func TestEncodeQRData(t *testing.T) {
+	data := []struct {
+		text     string
+		level    QRLevel
+		expected []int
+	}{
+		{"01234567", QRLevelM, []int{0x10, 0x20, 0x0c, 0x56, 0x61, 0x80, 0xec, 0x11, 0xec, 0x11, 0xec, 0x11, 0xec, 0x11, 0xec, 0x11}},
+		{"HELLO WORLD", QRLevelQ, []int{0x20, 0x5b, 0x0b, 0x78, 0xd1, 0x72, 0xdc, 0x4d, 0x43, 0x40, 0xec, 0x11, 0xec}},
+	}
+	for _, d := range data {
+		version, codewords, err := encodeQRData(d.text, d.level)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version != 1 || len(codewords) != len(d.expected) {
+			t.Fatalf("Unexpected codewords of %q in version %d: %x", d.text, version, codewords)
+		}
+		for i := range d.expected {
+			if codewords[i] != d.expected[i] {
+				t.Fatalf("Unexpected codewords of %q: %x", d.text, codewords)
+			}
+		}
+	}
+}
+
+func TestQRSegments(t *testing.T) {
+	data := []struct {
+		text  string
+		modes []qrMode
+	}{
+		{"123456789012", []qrMode{qrNumeric}},
+		{"HELLO WORLD", []qrMode{qrAlphanumeric}},
+		{"hello", []qrMode{qrByte}},
+		{"ABCDEF0123456789012345678", []qrMode{qrAlphanumeric, qrNumeric}},
+		{"a1", []qrMode{qrByte}},
+		{"点茗", []qrMode{qrKanji}},
+	}
+	for _, d := range data {
+		segments := newQRText(d.text).segments(0)
+		if len(segments) != len(d.modes) {
+			t.Fatalf("Unexpected segments of %q: %v", d.text, segments)
+		}
+		for i, s := range segments {
+			if s.mode != d.modes[i] {
+				t.Errorf("Unexpected mode of segment %d of %q: %d", i, d.text, s.mode)
+			}
+		}
+	}
+	// Kanji values from the examples of ISO/IEC 18004
+	s := newQRText("点茗").segments(0)[0]
+	var r bitReader
+	r.bits = s.data.bits
+	if v, _ := r.read(13); v != 0x0d9f {
+		t.Errorf("Unexpected Kanji value %x", v)
+	}
+	if v, _ := r.read(13); v != 0x1aaa {
+		t.Errorf("Unexpected Kanji value %x", v)
+	}
+}
+
+func TestQRCode(t *testing.T) {
+	qr, err := QRCodeFromString("https://id.gs1.org/01/05901234123457", QRLevelM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version() != 3 || qr.Size() != 29 {
+		t.Errorf("Unexpected version %d of size %d", qr.Version(), qr.Size())
+	}
+	// Finder pattern in the top left corner, with its separator
+	for i := 0; i < 8; i++ {
+		if qr.Module(i, 0) != (i < 7) || qr.Module(0, i) != (i < 7) {
+			t.Errorf("Unexpected finder pattern at %d", i)
+		}
+	}
+	// Format information read back from around the top left finder
+	format := 0
+	for i := 0; i < 6; i++ {
+		if qr.Module(8, i) {
+			format |= 1 << uint(i)
+		}
+	}
+	if format != qrFormatBits(QRLevelM, qr.mask)&0x3f {
+		t.Errorf("Unexpected format %06b", format)
+	}
+	if s := qr.WithQuietZone(2).symbol().layout(); s.width != 33 || s.height != 33 {
+		t.Errorf("Unexpected layout %v", s)
+	}
+	if _, err := QRCodeFromString(strings.Repeat("9", 7090), QRLevelL); err != errQRTooLong {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if qr, err := QRCodeFromString(strings.Repeat("9", 7089), QRLevelL); err != nil || qr.Version() != 40 {
+		t.Errorf("Unexpected version %d: %v", qr.Version(), err)
+	}
+	if qr, _ := QRCodeFromString("Grüße, Привет", QRLevelL); qr.Version() != 2 {
+		t.Errorf("Unexpected version %d", qr.Version())
+	}
+}
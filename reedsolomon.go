@@ -0,0 +1,69 @@
+package barcode
+
+// galoisField is the field GF(2^m) used by the Reed-Solomon codes of the matrix
+// symbols, defined by its primitive polynomial.
+type galoisField struct {
+	size int
+	exp  []int
+	log  []int
+}
+
+func newGaloisField(size, primitive int) *galoisField {
+	f := &galoisField{
+		size: size,
+		exp:  make([]int, 2*size),
+		log:  make([]int, size),
+	}
+	x := 1
+	for i := 0; i < size-1; i++ {
+		f.exp[i] = x
+		f.log[x] = i
+		x <<= 1
+		if x >= size {
+			x ^= primitive
+		}
+	}
+	// Extend the table to avoid the modulo in mul
+	for i := size - 1; i < 2*size; i++ {
+		f.exp[i] = f.exp[i-(size-1)]
+	}
+	return f
+}
+
+func (f *galoisField) mul(a, b int) int {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return f.exp[f.log[a]+f.log[b]]
+}
+
+// generator returns the coefficients of the generator polynomial of degree n with
+// the roots a^base to a^(base+n-1), highest degree first, without the leading 1.
+func (f *galoisField) generator(n, base int) []int {
+	g := []int{1}
+	for i := 0; i < n; i++ {
+		root := f.exp[(base+i)%(f.size-1)]
+		next := make([]int, len(g)+1)
+		for j, c := range g {
+			next[j] ^= c
+			next[j+1] ^= f.mul(c, root)
+		}
+		g = next
+	}
+	return g[1:]
+}
+
+// rsEncode returns the n error correction symbols of data.
+func (f *galoisField) rsEncode(data []int, n, base int) []int {
+	g := f.generator(n, base)
+	ecc := make([]int, n)
+	for _, d := range data {
+		factor := d ^ ecc[0]
+		copy(ecc, ecc[1:])
+		ecc[n-1] = 0
+		for i, c := range g {
+			ecc[i] ^= f.mul(c, factor)
+		}
+	}
+	return ecc
+}
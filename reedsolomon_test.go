@@ -0,0 +1,26 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestGaloisField(t *testing.T) {
+	f := newGaloisField(256, 0x11d)
+	for a := 1; a < 256; a++ {
+		inverse := f.exp[255-f.log[a]]
+		if f.mul(a, inverse) != 1 {
+			t.Fatalf("Unexpected inverse %d of %d", inverse, a)
+		}
+	}
+}
+
+func TestRSEncode(t *testing.T) {
+	data := []int{0x10, 0x20, 0x0c, 0x56, 0x61, 0x80, 0xec, 0x11, 0xec, 0x11, 0xec, 0x11, 0xec, 0x11, 0xec, 0x11}
+	expected := []int{0xa5, 0x24, 0xd4, 0xc1, 0xed, 0x36, 0xc7, 0x87, 0x2c, 0x55}
+	ecc := newGaloisField(256, 0x11d).rsEncode(data, len(expected), 0)
+	for i := range expected {
+		if ecc[i] != expected[i] {
+			t.Fatalf("Unexpected error correction %x v.s. %x", expected, ecc)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"image"
+	"image/draw"
+	"strings"
+)
+
+// rm4sccChars lists the characters of RM4SCC and KIX by value, which is 6 times
+// the row plus the column of the character in the table of the symbology.
+const rm4sccChars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// rm4sccHalves gives which 2 of the 4 bars of a character have an ascender for
+// each row, or a descender for each column, from the most significant bit.
+var rm4sccHalves = [6]int{0x3, 0x5, 0x6, 0x9, 0xa, 0xc}
+
+// Quiet zone of RM4SCC and KIX in modules
+const rm4sccQuietZone = 4
+
+var (
+	errInvalidRM4SCC = errors.New("Character cannot be encoded in RM4SCC")
+	errInvalidKIX    = errors.New("Character cannot be encoded in KIX")
+)
+
+// appendRM4SCCChar appends the 4 bars of the character of value v.
+func appendRM4SCCChar(bars []fourStateBar, v int) []fourStateBar {
+	ascenders, descenders := rm4sccHalves[v/6], rm4sccHalves[v%6]
+	for i := 3; i >= 0; i-- {
+		var bar fourStateBar
+		if ascenders>>uint(i)&1 != 0 {
+			bar |= barAscender
+		}
+		if descenders>>uint(i)&1 != 0 {
+			bar |= barDescender
+		}
+		bars = append(bars, bar)
+	}
+	return bars
+}
+
+// RM4SCC is a Royal Mail 4-State Customer Code symbol. Royal Mail Mailmark, whose
+// barcodes C and L use their own symbol tables and Reed-Solomon check, is not
+// available.
+type RM4SCC struct {
+	data string
+}
+
+// RM4SCCFromString encodes a postcode and delivery point suffix made of digits
+// and upper case letters, such as "SN34RD1A".
+func RM4SCCFromString(text string) (RM4SCC, error) {
+	for _, c := range text {
+		if !strings.ContainsRune(rm4sccChars, c) {
+			return RM4SCC{}, errInvalidRM4SCC
+		}
+	}
+	return RM4SCC{data: text}, nil
+}
+
+// String returns the encoded text, without the check character.
+func (code RM4SCC) String() string {
+	return code.data
+}
+
+// checksum returns the check character, whose row and column are the sums modulo 6
+// of the rows and columns of the characters.
+func (code RM4SCC) checksum() byte {
+	rows, columns := 0, 0
+	for i := range code.data {
+		v := strings.IndexByte(rm4sccChars, code.data[i])
+		rows += v/6 + 1
+		columns += v%6 + 1
+	}
+	// The rows and columns are numbered from 1 to 6
+	return rm4sccChars[(rows+5)%6*6+(columns+5)%6]
+}
+
+func (code RM4SCC) bars() []fourStateBar {
+	// Start bar
+	bars := []fourStateBar{barAscender}
+	for _, c := range code.data + string(code.checksum()) {
+		bars = appendRM4SCCChar(bars, strings.IndexRune(rm4sccChars, c))
+	}
+	// Stop bar
+	return append(bars, barFull)
+}
+
+func (code RM4SCC) symbol() fourStateSymbol {
+	return fourStateSymbol{bars: code.bars(), quietZone: rm4sccQuietZone}
+}
+
+func (code RM4SCC) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := code.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderFourState(s, r)
+	return nil
+}
+
+func (code RM4SCC) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := code.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderFourState(s, r)
+	return nil
+}
+
+// KIX is a Dutch KIX (Klantindex) symbol, which uses the characters of RM4SCC
+// without start and stop bars nor check character.
+type KIX struct {
+	data string
+}
+
+// KIXFromString encodes a postcode, house number and suffix made of digits and
+// upper case letters, such as "2500GG30250".
+func KIXFromString(text string) (KIX, error) {
+	for _, c := range text {
+		if !strings.ContainsRune(rm4sccChars, c) {
+			return KIX{}, errInvalidKIX
+		}
+	}
+	return KIX{data: text}, nil
+}
+
+// String returns the encoded text.
+func (code KIX) String() string {
+	return code.data
+}
+
+func (code KIX) bars() []fourStateBar {
+	var bars []fourStateBar
+	for _, c := range code.data {
+		bars = appendRM4SCCChar(bars, strings.IndexRune(rm4sccChars, c))
+	}
+	return bars
+}
+
+func (code KIX) symbol() fourStateSymbol {
+	return fourStateSymbol{bars: code.bars(), quietZone: rm4sccQuietZone}
+}
+
+func (code KIX) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	s := code.symbol()
+	r, err := newBitmapRenderer(img, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderFourState(s, r)
+	return nil
+}
+
+func (code KIX) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	s := code.symbol()
+	r, err := newPdfRenderer(canvas, bound, padding, s.layout())
+	if err != nil {
+		return err
+	}
+	renderFourState(s, r)
+	return nil
+}
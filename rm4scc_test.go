@@ -0,0 +1,44 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestRM4SCCChars(t *testing.T) {
+	for _, test := range []struct {
+		c        byte
+		expected string
+	}{
+		{'0', "TTFF"},
+		{'1', "TDAF"},
+		{'A', "DADA"},
+		{'Z', "FFTT"},
+	} {
+		code, _ := KIXFromString(string(test.c))
+		if s := fourStateString(code.bars()); s != test.expected {
+			t.Errorf("Unexpected bars %s of %c", s, test.c)
+		}
+	}
+}
+
+func TestRM4SCCChecksum(t *testing.T) {
+	code, _ := RM4SCCFromString("SN34RD1A")
+	if c := code.checksum(); c != 'K' {
+		t.Errorf("Unexpected check character %c", c)
+	}
+	bars := fourStateString(code.bars())
+	if len(bars) != 2+4*9 || bars[0] != 'A' || bars[len(bars)-1] != 'F' {
+		t.Errorf("Unexpected bars %s", bars)
+	}
+}
+
+func TestRM4SCCInvalid(t *testing.T) {
+	for _, text := range []string{"sn34rd1a", "SN3 4RD"} {
+		if _, err := RM4SCCFromString(text); err == nil {
+			t.Errorf("Unexpected valid text %q", text)
+		}
+		if _, err := KIXFromString(text); err == nil {
+			t.Errorf("Unexpected valid text %q", text)
+		}
+	}
+}
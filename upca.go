@@ -0,0 +1,147 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"strconv"
+)
+
+// UPCA is a 12 digit UPC-A code. It is a subset of EAN-13 with a leading zero.
+type UPCA struct {
+	code12 uint64
+	addon  Addon
+}
+
+func (upc UPCA) Code12() uint64 {
+	return upc.code12
+}
+
+func (upc UPCA) Code11() uint64 {
+	return upc.code12 / 10
+}
+
+func (upc UPCA) Checksum() uint8 {
+	return uint8(upc.code12 % 10)
+}
+
+// NumberSystem returns the leading digit of the code.
+func (upc UPCA) NumberSystem() uint8 {
+	return uint8(upc.code12 / 100000000000)
+}
+
+func (upc UPCA) String() string {
+	return fmt.Sprintf("%012d", upc.code12)
+}
+
+// Addon returns the supplemental code printed on the right of the symbol.
+func (upc UPCA) Addon() Addon {
+	return upc.addon
+}
+
+// WithAddon returns a copy of the code carrying the supplemental code addon.
+func (upc UPCA) WithAddon(addon Addon) UPCA {
+	upc.addon = addon
+	return upc
+}
+
+// EAN13 returns the equivalent EAN-13 code, i.e. the code with a leading zero.
+func (upc UPCA) EAN13() EAN13 {
+	return EAN13{
+		code13: upc.code12,
+		addon:  upc.addon,
+	}
+}
+
+func (upc UPCA) style() code13Style {
+	return code13Style{upca: true, addon: upc.addon}
+}
+
+func (upc UPCA) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	r, err := newBitmapRenderer(img, bound, padding, upc.style().layout())
+	if err != nil {
+		return err
+	}
+	renderCode13(upc.code12, upc.style(), r)
+	return nil
+}
+
+func (upc UPCA) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	r, err := newPdfRenderer(canvas, bound, padding, upc.style().layout())
+	if err != nil {
+		return err
+	}
+	renderCode13(upc.code12, upc.style(), r)
+	return nil
+}
+
+var errNotUPCA = errors.New("EAN code does not start with 0")
+
+// UPCAFromEAN13 converts an EAN-13 code with a leading zero to UPC-A.
+func UPCAFromEAN13(ean EAN13) (UPCA, error) {
+	if ean.code13 > 999999999999 {
+		return UPCA{}, errNotUPCA
+	}
+	return UPCA{
+		code12: ean.code13,
+		addon:  ean.addon,
+	}, nil
+}
+
+func UPCAFromCode11(code11 uint64) (UPCA, error) {
+	if code11 > 99999999999 {
+		return UPCA{}, errInvalidEAN
+	}
+	checksum := computeEANChecksum(code11)
+	return UPCA{
+		code12: code11*10 + checksum,
+	}, nil
+}
+
+func UPCAFromCode12(code12 uint64) (UPCA, error) {
+	if code12 > 999999999999 {
+		return UPCA{}, errInvalidEAN
+	}
+	checksum := computeEANChecksum(code12 / 10)
+	if code12%10 != checksum {
+		return UPCA{}, errInvalidEANChecksum
+	}
+	return UPCA{
+		code12: code12,
+	}, nil
+}
+
+func UPCAFromString11(code string) (UPCA, error) {
+	if len(code) != 11 || !allDigits(code) {
+		return UPCA{}, errInvalidEAN
+	}
+	code11, err := strconv.ParseUint(code, 10, 64)
+	if err != nil {
+		return UPCA{}, err
+	}
+	return UPCAFromCode11(code11)
+}
+
+func UPCAFromString12(code string) (UPCA, error) {
+	if len(code) != 12 || !allDigits(code) {
+		return UPCA{}, errInvalidEAN
+	}
+	code12, err := strconv.ParseUint(code, 10, 64)
+	if err != nil {
+		return UPCA{}, err
+	}
+	return UPCAFromCode12(code12)
+}
+
+func UPCAFromString(code string) (UPCA, error) {
+	switch len(code) {
+	case 11:
+		return UPCAFromString11(code)
+	case 12:
+		return UPCAFromString12(code)
+	default:
+		return UPCA{}, errInvalidEAN
+	}
+}
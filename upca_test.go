@@ -0,0 +1,59 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestUPCA(t *testing.T) {
+	type data struct {
+		code11   uint64
+		checksum uint8
+	}
+	var testdata = []data{
+		{3600029145, 2},
+		{4210000526, 4},
+		{7123456789, 8},
+		{81234567890, 1},
+	}
+	for _, d := range testdata {
+		upc, err := UPCAFromCode11(d.code11)
+		if err != nil {
+			t.Errorf("Invalid upc %d", d.code11)
+		}
+		if d.checksum != upc.Checksum() {
+			t.Errorf("Unexpected checksum %d v.s. %d", d.checksum, upc.Checksum())
+		}
+		upc, err = UPCAFromString(upc.String())
+		if err != nil {
+			t.Errorf("Invalid upc %d", d.code11)
+		}
+		if d.checksum != upc.Checksum() {
+			t.Errorf("Unexpected checksum %d v.s. %d", d.checksum, upc.Checksum())
+		}
+		ean := upc.EAN13()
+		if ean.String() != "0"+upc.String() {
+			t.Errorf("Unexpected EAN-13 %s for UPC-A %s", ean, upc)
+		}
+		back, err := UPCAFromEAN13(ean)
+		if err != nil || back != upc {
+			t.Errorf("Unexpected UPC-A %s for EAN-13 %s", back, ean)
+		}
+	}
+}
+
+func TestInvalidUPCA(t *testing.T) {
+	var invalidString = []string{
+		"0360002914521", // too long
+		"03600029145a",  // not all digits
+		"036000291453",  // invalid checksum
+	}
+	for _, c := range invalidString {
+		if _, err := UPCAFromString(c); err == nil {
+			t.Errorf("Unexpected valid code %s", c)
+		}
+	}
+	ean, _ := EAN13FromString12("590123412345")
+	if _, err := UPCAFromEAN13(ean); err == nil {
+		t.Errorf("Unexpected UPC-A for EAN-13 %s", ean)
+	}
+}
@@ -0,0 +1,200 @@
+package barcode
+
+import (
+	"bitbucket.org/saintfish/gopdf/pdf"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"strconv"
+)
+
+// UPCE is a zero-suppressed UPC code. It holds 8 digits: the number system digit,
+// 6 digits of compressed payload and the check digit of the expanded UPC-A code.
+type UPCE struct {
+	code8 uint64
+	addon Addon
+}
+
+func (upc UPCE) Code8() uint64 {
+	return upc.code8
+}
+
+// Code6 returns the 6 digits of compressed payload.
+func (upc UPCE) Code6() uint64 {
+	return upc.code8 / 10 % 1000000
+}
+
+func (upc UPCE) Checksum() uint8 {
+	return uint8(upc.code8 % 10)
+}
+
+// NumberSystem returns the number system digit, which is either 0 or 1.
+func (upc UPCE) NumberSystem() uint8 {
+	return uint8(upc.code8 / 10000000)
+}
+
+func (upc UPCE) String() string {
+	return fmt.Sprintf("%08d", upc.code8)
+}
+
+// Addon returns the supplemental code printed on the right of the symbol.
+func (upc UPCE) Addon() Addon {
+	return upc.addon
+}
+
+// WithAddon returns a copy of the code carrying the supplemental code addon.
+func (upc UPCE) WithAddon(addon Addon) UPCE {
+	upc.addon = addon
+	return upc
+}
+
+// UPCA expands the code to its UPC-A equivalent.
+func (upc UPCE) UPCA() UPCA {
+	return UPCA{
+		code12: expandUPCE(upc.code8/10)*10 + upc.code8%10,
+		addon:  upc.addon,
+	}
+}
+
+// EAN13 expands the code to its EAN-13 equivalent.
+func (upc UPCE) EAN13() EAN13 {
+	return upc.UPCA().EAN13()
+}
+
+func (upc UPCE) RenderImage(img draw.Image, bound image.Rectangle, padding int) error {
+	r, err := newBitmapRenderer(img, bound, padding, eanLayout{width: upceLogicalWidth + upc.addon.logicalWidth()})
+	if err != nil {
+		return err
+	}
+	renderUPCE(upc.code8, upc.addon, r)
+	return nil
+}
+
+func (upc UPCE) RenderPdf(canvas *pdf.Canvas, bound pdf.Rectangle, padding pdf.Unit) error {
+	r, err := newPdfRenderer(canvas, bound, padding, eanLayout{width: upceLogicalWidth + upc.addon.logicalWidth()})
+	if err != nil {
+		return err
+	}
+	renderUPCE(upc.code8, upc.addon, r)
+	return nil
+}
+
+// expandUPCE expands the number system and 6 digits of a UPC-E code to the
+// first 11 digits of the UPC-A code.
+func expandUPCE(code7 uint64) uint64 {
+	var d [7]int
+	splitDigits(code7, d[:])
+	var e [11]int
+	e[0], e[1], e[2] = d[0], d[1], d[2]
+	switch last := d[6]; {
+	case last <= 2:
+		e[3] = last
+		e[8], e[9], e[10] = d[3], d[4], d[5]
+	case last == 3:
+		e[3] = d[3]
+		e[9], e[10] = d[4], d[5]
+	case last == 4:
+		e[3], e[4] = d[3], d[4]
+		e[10] = d[5]
+	default:
+		e[3], e[4], e[5] = d[3], d[4], d[5]
+		e[10] = last
+	}
+	var code11 uint64
+	for _, v := range e {
+		code11 = code11*10 + uint64(v)
+	}
+	return code11
+}
+
+// compressUPCA returns the number system and 6 digits of the UPC-E code that expands
+// to the first 11 digits of a UPC-A code.
+func compressUPCA(code11 uint64) (uint64, bool) {
+	var e [11]int
+	splitDigits(code11, e[:])
+	if e[0] > 1 {
+		return 0, false
+	}
+	var candidates = [4][6]int{
+		{e[1], e[2], e[8], e[9], e[10], e[3]},
+		{e[1], e[2], e[3], e[9], e[10], 3},
+		{e[1], e[2], e[3], e[4], e[10], 4},
+		{e[1], e[2], e[3], e[4], e[5], e[10]},
+	}
+	for _, candidate := range candidates {
+		code7 := uint64(e[0])
+		for _, v := range candidate {
+			code7 = code7*10 + uint64(v)
+		}
+		if expandUPCE(code7) == code11 {
+			return code7, true
+		}
+	}
+	return 0, false
+}
+
+var (
+	errInvalidUPCE       = errors.New("Invalid UPC-E code")
+	errUPCENotCompatible = errors.New("Code cannot be compressed to UPC-E")
+)
+
+// UPCEFromUPCA compresses a UPC-A code to UPC-E when the zero suppression rules allow it.
+func UPCEFromUPCA(upc UPCA) (UPCE, error) {
+	code7, ok := compressUPCA(upc.code12 / 10)
+	if !ok {
+		return UPCE{}, errUPCENotCompatible
+	}
+	return UPCE{
+		code8: code7*10 + upc.code12%10,
+		addon: upc.addon,
+	}, nil
+}
+
+// UPCEFromEAN13 compresses an EAN-13 code to UPC-E when the zero suppression rules allow it.
+func UPCEFromEAN13(ean EAN13) (UPCE, error) {
+	upc, err := UPCAFromEAN13(ean)
+	if err != nil {
+		return UPCE{}, errUPCENotCompatible
+	}
+	return UPCEFromUPCA(upc)
+}
+
+func UPCEFromCode7(code7 uint64) (UPCE, error) {
+	if code7 > 1999999 {
+		return UPCE{}, errInvalidUPCE
+	}
+	checksum := computeEANChecksum(expandUPCE(code7))
+	return UPCE{
+		code8: code7*10 + checksum,
+	}, nil
+}
+
+func UPCEFromCode8(code8 uint64) (UPCE, error) {
+	if code8 > 19999999 {
+		return UPCE{}, errInvalidUPCE
+	}
+	checksum := computeEANChecksum(expandUPCE(code8 / 10))
+	if code8%10 != checksum {
+		return UPCE{}, errInvalidEANChecksum
+	}
+	return UPCE{
+		code8: code8,
+	}, nil
+}
+
+// UPCEFromString parses a UPC-E code of 6 digits (number system 0 assumed),
+// 7 digits (with number system) or 8 digits (with number system and check digit).
+func UPCEFromString(code string) (UPCE, error) {
+	if len(code) < 6 || len(code) > 8 || !allDigits(code) {
+		return UPCE{}, errInvalidUPCE
+	}
+	c, err := strconv.ParseUint(code, 10, 64)
+	if err != nil {
+		return UPCE{}, err
+	}
+	if len(code) == 8 {
+		return UPCEFromCode8(c)
+	}
+	return UPCEFromCode7(c)
+}
@@ -0,0 +1,68 @@
+package barcode
+
+import (
+	"testing"
+)
+
+func TestUPCE(t *testing.T) {
+	type data struct {
+		upce string
+		upca string
+	}
+	var testdata = []data{
+		{"04252614", "042100005264"},
+		{"01234565", "012345000065"},
+		{"01234531", "012300000451"},
+		{"01234145", "012340000015"},
+		{"01234558", "012345000058"},
+		{"11234562", "112345000062"},
+	}
+	for _, d := range testdata {
+		upce, err := UPCEFromString(d.upce)
+		if err != nil {
+			t.Errorf("Invalid upc-e %s", d.upce)
+			continue
+		}
+		if upce.UPCA().String() != d.upca {
+			t.Errorf("Unexpected expansion %s v.s. %s", d.upca, upce.UPCA())
+		}
+		if upce.EAN13().String() != "0"+d.upca {
+			t.Errorf("Unexpected expansion %s v.s. %s", "0"+d.upca, upce.EAN13())
+		}
+		short, err := UPCEFromString(d.upce[:7])
+		if err != nil || short != upce {
+			t.Errorf("Unexpected upc-e %s v.s. %s", d.upce, short)
+		}
+		ean, _ := EAN13FromString("0" + d.upca)
+		compressed, err := UPCEFromEAN13(ean)
+		if err != nil || compressed != upce {
+			t.Errorf("Unexpected compression %s v.s. %s", d.upce, compressed)
+		}
+	}
+}
+
+func TestInvalidUPCE(t *testing.T) {
+	var invalidString = []string{
+		"12345",     // too short
+		"123456789", // too long
+		"04252a14",  // not all digits
+		"04252615",  // invalid checksum
+		"24252614",  // invalid number system
+	}
+	for _, c := range invalidString {
+		if _, err := UPCEFromString(c); err == nil {
+			t.Errorf("Unexpected valid code %s", c)
+		}
+	}
+	var incompressible = []string{
+		"5901234123457",
+		"0012345678905",
+		"0010000100006",
+	}
+	for _, c := range incompressible {
+		ean, _ := EAN13FromString(c)
+		if _, err := UPCEFromEAN13(ean); err == nil {
+			t.Errorf("Unexpected compression of %s", c)
+		}
+	}
+}